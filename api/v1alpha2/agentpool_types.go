@@ -41,9 +41,73 @@ type AgentAPIToken struct {
 	//
 	//+optional
 	LastUsedAt *int64 `json:"lastUsedAt,omitempty"`
+	// Timestamp of when the agent token was last rotated.
+	//
+	//+optional
+	RotatedAt *int64 `json:"rotatedAt,omitempty"`
+	// ID of the agent token replaced during the last rotation.
+	// It is revoked once the overlap period has elapsed and the agent token has been used.
+	//
+	//+kubebuilder:validation:Pattern:="^at-[a-zA-Z0-9]+$"
+	//+optional
+	PreviousID string `json:"previousID,omitempty"`
+}
+
+// AgentTokenRotation defines how the controller rotates agent tokens.
+// Once an agent token is older than `maxAge`, the controller creates a replacement token and stores it in the Kubernetes Secret.
+// The replaced token remains valid during `overlapPeriod`, and is revoked once the period has elapsed and the new token has been used.
+type AgentTokenRotation struct {
+	// Maximum age of an agent token.
+	// Must be a duration, e.g. `720h`.
+	MaxAge metav1.Duration `json:"maxAge"`
+	// Period during which the replaced agent token remains valid after the rotation.
+	// Must be a duration shorter than `maxAge`, e.g. `1h`.
+	// Default: `1h`.
+	//
+	//+kubebuilder:default:="1h"
+	//+optional
+	OverlapPeriod *metav1.Duration `json:"overlapPeriod,omitempty"`
+}
+
+// StaleAgentTokens defines how the controller handles agent tokens that have not been used for a while.
+type StaleAgentTokens struct {
+	// Duration after which an agent token that has not been used is considered stale.
+	// An agent token that has never been used is considered stale once this duration has elapsed since its creation.
+	// Must be a duration, e.g. `2160h`.
+	UnusedFor metav1.Duration `json:"unusedFor"`
+	// Revoke stale agent tokens managed by the controller.
+	// Agent tokens that the controller does not manage are only reported.
+	// A revoked agent token that is still in the spec is replaced with a new one on the next reconciliation.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	Revoke bool `json:"revoke,omitempty"`
+}
+
+// StaleAgentToken is an agent token that has not been used for the configured duration.
+type StaleAgentToken struct {
+	// Agent Token ID.
+	ID string `json:"id"`
+	// Agent Token name.
+	//
+	//+optional
+	Name string `json:"name,omitempty"`
+	// Timestamp of when the agent token was created.
+	//
+	//+optional
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+	// Timestamp of when the agent token was last used.
+	// Not set if the agent token has never been used.
+	//
+	//+optional
+	LastUsedAt *int64 `json:"lastUsedAt,omitempty"`
+	// Whether the agent token is managed by the controller.
+	Managed bool `json:"managed"`
 }
 
-// TargetWorkspace is the name or ID of the workspace you want autoscale against.
+// TargetWorkspace selects workspaces you want autoscale against.
+// Only one of the fields `ID`, `Name`, `WildcardName`, `Project`, `Tags`, or `WorkspaceSelector` is allowed.
 type TargetWorkspace struct {
 	// Workspace ID
 	//
@@ -60,6 +124,22 @@ type TargetWorkspace struct {
 	//+kubebuilder:validation:MinLength:=1
 	//+optional
 	WildcardName string `json:"wildcardName,omitempty"`
+	// Project of the workspaces.
+	// Matches all workspaces in the project.
+	//
+	//+optional
+	Project *WorkspaceProject `json:"project,omitempty"`
+	// Workspace tags.
+	// Matches workspaces that have all of the tags.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Tags []Tag `json:"tags,omitempty"`
+	// Label selector of the Workspace objects.
+	// Matches workspaces managed by the Workspace objects in the same namespace as the AgentPool object.
+	//
+	//+optional
+	WorkspaceSelector *metav1.LabelSelector `json:"workspaceSelector,omitempty"`
 }
 
 // AgentDeploymentAutoscaling allows you to configure the operator
@@ -71,11 +151,11 @@ type AgentDeploymentAutoscaling struct {
 	// MinReplicas is the minimum number of replicas for the Agent deployment.
 	MinReplicas *int32 `json:"minReplicas"`
 
-	// DEPRECATED: This field has been deprecated since 2.9.0 and will be removed in future versions.
 	// TargetWorkspaces is a list of HCP Terraform Workspaces which
 	// the agent pool should scale up to meet demand. When this field
 	// is ommited the autoscaler will target all workspaces that are
 	// associated with the AgentPool.
+	// A workspace is targeted if it matches any of the items.
 	//
 	//+optional
 	TargetWorkspaces *[]TargetWorkspace `json:"targetWorkspaces"`
@@ -89,6 +169,53 @@ type AgentDeploymentAutoscaling struct {
 	// CoolDownPeriod configures the period to wait between scaling up and scaling down
 	//+optional
 	CooldownPeriod *AgentDeploymentAutoscalingCooldownPeriod `json:"cooldownPeriod,omitempty"`
+
+	// Schedules override MinReplicas and MaxReplicas for recurring time windows.
+	// If more than one schedule is active at the same time, the first one in the list is applied.
+	// When the active schedule raises the minimum number of replicas above the current number of replicas,
+	// the autoscaler scales up immediately, ignoring the cooldown period.
+	// All other scaling events, including scaling down when a window ends, respect the cooldown period.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Schedules []AgentDeploymentAutoscalingSchedule `json:"schedules,omitempty"`
+}
+
+// AgentDeploymentAutoscalingSchedule overrides the autoscaling bounds for a recurring time window.
+// The window starts at every time that matches the cron expression and lasts for the given duration.
+// At least one of the fields `MinReplicas` or `MaxReplicas` must be set.
+type AgentDeploymentAutoscalingSchedule struct {
+	// Name of the schedule.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Cron expression of the window start in the standard 5-field format: minute, hour, day of month, month, and day of week.
+	// For example, `0 8 * * 1-5` starts the window at 08:00 on every weekday.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Schedule string `json:"schedule"`
+	// Duration of the window.
+	// Format: `30m`, `10h`, etc.
+	Duration metav1.Duration `json:"duration"`
+	// Time zone of the cron expression in the IANA Time Zone database format.
+	// For example, `Europe/Amsterdam`.
+	// Default: `UTC`.
+	//
+	//+kubebuilder:default:=UTC
+	//+optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// MinReplicas is the minimum number of replicas for the Agent deployment during the window.
+	// If not set, `autoscaling.minReplicas` is used.
+	//
+	//+kubebuilder:validation:Minimum:=0
+	//+optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of replicas for the Agent deployment during the window.
+	// If not set, `autoscaling.maxReplicas` is used.
+	//
+	//+kubebuilder:validation:Minimum:=0
+	//+optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
 }
 
 // AgentDeploymentAutoscalingCooldownPeriod configures the period to wait between scaling up and scaling down
@@ -112,6 +239,233 @@ type AgentDeployment struct {
 	// The labels that the operator will apply to the pod template in the deployment.
 	//+optional
 	Labels map[string]string `json:"labels,omitempty"`
+	// The HCP Terraform Agent configuration.
+	// The operator renders it as environment variables and volumes of each container in the deployment.
+	// Environment variables that the configuration renders cannot be set in the containers.
+	//
+	//+optional
+	Config *AgentConfig `json:"config,omitempty"`
+	// PodDisruptionBudget of the agent deployment.
+	// The operator creates a PodDisruptionBudget named as the deployment and adjusts it as the number of replicas and busy agents change.
+	//
+	//+optional
+	PodDisruptionBudget *AgentPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+	// Topology spread of the agent pods.
+	// The operator adds a topology spread constraint that selects the agent pods of the deployment,
+	// unless `spec.topologySpreadConstraints` is set.
+	//
+	//+optional
+	TopologySpread *AgentTopologySpread `json:"topologySpread,omitempty"`
+}
+
+// AgentPodDisruptionBudget limits the number of agent pods that voluntary disruptions, such as node drains, can evict at the same time.
+// The operator labels pods with busy agents and covers them with a dedicated PodDisruptionBudget `<deployment>-busy` with `maxUnavailable` set to `0`,
+// so that evictions do not interrupt runs in progress. The other agent pods are covered by a PodDisruptionBudget with the same name as the deployment,
+// which allows `maxUnavailable` of them to be evicted at the same time.
+// More information:
+//   - https://kubernetes.io/docs/concepts/workloads/pods/disruptions/
+type AgentPodDisruptionBudget struct {
+	// MaxUnavailable is the number of agent pods that can be unavailable at the same time.
+	// Default: `1`.
+	//
+	//+kubebuilder:validation:Minimum:=0
+	//+kubebuilder:default:=1
+	//+optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+}
+
+// AgentTopologySpread spreads agent pods across topology domains, so that a single node or zone disruption affects fewer agents.
+// More information:
+//   - https://kubernetes.io/docs/concepts/scheduling-eviction/topology-spread-constraints/
+type AgentTopologySpread struct {
+	// The key of node labels. Nodes that have a label with this key and identical values are considered to be in the same topology.
+	// Default: `kubernetes.io/hostname`.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+kubebuilder:default:=kubernetes.io/hostname
+	//+optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+	// The maximum difference between the number of agent pods in any two topology domains.
+	// Default: `1`.
+	//
+	//+kubebuilder:validation:Minimum:=1
+	//+kubebuilder:default:=1
+	//+optional
+	MaxSkew int32 `json:"maxSkew,omitempty"`
+	// Whether to schedule a pod if it does not satisfy the spread constraint: `DoNotSchedule` or `ScheduleAnyway`.
+	// Default: `ScheduleAnyway`.
+	//
+	//+kubebuilder:validation:Enum:=DoNotSchedule;ScheduleAnyway
+	//+kubebuilder:default:=ScheduleAnyway
+	//+optional
+	WhenUnsatisfiable v1.UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
+// AgentConfig configures the HCP Terraform Agent.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/agents/agents#optional-configuration
+type AgentConfig struct {
+	// The log level of the agent: `trace`, `debug`, `info`, `warn`, or `error`.
+	// Renders the `TFC_AGENT_LOG_LEVEL` environment variable.
+	//
+	//+kubebuilder:validation:Enum:=trace;debug;info;warn;error
+	//+optional
+	LogLevel string `json:"logLevel,omitempty"`
+	// Whether the agent exits after it completes a single run. Kubernetes restarts the container afterwards.
+	// Renders the `TFC_AGENT_SINGLE` environment variable.
+	//
+	//+optional
+	Single *bool `json:"single,omitempty"`
+	// Whether the agent forwards requests from HCP Terraform to private services.
+	// Renders the `TFC_AGENT_REQUEST_FORWARDING` environment variable.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/agents/request-forwarding
+	//
+	//+optional
+	RequestForwarding *bool `json:"requestForwarding,omitempty"`
+	// Agent hooks.
+	//
+	//+optional
+	Hooks *AgentHooks `json:"hooks,omitempty"`
+	// OpenTelemetry settings.
+	//
+	//+optional
+	OpenTelemetry *AgentOpenTelemetry `json:"openTelemetry,omitempty"`
+	// The absolute path of the agent data directory.
+	// Renders the `TFC_AGENT_DATA_DIR` environment variable.
+	// Default: `/home/tfc-agent/.tfc-agent`.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	DataDir string `json:"dataDir,omitempty"`
+	// The absolute path of the agent cache directory.
+	// Renders the `TFC_AGENT_CACHE_DIR` environment variable.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	CacheDir string `json:"cacheDir,omitempty"`
+	// The volume the operator mounts to the cache directory.
+	// Requires `cacheDir`.
+	//
+	//+optional
+	CacheVolume *AgentCacheVolume `json:"cacheVolume,omitempty"`
+}
+
+// AgentHooks configures the agent hooks from a ConfigMap.
+// The operator mounts the ConfigMap to the `hooks` subdirectory of the agent data directory.
+// The ConfigMap keys must match the hook names: `terraform-pre-plan`, `terraform-post-plan`, `terraform-pre-apply`, and `terraform-post-apply`.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/agents/hooks
+type AgentHooks struct {
+	// The name of the ConfigMap with the hook scripts in the same namespace as the AgentPool object.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	ConfigMapName string `json:"configMapName"`
+}
+
+// AgentOpenTelemetry configures the agent to export OpenTelemetry data.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/agents/telemetry
+type AgentOpenTelemetry struct {
+	// The address of the OpenTelemetry collector in the `host:port` format.
+	// Renders the `TFC_AGENT_OTLP_ADDRESS` environment variable.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Address string `json:"address"`
+	// The absolute path of the certificate file to use for the connection to the OpenTelemetry collector.
+	// Renders the `TFC_AGENT_OTLP_CERT_FILE` environment variable.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	CertFile string `json:"certFile,omitempty"`
+}
+
+// AgentCacheVolume configures the volume of the agent cache directory.
+// Only one of the fields `ClaimName` or `VolumeClaimTemplate` is allowed.
+type AgentCacheVolume struct {
+	// The name of an existing PersistentVolumeClaim in the same namespace as the AgentPool object.
+	// All agent pods share the claim, therefore, it must support the `ReadWriteMany` access mode if agents run on different nodes.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	ClaimName string `json:"claimName,omitempty"`
+	// The template of a PersistentVolumeClaim that Kubernetes creates for each agent pod and removes along with it.
+	//
+	//+optional
+	VolumeClaimTemplate *v1.PersistentVolumeClaimTemplate `json:"volumeClaimTemplate,omitempty"`
+}
+
+// AgentDeploymentClass is a named agent deployment of the agent pool.
+// Each class has its own pod template, replicas or autoscaling bounds, and target workspaces.
+type AgentDeploymentClass struct {
+	// Name of the agent deployment class.
+	// The operator names the Deployment `agents-of-<metadata.name>-<name>`.
+	// Must match pattern: `^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	//
+	//+kubebuilder:validation:Pattern:="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	//+kubebuilder:validation:MaxLength:=32
+	Name string `json:"name"`
+	// Agent deployment settings.
+	AgentDeployment `json:",inline"`
+	// Agent deployment autoscaling settings.
+	// The autoscaler assigns each pending run to the first class which target workspaces match the run workspace.
+	//
+	//+optional
+	Autoscaling *AgentDeploymentAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// AgentExternalAutoscaling delegates scaling of the agent deployment to an external autoscaler, such as KEDA or HorizontalPodAutoscaler.
+// The operator does not manage the number of replicas of the agent deployment, instead, it exposes the number of pending runs
+// via the KEDA external scaler gRPC service and the Prometheus metric `hcp_tf_agent_pool_pending_runs`.
+// More information:
+//   - https://keda.sh/docs/latest/concepts/external-scalers/
+type AgentExternalAutoscaling struct {
+	// TargetWorkspaces is a list of HCP Terraform Workspaces which
+	// pending runs are counted. When this field is ommited
+	// the operator counts pending runs of all workspaces that are
+	// associated with the AgentPool.
+	// A workspace is targeted if it matches any of the items.
+	//
+	//+optional
+	TargetWorkspaces *[]TargetWorkspace `json:"targetWorkspaces,omitempty"`
+}
+
+// AgentJobs configures the operator to launch one Kubernetes Job per pending run instead of a long-running agent Deployment.
+// Each Job runs a single HCP Terraform Agent in the single-execution mode, the agent exits once it completes a run.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/agents/agents#optional-configuration
+type AgentJobs struct {
+	// The pod template of the agent Job.
+	// If not set, the operator uses a single `hashicorp/tfc-agent` container.
+	//
+	//+optional
+	Spec *v1.PodSpec `json:"spec,omitempty"`
+	// The annotations that the operator will apply to the pod template in the Job.
+	//
+	//+optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// The labels that the operator will apply to the pod template in the Job.
+	//
+	//+optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// MaxJobs is the maximum number of agent Jobs that can run at the same time.
+	//
+	//+kubebuilder:validation:Minimum:=1
+	MaxJobs *int32 `json:"maxJobs"`
+	// MinJobs is the minimum number of agent Jobs that are kept running, even if there are no pending runs.
+	// Default: `0`.
+	//
+	//+kubebuilder:validation:Minimum:=0
+	//+kubebuilder:default:=0
+	//+optional
+	MinJobs *int32 `json:"minJobs,omitempty"`
+	// TTLSecondsAfterFinished is the time after which Kubernetes removes a finished agent Job.
+	// Default: `300`.
+	//
+	//+kubebuilder:validation:Minimum:=0
+	//+kubebuilder:default:=300
+	//+optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 }
 
 // AgentPoolSpec defines the desired state of AgentPool.
@@ -136,6 +490,16 @@ type AgentPoolSpec struct {
 	//+kubebuilder:validation:MinItems:=1
 	//+optional
 	AgentTokens []*AgentAPIToken `json:"agentTokens,omitempty"`
+	// Agent token rotation settings.
+	// The agent deployment is restarted when its agent token is rotated.
+	//
+	//+optional
+	AgentTokenRotation *AgentTokenRotation `json:"agentTokenRotation,omitempty"`
+	// Stale agent tokens settings.
+	// The controller reports agent tokens that have not been used for the configured duration and optionally revokes them.
+	//
+	//+optional
+	StaleAgentTokens *StaleAgentTokens `json:"staleAgentTokens,omitempty"`
 
 	// Agent deployment settings
 	//+optional
@@ -145,6 +509,36 @@ type AgentPoolSpec struct {
 	//+optional
 	AgentDeploymentAutoscaling *AgentDeploymentAutoscaling `json:"autoscaling,omitempty"`
 
+	// External autoscaling settings.
+	// The operator does not set the number of agent deployment replicas and delegates scaling to an external autoscaler.
+	// Requires `agentDeployment`. Cannot be used together with `autoscaling`.
+	//
+	//+optional
+	ExternalAutoscaling *AgentExternalAutoscaling `json:"externalAutoscaling,omitempty"`
+
+	// Agent Jobs settings.
+	// The operator launches one Kubernetes Job per pending run.
+	// Cannot be used together with `agentDeployment` and `autoscaling`.
+	//
+	//+optional
+	AgentJobs *AgentJobs `json:"agentJobs,omitempty"`
+
+	// Agent deployment classes.
+	// The operator creates one Deployment per class.
+	// Cannot be used together with `agentDeployment`, `autoscaling`, and `agentJobs`.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	AgentDeploymentClasses []AgentDeploymentClass `json:"agentDeploymentClasses,omitempty"`
+
+	// Deregister agents of the agent pool whose Kubernetes pods no longer exist.
+	// Only agents that run in the pods managed by the operator and have the `unknown`, `errored`, or `exited` status are deregistered.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	DeregisterOrphanedAgents bool `json:"deregisterOrphanedAgents,omitempty"`
+
 	// The Deletion Policy specifies the behavior of the custom resource and its associated agent pool when the custom resource is deleted.
 	// - `retain`: When you delete the custom resource, the operator will remove only the custom resource.
 	//   The HCP Terraform agent pool will be retained. The managed tokens will remain active on the HCP Terraform side; however, the corresponding secrets and managed agents will be removed.
@@ -168,6 +562,133 @@ type AgentDeploymentAutoscalingStatus struct {
 	// Last time the agent pool was scaledx
 	//+optional
 	LastScalingEvent *metav1.Time `json:"lastScalingEvent,omitempty"`
+
+	// Name of the active autoscaling schedule.
+	//
+	//+optional
+	ActiveSchedule string `json:"activeSchedule,omitempty"`
+	// Minimum number of agent replicas in effect.
+	//
+	//+optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// Maximum number of agent replicas in effect.
+	//
+	//+optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
+// AgentDeploymentStatus
+type AgentDeploymentStatus struct {
+	// Total number of agent pods targeted by the agent deployment.
+	//
+	//+optional
+	Replicas int32 `json:"replicas"`
+	// Number of agent pods that run the current pod template.
+	//
+	//+optional
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// Number of ready agent pods.
+	//
+	//+optional
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// Number of available agent pods.
+	//
+	//+optional
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// Rollout state of the agent deployment.
+	// One of: `Progressing`, `Complete`, or `Failed`.
+	//
+	//+optional
+	Rollout string `json:"rollout,omitempty"`
+	// Names of agent pods with a container in the `CrashLoopBackOff` state.
+	//
+	//+optional
+	CrashLoopingPods []string `json:"crashLoopingPods,omitempty"`
+	// Last time the agent deployment status was updated.
+	//
+	//+optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// AgentJobsStatus
+type AgentJobsStatus struct {
+	// Number of agent Jobs that are currently running.
+	//
+	//+optional
+	ActiveJobs int32 `json:"activeJobs"`
+	// Desired number of agent Jobs.
+	//
+	//+optional
+	DesiredJobs int32 `json:"desiredJobs"`
+	// Last time the operator launched an agent Job.
+	//
+	//+optional
+	LastJobCreated *metav1.Time `json:"lastJobCreated,omitempty"`
+}
+
+// AgentDeploymentClassStatus
+type AgentDeploymentClassStatus struct {
+	// Name of the agent deployment class.
+	Name string `json:"name"`
+	// Name of the agent Deployment.
+	//
+	//+optional
+	DeploymentName string `json:"deploymentName,omitempty"`
+	// Autoscaling status of the agent Deployment.
+	AgentDeploymentAutoscalingStatus `json:",inline"`
+}
+
+// AgentExternalAutoscalingStatus
+type AgentExternalAutoscalingStatus struct {
+	// Number of pending runs of the target workspaces.
+	//
+	//+optional
+	PendingRuns int32 `json:"pendingRuns"`
+	// Last time the operator updated the number of pending runs.
+	//
+	//+optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// AgentStatus describes an agent registered to the agent pool.
+type AgentStatus struct {
+	// Agent ID.
+	ID string `json:"id"`
+	// Agent name.
+	Name string `json:"name"`
+	// Agent status: `idle`, `busy`, `unknown`, `errored`, or `exited`.
+	Status string `json:"status"`
+	// Last time the agent pinged HCP Terraform.
+	//
+	//+optional
+	LastPingAt *metav1.Time `json:"lastPingAt,omitempty"`
+	// Name of the Kubernetes pod the agent runs in.
+	// Empty if the agent does not run in a pod managed by the operator or the pod no longer exists.
+	//
+	//+optional
+	PodName string `json:"podName,omitempty"`
+}
+
+// AgentsStatus describes the agents registered to the agent pool.
+type AgentsStatus struct {
+	// Number of agents with the `idle` status.
+	Idle int32 `json:"idle"`
+	// Number of agents with the `busy` status.
+	Busy int32 `json:"busy"`
+	// Number of agents with the `unknown` status.
+	Unknown int32 `json:"unknown"`
+	// Number of agents with the `errored` status.
+	Errored int32 `json:"errored"`
+	// Number of agents with the `exited` status.
+	Exited int32 `json:"exited"`
+	// List of the agents, except the ones with the `exited` status.
+	//
+	//+optional
+	Agents []AgentStatus `json:"agents,omitempty"`
+	// Last time the operator updated the agents status.
+	//
+	//+optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
 }
 
 // AgentPoolStatus defines the observed state of AgentPool.
@@ -184,10 +705,34 @@ type AgentPoolStatus struct {
 	//
 	//+optional
 	AgentDeploymentName string `json:"agentDeploymentName,omitempty"`
+	// Agent Deployment Status
+	//
+	//+optional
+	AgentDeployment *AgentDeploymentStatus `json:"agentDeployment,omitempty"`
 	// Autoscaling Status
 	//
 	//+optional
 	AgentDeploymentAutoscalingStatus *AgentDeploymentAutoscalingStatus `json:"autoscaling,omitempty"`
+	// Agent Jobs Status
+	//
+	//+optional
+	AgentJobsStatus *AgentJobsStatus `json:"agentJobs,omitempty"`
+	// Agent Deployment Classes Status
+	//
+	//+optional
+	AgentDeploymentClasses []AgentDeploymentClassStatus `json:"agentDeploymentClasses,omitempty"`
+	// External Autoscaling Status
+	//
+	//+optional
+	ExternalAutoscaling *AgentExternalAutoscalingStatus `json:"externalAutoscaling,omitempty"`
+	// Agents registered to the agent pool.
+	//
+	//+optional
+	Agents *AgentsStatus `json:"agents,omitempty"`
+	// Agent tokens that have not been used for the duration configured in `spec.staleAgentTokens`.
+	//
+	//+optional
+	StaleAgentTokens []StaleAgentToken `json:"staleAgentTokens,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -5,7 +5,11 @@ package v1alpha2
 
 import (
 	"fmt"
+	"path"
+	"strings"
+	"time"
 
+	"github.com/robfig/cron/v3"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -15,6 +19,12 @@ func (ap *AgentPool) ValidateSpec() error {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, ap.validateSpecAgentToken()...)
+	allErrs = append(allErrs, validateAgentTokenRotation(ap.Spec.AgentTokenRotation, field.NewPath("spec").Child("agentTokenRotation"))...)
+	allErrs = append(allErrs, validateStaleAgentTokens(ap.Spec.StaleAgentTokens, field.NewPath("spec").Child("staleAgentTokens"))...)
+	allErrs = append(allErrs, ap.validateSpecAgentJobs()...)
+	allErrs = append(allErrs, ap.validateSpecAutoscaling()...)
+	allErrs = append(allErrs, ap.validateSpecAgentDeploymentClasses()...)
+	allErrs = append(allErrs, ap.validateSpecExternalAutoscaling()...)
 
 	// Validate labels
 	if ap.Spec.AgentDeployment != nil && ap.Spec.AgentDeployment.Labels != nil {
@@ -26,6 +36,17 @@ func (ap *AgentPool) ValidateSpec() error {
 		allErrs = append(allErrs, validateDeploymentAnnotations(ap.Spec.AgentDeployment.Annotations, field.NewPath("spec").Child("agentDeployment").Child("annotations"))...)
 	}
 
+	// Validate agent configuration
+	allErrs = append(allErrs, validateAgentConfig(ap.Spec.AgentDeployment, field.NewPath("spec").Child("agentDeployment"))...)
+
+	if ap.Spec.AgentJobs != nil && ap.Spec.AgentJobs.Labels != nil {
+		allErrs = append(allErrs, validateDeploymentLabels(ap.Spec.AgentJobs.Labels, field.NewPath("spec").Child("agentJobs").Child("labels"))...)
+	}
+
+	if ap.Spec.AgentJobs != nil && ap.Spec.AgentJobs.Annotations != nil {
+		allErrs = append(allErrs, validateDeploymentAnnotations(ap.Spec.AgentJobs.Annotations, field.NewPath("spec").Child("agentJobs").Child("annotations"))...)
+	}
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -62,6 +83,18 @@ func (ap *AgentPool) validateSpecAgentToken() field.ErrorList {
 				"lastUsedAt is not allowed in the spec"),
 			)
 		}
+		if at.RotatedAt != nil {
+			allErrs = append(allErrs, field.Forbidden(
+				f.Child("rotatedAt"),
+				"rotatedAt is not allowed in the spec"),
+			)
+		}
+		if at.PreviousID != "" {
+			allErrs = append(allErrs, field.Forbidden(
+				f.Child("previousID"),
+				"previousID is not allowed in the spec"),
+			)
+		}
 
 		if _, ok := atn[at.Name]; ok {
 			allErrs = append(allErrs, field.Duplicate(f.Child("name"), at.Name))
@@ -72,6 +105,454 @@ func (ap *AgentPool) validateSpecAgentToken() field.ErrorList {
 	return allErrs
 }
 
+// validateAgentTokenRotation validates the following:
+//   - maxAge is positive.
+//   - overlapPeriod is not negative and shorter than maxAge.
+func validateAgentTokenRotation(r *AgentTokenRotation, f *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if r == nil {
+		return allErrs
+	}
+
+	if r.MaxAge.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("maxAge"),
+			r.MaxAge.Duration.String(),
+			"maxAge must be a positive duration"),
+		)
+	}
+
+	if o := r.OverlapPeriod; o != nil {
+		if o.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("overlapPeriod"),
+				o.Duration.String(),
+				"overlapPeriod cannot be negative"),
+			)
+		}
+		if r.MaxAge.Duration > 0 && o.Duration >= r.MaxAge.Duration {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("overlapPeriod"),
+				o.Duration.String(),
+				"overlapPeriod must be shorter than maxAge"),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+// validateStaleAgentTokens validates the following:
+//   - unusedFor is positive.
+func validateStaleAgentTokens(s *StaleAgentTokens, f *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if s == nil {
+		return allErrs
+	}
+
+	if s.UnusedFor.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("unusedFor"),
+			s.UnusedFor.Duration.String(),
+			"unusedFor must be a positive duration"),
+		)
+	}
+
+	return allErrs
+}
+
+// validateSpecAgentJobs validates the following:
+//   - agentJobs cannot be used together with agentDeployment and autoscaling.
+//   - minJobs is not greater than maxJobs.
+func (ap *AgentPool) validateSpecAgentJobs() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := ap.Spec.AgentJobs
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("agentJobs")
+
+	if ap.Spec.AgentDeployment != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			f,
+			"'spec.agentJobs' cannot be used together with 'spec.agentDeployment'"),
+		)
+	}
+
+	if ap.Spec.AgentDeploymentAutoscaling != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			f,
+			"'spec.agentJobs' cannot be used together with 'spec.autoscaling'"),
+		)
+	}
+
+	if spec.MinJobs != nil && spec.MaxJobs != nil && *spec.MinJobs > *spec.MaxJobs {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("minJobs"),
+			*spec.MinJobs,
+			"must not be greater than 'spec.agentJobs.maxJobs'"),
+		)
+	}
+
+	return allErrs
+}
+
+// validateSpecAutoscaling validates the following:
+//   - each target workspace has exactly one of the fields: id, name, wildcardName, project, tags, or workspaceSelector.
+//   - target project has exactly one of the fields: id or name.
+//   - schedules.
+func (ap *AgentPool) validateSpecAutoscaling() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := ap.Spec.AgentDeploymentAutoscaling
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("autoscaling")
+
+	allErrs = append(allErrs, validateTargetWorkspaces(spec.TargetWorkspaces, f.Child("targetWorkspaces"))...)
+	allErrs = append(allErrs, validateAutoscalingSchedules(spec, f.Child("schedules"))...)
+
+	return allErrs
+}
+
+// validateAutoscalingSchedules validates the following:
+//   - schedule names are unique.
+//   - schedule is a valid 5-field cron expression without a time zone prefix.
+//   - timeZone is a valid IANA time zone.
+//   - duration is positive.
+//   - at least one of the fields minReplicas or maxReplicas is set.
+//   - minReplicas in effect is not greater than maxReplicas in effect.
+func validateAutoscalingSchedules(autoscaling *AgentDeploymentAutoscaling, fp *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := make(map[string]int)
+
+	for i, s := range autoscaling.Schedules {
+		f := fp.Index(i)
+
+		if _, ok := names[s.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Child("name"), s.Name))
+		}
+		names[s.Name] = i
+
+		if strings.HasPrefix(s.Schedule, "TZ=") || strings.HasPrefix(s.Schedule, "CRON_TZ=") {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("schedule"),
+				s.Schedule,
+				"time zone prefix is not allowed, use 'timeZone' instead"),
+			)
+		} else if _, err := cron.ParseStandard(s.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("schedule"),
+				s.Schedule,
+				fmt.Sprintf("must be a valid cron expression: %s", err)),
+			)
+		}
+
+		if _, err := time.LoadLocation(s.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("timeZone"),
+				s.TimeZone,
+				"must be a valid IANA time zone"),
+			)
+		}
+
+		if s.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("duration"),
+				s.Duration.Duration.String(),
+				"must be greater than 0"),
+			)
+		}
+
+		if s.MinReplicas == nil && s.MaxReplicas == nil {
+			allErrs = append(allErrs, field.Invalid(
+				f,
+				"",
+				"one of the field MinReplicas or MaxReplicas must be set"),
+			)
+			continue
+		}
+
+		minReplicas, maxReplicas := autoscaling.MinReplicas, autoscaling.MaxReplicas
+		if s.MinReplicas != nil {
+			minReplicas = s.MinReplicas
+		}
+		if s.MaxReplicas != nil {
+			maxReplicas = s.MaxReplicas
+		}
+		if minReplicas != nil && maxReplicas != nil && *minReplicas > *maxReplicas {
+			allErrs = append(allErrs, field.Invalid(
+				f,
+				"",
+				fmt.Sprintf("minReplicas %d in effect must not be greater than maxReplicas %d in effect", *minReplicas, *maxReplicas)),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+func validateTargetWorkspaces(targets *[]TargetWorkspace, fp *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if targets == nil {
+		return allErrs
+	}
+
+	for i, t := range *targets {
+		f := fp.Index(i)
+		n := 0
+		if t.ID != "" {
+			n++
+		}
+		if t.Name != "" {
+			n++
+		}
+		if t.WildcardName != "" {
+			n++
+		}
+		if t.Project != nil {
+			n++
+			if (t.Project.ID == "") == (t.Project.Name == "") {
+				allErrs = append(allErrs, field.Invalid(
+					f.Child("project"),
+					"",
+					"one of the field ID or Name must be set"),
+				)
+			}
+		}
+		if len(t.Tags) > 0 {
+			n++
+		}
+		if t.WorkspaceSelector != nil {
+			n++
+		}
+		if n != 1 {
+			allErrs = append(allErrs, field.Invalid(
+				f,
+				"",
+				"one of the field ID, Name, WildcardName, Project, Tags, or WorkspaceSelector must be set"),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+// validateSpecExternalAutoscaling validates the following:
+//   - externalAutoscaling requires agentDeployment.
+//   - externalAutoscaling cannot be used together with autoscaling.
+//   - target workspaces.
+func (ap *AgentPool) validateSpecExternalAutoscaling() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := ap.Spec.ExternalAutoscaling
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("externalAutoscaling")
+
+	if ap.Spec.AgentDeployment == nil {
+		allErrs = append(allErrs, field.Required(
+			field.NewPath("spec").Child("agentDeployment"),
+			"'spec.agentDeployment' must be set when 'spec.externalAutoscaling' is used"),
+		)
+	}
+
+	if ap.Spec.AgentDeploymentAutoscaling != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			f,
+			"'spec.externalAutoscaling' cannot be used together with 'spec.autoscaling'"),
+		)
+	}
+
+	allErrs = append(allErrs, validateTargetWorkspaces(spec.TargetWorkspaces, f.Child("targetWorkspaces"))...)
+
+	return allErrs
+}
+
+// agentConfigVolumeNames contains names of the volumes that the agent configuration renders.
+var agentConfigVolumeNames = map[string]struct{}{
+	"tfc-agent-hooks": {},
+	"tfc-agent-cache": {},
+}
+
+// agentConfigEnvVarNames returns names of the environment variables that the agent configuration renders.
+func agentConfigEnvVarNames(c *AgentConfig) map[string]struct{} {
+	names := map[string]struct{}{}
+	if c.LogLevel != "" {
+		names["TFC_AGENT_LOG_LEVEL"] = struct{}{}
+	}
+	if c.Single != nil {
+		names["TFC_AGENT_SINGLE"] = struct{}{}
+	}
+	if c.RequestForwarding != nil {
+		names["TFC_AGENT_REQUEST_FORWARDING"] = struct{}{}
+	}
+	if c.OpenTelemetry != nil {
+		names["TFC_AGENT_OTLP_ADDRESS"] = struct{}{}
+		if c.OpenTelemetry.CertFile != "" {
+			names["TFC_AGENT_OTLP_CERT_FILE"] = struct{}{}
+		}
+	}
+	if c.DataDir != "" {
+		names["TFC_AGENT_DATA_DIR"] = struct{}{}
+	}
+	if c.CacheDir != "" {
+		names["TFC_AGENT_CACHE_DIR"] = struct{}{}
+	}
+	return names
+}
+
+// validateAgentConfig validates the following:
+//   - dataDir, cacheDir, and openTelemetry.certFile are absolute paths.
+//   - cacheVolume requires cacheDir.
+//   - cacheVolume has exactly one of the fields: claimName or volumeClaimTemplate.
+//   - containers do not set environment variables that the configuration renders.
+//   - pod volumes do not use names that the configuration renders.
+func validateAgentConfig(ad *AgentDeployment, fp *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ad == nil || ad.Config == nil {
+		return allErrs
+	}
+
+	c := ad.Config
+	f := fp.Child("config")
+
+	if c.DataDir != "" && !path.IsAbs(c.DataDir) {
+		allErrs = append(allErrs, field.Invalid(f.Child("dataDir"), c.DataDir, "must be an absolute path"))
+	}
+
+	if c.CacheDir != "" && !path.IsAbs(c.CacheDir) {
+		allErrs = append(allErrs, field.Invalid(f.Child("cacheDir"), c.CacheDir, "must be an absolute path"))
+	}
+
+	if c.OpenTelemetry != nil && c.OpenTelemetry.CertFile != "" && !path.IsAbs(c.OpenTelemetry.CertFile) {
+		allErrs = append(allErrs, field.Invalid(f.Child("openTelemetry").Child("certFile"), c.OpenTelemetry.CertFile, "must be an absolute path"))
+	}
+
+	if v := c.CacheVolume; v != nil {
+		if c.CacheDir == "" {
+			allErrs = append(allErrs, field.Required(
+				f.Child("cacheDir"),
+				"'cacheDir' must be set when 'cacheVolume' is used"),
+			)
+		}
+		if (v.ClaimName == "") == (v.VolumeClaimTemplate == nil) {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("cacheVolume"),
+				"",
+				"one of the field ClaimName or VolumeClaimTemplate must be set"),
+			)
+		}
+	}
+
+	if ad.Spec == nil {
+		return allErrs
+	}
+
+	names := agentConfigEnvVarNames(c)
+	for i, ct := range ad.Spec.Containers {
+		for j, e := range ct.Env {
+			if _, ok := names[e.Name]; ok {
+				allErrs = append(allErrs, field.Forbidden(
+					fp.Child("spec").Child("containers").Index(i).Child("env").Index(j),
+					fmt.Sprintf("environment variable %s is rendered by 'config'", e.Name)),
+				)
+			}
+		}
+	}
+
+	for i, v := range ad.Spec.Volumes {
+		if _, ok := agentConfigVolumeNames[v.Name]; ok {
+			allErrs = append(allErrs, field.Forbidden(
+				fp.Child("spec").Child("volumes").Index(i).Child("name"),
+				fmt.Sprintf("volume name %s is reserved for 'config'", v.Name)),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+// validateSpecAgentDeploymentClasses validates the following:
+//   - agentDeploymentClasses cannot be used together with agentDeployment, autoscaling, and agentJobs.
+//   - class names are unique.
+//   - replicas and autoscaling cannot be set together.
+//   - target workspaces, labels, and annotations of each class.
+func (ap *AgentPool) validateSpecAgentDeploymentClasses() field.ErrorList {
+	allErrs := field.ErrorList{}
+	classes := ap.Spec.AgentDeploymentClasses
+
+	if len(classes) == 0 {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("agentDeploymentClasses")
+
+	if ap.Spec.AgentDeployment != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			f,
+			"'spec.agentDeploymentClasses' cannot be used together with 'spec.agentDeployment'"),
+		)
+	}
+
+	if ap.Spec.AgentDeploymentAutoscaling != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			f,
+			"'spec.agentDeploymentClasses' cannot be used together with 'spec.autoscaling'"),
+		)
+	}
+
+	if ap.Spec.AgentJobs != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			f,
+			"'spec.agentDeploymentClasses' cannot be used together with 'spec.agentJobs'"),
+		)
+	}
+
+	names := make(map[string]int)
+	for i, c := range classes {
+		fi := f.Index(i)
+		if _, ok := names[c.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(fi.Child("name"), c.Name))
+		}
+		names[c.Name] = i
+
+		if c.Replicas != nil && c.Autoscaling != nil {
+			allErrs = append(allErrs, field.Forbidden(
+				fi.Child("replicas"),
+				"'replicas' cannot be used together with 'autoscaling'"),
+			)
+		}
+
+		if c.Autoscaling != nil {
+			allErrs = append(allErrs, validateTargetWorkspaces(c.Autoscaling.TargetWorkspaces, fi.Child("autoscaling").Child("targetWorkspaces"))...)
+			allErrs = append(allErrs, validateAutoscalingSchedules(c.Autoscaling, fi.Child("autoscaling").Child("schedules"))...)
+		}
+
+		if c.Labels != nil {
+			allErrs = append(allErrs, validateDeploymentLabels(c.Labels, fi.Child("labels"))...)
+		}
+
+		if c.Annotations != nil {
+			allErrs = append(allErrs, validateDeploymentAnnotations(c.Annotations, fi.Child("annotations"))...)
+		}
+
+		allErrs = append(allErrs, validateAgentConfig(&c.AgentDeployment, fi)...)
+	}
+
+	return allErrs
+}
+
 // TODO:Validation
 //
 // + Invalid CR cannot be deleted until it is fixed -- need to discuss if we want to do something about it
@@ -5,9 +5,13 @@ package v1alpha2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 func TestValidateAgentPoolSpecAgentToken(t *testing.T) {
@@ -96,3 +100,581 @@ func TestValidateAgentPoolSpecAgentToken(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAgentPoolSpecAgentJobs(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]AgentPool{
+		"HasOnlyMaxJobs": {
+			Spec: AgentPoolSpec{
+				AgentJobs: &AgentJobs{
+					MaxJobs: pointer.PointerOf(int32(5)),
+				},
+			},
+		},
+		"HasMinJobsEqualMaxJobs": {
+			Spec: AgentPoolSpec{
+				AgentJobs: &AgentJobs{
+					MinJobs: pointer.PointerOf(int32(5)),
+					MaxJobs: pointer.PointerOf(int32(5)),
+				},
+			},
+		},
+		"HasNoAgentJobs": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAgentJobs()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]AgentPool{
+		"HasMinJobsGreaterThanMaxJobs": {
+			Spec: AgentPoolSpec{
+				AgentJobs: &AgentJobs{
+					MinJobs: pointer.PointerOf(int32(6)),
+					MaxJobs: pointer.PointerOf(int32(5)),
+				},
+			},
+		},
+		"HasAgentDeployment": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{},
+				AgentJobs: &AgentJobs{
+					MaxJobs: pointer.PointerOf(int32(5)),
+				},
+			},
+		},
+		"HasAutoscaling": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{
+					MaxReplicas: pointer.PointerOf(int32(5)),
+					MinReplicas: pointer.PointerOf(int32(0)),
+				},
+				AgentJobs: &AgentJobs{
+					MaxJobs: pointer.PointerOf(int32(5)),
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAgentJobs()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateAgentPoolSpecAutoscaling(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]AgentPool{
+		"HasNoTargetWorkspaces": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{},
+			},
+		},
+		"HasTargetWorkspaces": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{
+					TargetWorkspaces: &[]TargetWorkspace{
+						{ID: "ws-this"},
+						{Name: "this"},
+						{WildcardName: "this-*"},
+						{Project: &WorkspaceProject{ID: "prj-this"}},
+						{Project: &WorkspaceProject{Name: "this"}},
+						{Tags: []Tag{"this", "self"}},
+						{WorkspaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"this": "self"}}},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAutoscaling()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]AgentPool{
+		"HasEmptyTargetWorkspace": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{
+					TargetWorkspaces: &[]TargetWorkspace{
+						{},
+					},
+				},
+			},
+		},
+		"HasNameAndProject": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{
+					TargetWorkspaces: &[]TargetWorkspace{
+						{
+							Name:    "this",
+							Project: &WorkspaceProject{Name: "this"},
+						},
+					},
+				},
+			},
+		},
+		"HasProjectIDAndName": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{
+					TargetWorkspaces: &[]TargetWorkspace{
+						{Project: &WorkspaceProject{ID: "prj-this", Name: "this"}},
+					},
+				},
+			},
+		},
+		"HasEmptyProject": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{
+					TargetWorkspaces: &[]TargetWorkspace{
+						{Project: &WorkspaceProject{}},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAutoscaling()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateAgentPoolSpecAutoscalingSchedules(t *testing.T) {
+	t.Parallel()
+
+	newAgentPool := func(s AgentDeploymentAutoscalingSchedule) AgentPool {
+		return AgentPool{
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{
+					MinReplicas: pointer.PointerOf(int32(0)),
+					MaxReplicas: pointer.PointerOf(int32(5)),
+					Schedules:   []AgentDeploymentAutoscalingSchedule{s},
+				},
+			},
+		}
+	}
+
+	successCases := map[string]AgentPool{
+		"HasMinReplicas": newAgentPool(AgentDeploymentAutoscalingSchedule{
+			Name:        "business-hours",
+			Schedule:    "0 8 * * 1-5",
+			Duration:    metav1.Duration{Duration: 10 * time.Hour},
+			TimeZone:    "Europe/Amsterdam",
+			MinReplicas: pointer.PointerOf(int32(3)),
+		}),
+		"HasMaxReplicas": newAgentPool(AgentDeploymentAutoscalingSchedule{
+			Name:        "nights",
+			Schedule:    "0 20 * * *",
+			Duration:    metav1.Duration{Duration: 12 * time.Hour},
+			MaxReplicas: pointer.PointerOf(int32(1)),
+		}),
+		"HasDescriptor": newAgentPool(AgentDeploymentAutoscalingSchedule{
+			Name:        "daily",
+			Schedule:    "@daily",
+			Duration:    metav1.Duration{Duration: time.Hour},
+			TimeZone:    "UTC",
+			MinReplicas: pointer.PointerOf(int32(1)),
+			MaxReplicas: pointer.PointerOf(int32(1)),
+		}),
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAutoscaling()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]AgentPool{
+		"HasInvalidSchedule": newAgentPool(AgentDeploymentAutoscalingSchedule{
+			Name:        "this",
+			Schedule:    "0 8 * *",
+			Duration:    metav1.Duration{Duration: time.Hour},
+			MinReplicas: pointer.PointerOf(int32(1)),
+		}),
+		"HasTimeZonePrefix": newAgentPool(AgentDeploymentAutoscalingSchedule{
+			Name:        "this",
+			Schedule:    "CRON_TZ=Europe/Amsterdam 0 8 * * *",
+			Duration:    metav1.Duration{Duration: time.Hour},
+			MinReplicas: pointer.PointerOf(int32(1)),
+		}),
+		"HasInvalidTimeZone": newAgentPool(AgentDeploymentAutoscalingSchedule{
+			Name:        "this",
+			Schedule:    "0 8 * * *",
+			Duration:    metav1.Duration{Duration: time.Hour},
+			TimeZone:    "Mars/Olympus",
+			MinReplicas: pointer.PointerOf(int32(1)),
+		}),
+		"HasNoDuration": newAgentPool(AgentDeploymentAutoscalingSchedule{
+			Name:        "this",
+			Schedule:    "0 8 * * *",
+			MinReplicas: pointer.PointerOf(int32(1)),
+		}),
+		"HasNoReplicas": newAgentPool(AgentDeploymentAutoscalingSchedule{
+			Name:     "this",
+			Schedule: "0 8 * * *",
+			Duration: metav1.Duration{Duration: time.Hour},
+		}),
+		"HasMinReplicasGreaterThanMaxReplicas": newAgentPool(AgentDeploymentAutoscalingSchedule{
+			Name:        "this",
+			Schedule:    "0 8 * * *",
+			Duration:    metav1.Duration{Duration: time.Hour},
+			MinReplicas: pointer.PointerOf(int32(6)),
+		}),
+		"HasDuplicateName": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{
+					MinReplicas: pointer.PointerOf(int32(0)),
+					MaxReplicas: pointer.PointerOf(int32(5)),
+					Schedules: []AgentDeploymentAutoscalingSchedule{
+						{
+							Name:        "this",
+							Schedule:    "0 8 * * *",
+							Duration:    metav1.Duration{Duration: time.Hour},
+							MinReplicas: pointer.PointerOf(int32(1)),
+						},
+						{
+							Name:        "this",
+							Schedule:    "0 20 * * *",
+							Duration:    metav1.Duration{Duration: time.Hour},
+							MaxReplicas: pointer.PointerOf(int32(1)),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAutoscaling()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateAgentPoolSpecAgentDeploymentClasses(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]AgentPool{
+		"HasNoClasses": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{},
+			},
+		},
+		"HasClasses": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentClasses: []AgentDeploymentClass{
+					{
+						Name: "small",
+						AgentDeployment: AgentDeployment{
+							Replicas: pointer.PointerOf(int32(1)),
+						},
+					},
+					{
+						Name: "large",
+						Autoscaling: &AgentDeploymentAutoscaling{
+							MinReplicas: pointer.PointerOf(int32(0)),
+							MaxReplicas: pointer.PointerOf(int32(3)),
+							TargetWorkspaces: &[]TargetWorkspace{
+								{Tags: []Tag{"large"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAgentDeploymentClasses()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]AgentPool{
+		"HasAgentDeployment": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{},
+				AgentDeploymentClasses: []AgentDeploymentClass{
+					{Name: "small"},
+				},
+			},
+		},
+		"HasAutoscaling": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{},
+				AgentDeploymentClasses: []AgentDeploymentClass{
+					{Name: "small"},
+				},
+			},
+		},
+		"HasAgentJobs": {
+			Spec: AgentPoolSpec{
+				AgentJobs: &AgentJobs{},
+				AgentDeploymentClasses: []AgentDeploymentClass{
+					{Name: "small"},
+				},
+			},
+		},
+		"HasDuplicateName": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentClasses: []AgentDeploymentClass{
+					{Name: "small"},
+					{Name: "small"},
+				},
+			},
+		},
+		"HasReplicasAndAutoscaling": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentClasses: []AgentDeploymentClass{
+					{
+						Name: "small",
+						AgentDeployment: AgentDeployment{
+							Replicas: pointer.PointerOf(int32(1)),
+						},
+						Autoscaling: &AgentDeploymentAutoscaling{},
+					},
+				},
+			},
+		},
+		"HasInvalidTargetWorkspace": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentClasses: []AgentDeploymentClass{
+					{
+						Name: "small",
+						Autoscaling: &AgentDeploymentAutoscaling{
+							TargetWorkspaces: &[]TargetWorkspace{{}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAgentDeploymentClasses()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateAgentPoolSpecExternalAutoscaling(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]AgentPool{
+		"HasAgentDeployment": {
+			Spec: AgentPoolSpec{
+				AgentDeployment:     &AgentDeployment{},
+				ExternalAutoscaling: &AgentExternalAutoscaling{},
+			},
+		},
+		"HasTargetWorkspaces": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{},
+				ExternalAutoscaling: &AgentExternalAutoscaling{
+					TargetWorkspaces: &[]TargetWorkspace{
+						{Name: "this"},
+						{WildcardName: "this-*"},
+					},
+				},
+			},
+		},
+		"HasNoExternalAutoscaling": {
+			Spec: AgentPoolSpec{
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecExternalAutoscaling()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]AgentPool{
+		"HasNoAgentDeployment": {
+			Spec: AgentPoolSpec{
+				ExternalAutoscaling: &AgentExternalAutoscaling{},
+			},
+		},
+		"HasAutoscaling": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{},
+				AgentDeploymentAutoscaling: &AgentDeploymentAutoscaling{
+					MaxReplicas: pointer.PointerOf(int32(5)),
+					MinReplicas: pointer.PointerOf(int32(0)),
+				},
+				ExternalAutoscaling: &AgentExternalAutoscaling{},
+			},
+		},
+		"HasInvalidTargetWorkspace": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{},
+				ExternalAutoscaling: &AgentExternalAutoscaling{
+					TargetWorkspaces: &[]TargetWorkspace{
+						{ID: "ws-this", Name: "this"},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecExternalAutoscaling()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateAgentPoolSpecAgentConfig(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]AgentPool{
+		"HasFullConfig": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{
+					Config: &AgentConfig{
+						LogLevel:          "debug",
+						Single:            pointer.PointerOf(true),
+						RequestForwarding: pointer.PointerOf(true),
+						Hooks:             &AgentHooks{ConfigMapName: "hooks"},
+						OpenTelemetry:     &AgentOpenTelemetry{Address: "otel:4317", CertFile: "/etc/otel/ca.crt"},
+						DataDir:           "/data",
+						CacheDir:          "/cache",
+						CacheVolume:       &AgentCacheVolume{ClaimName: "cache"},
+					},
+				},
+			},
+		},
+		"HasContainerEnv": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{
+					Spec: &corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: "tfc-agent",
+								Env:  []corev1.EnvVar{{Name: "TFC_AGENT_LOG_LEVEL", Value: "debug"}},
+							},
+						},
+					},
+					Config: &AgentConfig{
+						Single: pointer.PointerOf(true),
+					},
+				},
+			},
+		},
+		"HasNoConfig": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := validateAgentConfig(c.Spec.AgentDeployment, field.NewPath("spec").Child("agentDeployment"))
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]AgentPool{
+		"HasRelativeDataDir": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{
+					Config: &AgentConfig{DataDir: "data"},
+				},
+			},
+		},
+		"HasRelativeCacheDir": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{
+					Config: &AgentConfig{CacheDir: "cache"},
+				},
+			},
+		},
+		"HasCacheVolumeWithoutCacheDir": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{
+					Config: &AgentConfig{CacheVolume: &AgentCacheVolume{ClaimName: "cache"}},
+				},
+			},
+		},
+		"HasCacheVolumeClaimNameAndTemplate": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{
+					Config: &AgentConfig{
+						CacheDir: "/cache",
+						CacheVolume: &AgentCacheVolume{
+							ClaimName:           "cache",
+							VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{},
+						},
+					},
+				},
+			},
+		},
+		"HasEmptyCacheVolume": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{
+					Config: &AgentConfig{
+						CacheDir:    "/cache",
+						CacheVolume: &AgentCacheVolume{},
+					},
+				},
+			},
+		},
+		"HasConflictingContainerEnv": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{
+					Spec: &corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: "tfc-agent",
+								Env:  []corev1.EnvVar{{Name: "TFC_AGENT_LOG_LEVEL", Value: "debug"}},
+							},
+						},
+					},
+					Config: &AgentConfig{LogLevel: "info"},
+				},
+			},
+		},
+		"HasReservedVolumeName": {
+			Spec: AgentPoolSpec{
+				AgentDeployment: &AgentDeployment{
+					Spec: &corev1.PodSpec{
+						Volumes: []corev1.Volume{{Name: "tfc-agent-hooks"}},
+					},
+					Config: &AgentConfig{Hooks: &AgentHooks{ConfigMapName: "hooks"}},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := validateAgentConfig(c.Spec.AgentDeployment, field.NewPath("spec").Child("agentDeployment"))
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
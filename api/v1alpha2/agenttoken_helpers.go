@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"strings"
+	"text/template"
+)
+
+const defaultAgentTokenSecretKey = "{{ .Name }}"
+
+// AgentTokenSecretKeyData is the data of the Secret key template of an agent token.
+type AgentTokenSecretKeyData struct {
+	Name          string
+	AgentPoolID   string
+	AgentPoolName string
+}
+
+var agentTokenSecretKeyFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	// replace is meant to be used in pipelines, e.g. `{{ .Name | replace "-" "_" }}`.
+	"replace": func(old, new, s string) string {
+		return strings.ReplaceAll(s, old, new)
+	},
+}
+
+// secretKeyTemplate parses the Secret key template.
+func (t *AgentToken) secretKeyTemplate() (*template.Template, error) {
+	key := defaultAgentTokenSecretKey
+	if t.Spec.SecretTemplate != nil && t.Spec.SecretTemplate.Key != "" {
+		key = t.Spec.SecretTemplate.Key
+	}
+
+	return template.New("key").Funcs(agentTokenSecretKeyFuncs).Option("missingkey=error").Parse(key)
+}
+
+// SecretKey returns the Secret key of the agent token with the given name.
+func (t *AgentToken) SecretKey(name string) (string, error) {
+	tmpl, err := t.secretKeyTemplate()
+	if err != nil {
+		return "", err
+	}
+
+	data := AgentTokenSecretKeyData{
+		Name: name,
+	}
+	if t.Status.AgentPool != nil {
+		data.AgentPoolID = t.Status.AgentPool.ID
+		data.AgentPoolName = t.Status.AgentPool.Name
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
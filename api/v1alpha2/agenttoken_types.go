@@ -28,6 +28,33 @@ const (
 	AgentTokenDeletionPolicyDestroy AgentTokenDeletionPolicy = "destroy"
 )
 
+// AgentTokenSecretTemplate defines how the controller renders the Kubernetes Secret with the agent tokens.
+type AgentTokenSecretTemplate struct {
+	// Go template of the Secret key of each agent token.
+	// The template has access to the fields `.Name`, `.AgentPoolID`, and `.AgentPoolName`,
+	// and to the functions `upper`, `lower`, and `replace`.
+	// For example: `TFC_AGENT_TOKEN_{{ .Name | upper | replace "-" "_" }}`.
+	// Default: `{{ .Name }}`.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Key string `json:"key,omitempty"`
+	// Labels to add to the Kubernetes Secret.
+	//
+	//+optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations to add to the Kubernetes Secret.
+	//
+	//+optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Secret key of an additional dotenv file with all agent tokens.
+	// Each agent token is a `<key>=<token>` line, where `<key>` is rendered from the `key` template.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	DotEnvKey string `json:"dotEnvKey,omitempty"`
+}
+
 // AgentTokenSpec defines the desired state of AgentToken.
 type AgentTokenSpec struct {
 	// Organization name where the Workspace will be created.
@@ -63,11 +90,32 @@ type AgentTokenSpec struct {
 	//
 	//+kubebuilder:validation:MinItems:=1
 	AgentTokens []AgentAPIToken `json:"agentTokens"`
+	// Agent token rotation settings.
+	//
+	//+optional
+	Rotation *AgentTokenRotation `json:"rotation,omitempty"`
+	// Stale agent tokens settings.
+	// The controller reports agent tokens in the agent pool that have not been used for the configured duration and optionally revokes them.
+	// With the `merge` management policy, only the agent tokens managed by this resource are revoked.
+	//
+	//+optional
+	StaleTokens *StaleAgentTokens `json:"staleTokens,omitempty"`
 	// secretName specifies the name of the Kubernetes Secret
 	// where the HCP Terraform Agent tokens are stored.
 	//
 	//+kubebuilder:validation:MinLength:=1
 	SecretName string `json:"secretName"`
+	// Template of the Kubernetes Secret where the HCP Terraform Agent tokens are stored.
+	//
+	//+optional
+	SecretTemplate *AgentTokenSecretTemplate `json:"secretTemplate,omitempty"`
+	// Selects namespaces to copy the Kubernetes Secret `secretName` to, in addition to the namespace of this resource.
+	// Only namespaces labelled with `agenttoken.app.terraform.io/accept-secrets: "true"` are selected.
+	// The copies are kept in sync with the Secret and removed when a namespace is no longer selected.
+	// The namespaces must be watched by the operator.
+	//
+	//+optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
 // AgentTokenStatus defines the observed state of AgentToken.
@@ -80,6 +128,26 @@ type AgentTokenStatus struct {
 	//
 	//+optional
 	AgentTokens []*AgentAPIToken `json:"agentTokens,omitempty"`
+	// Agent tokens in the agent pool that have not been used for the duration configured in `spec.staleTokens`.
+	//
+	//+optional
+	StaleTokens []StaleAgentToken `json:"staleTokens,omitempty"`
+	// Keys of the agent tokens in the Kubernetes Secret by agent token name.
+	//
+	//+optional
+	SecretKeys map[string]string `json:"secretKeys,omitempty"`
+	// Keys of the labels the controller set on the Kubernetes Secret.
+	//
+	//+optional
+	SecretLabels []string `json:"secretLabels,omitempty"`
+	// Keys of the annotations the controller set on the Kubernetes Secret.
+	//
+	//+optional
+	SecretAnnotations []string `json:"secretAnnotations,omitempty"`
+	// Namespaces where the Kubernetes Secret is copied to.
+	//
+	//+optional
+	ProjectedNamespaces []string `json:"projectedNamespaces,omitempty"`
 }
 
 // +kubebuilder:object:root=true
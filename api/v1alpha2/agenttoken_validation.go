@@ -7,7 +7,9 @@ import (
 	"fmt"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -15,6 +17,10 @@ func (t *AgentToken) ValidateSpec() error {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, t.validateSpecAgentTokens()...)
+	allErrs = append(allErrs, validateAgentTokenRotation(t.Spec.Rotation, field.NewPath("spec").Child("rotation"))...)
+	allErrs = append(allErrs, validateStaleAgentTokens(t.Spec.StaleTokens, field.NewPath("spec").Child("staleTokens"))...)
+	allErrs = append(allErrs, t.validateSpecSecretTemplate()...)
+	allErrs = append(allErrs, t.validateSpecNamespaceSelector()...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -52,6 +58,18 @@ func (t *AgentToken) validateSpecAgentTokens() field.ErrorList {
 				"lastUsedAt is not allowed in the spec"),
 			)
 		}
+		if at.RotatedAt != nil {
+			allErrs = append(allErrs, field.Forbidden(
+				f.Child("rotatedAt"),
+				"rotatedAt is not allowed in the spec"),
+			)
+		}
+		if at.PreviousID != "" {
+			allErrs = append(allErrs, field.Forbidden(
+				f.Child("previousID"),
+				"previousID is not allowed in the spec"),
+			)
+		}
 
 		if _, ok := atn[at.Name]; ok {
 			allErrs = append(allErrs, field.Duplicate(f.Child("name"), at.Name))
@@ -61,3 +79,67 @@ func (t *AgentToken) validateSpecAgentTokens() field.ErrorList {
 
 	return allErrs
 }
+
+// validateSpecSecretTemplate validates the following:
+//   - key is a valid template that renders valid and unique Secret keys.
+//   - dotEnvKey is a valid Secret key that does not clash with the keys of agent tokens.
+//   - labels and annotations have non-empty keys and values.
+func (t *AgentToken) validateSpecSecretTemplate() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := t.Spec.SecretTemplate
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("secretTemplate")
+
+	if _, err := t.secretKeyTemplate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(f.Child("key"), spec.Key, err.Error()))
+		return allErrs
+	}
+
+	keys := make(map[string]struct{}, len(t.Spec.AgentTokens))
+	for _, at := range t.Spec.AgentTokens {
+		key, err := t.SecretKey(at.Name)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(f.Child("key"), spec.Key, err.Error()))
+			continue
+		}
+		for _, msg := range validation.IsConfigMapKey(key) {
+			allErrs = append(allErrs, field.Invalid(f.Child("key"), key, msg))
+		}
+		if _, ok := keys[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Child("key"), key))
+		}
+		keys[key] = struct{}{}
+	}
+
+	if spec.DotEnvKey != "" {
+		for _, msg := range validation.IsConfigMapKey(spec.DotEnvKey) {
+			allErrs = append(allErrs, field.Invalid(f.Child("dotEnvKey"), spec.DotEnvKey, msg))
+		}
+		if _, ok := keys[spec.DotEnvKey]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Child("dotEnvKey"), spec.DotEnvKey))
+		}
+	}
+
+	allErrs = append(allErrs, validateDeploymentLabels(spec.Labels, f.Child("labels"))...)
+	allErrs = append(allErrs, validateDeploymentAnnotations(spec.Annotations, f.Child("annotations"))...)
+
+	return allErrs
+}
+
+func (t *AgentToken) validateSpecNamespaceSelector() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if t.Spec.NamespaceSelector == nil {
+		return allErrs
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(t.Spec.NamespaceSelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("namespaceSelector"), t.Spec.NamespaceSelector.String(), err.Error()))
+	}
+
+	return allErrs
+}
@@ -5,9 +5,12 @@ package v1alpha2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 func TestValidateAgentTokenSpecAgentToken(t *testing.T) {
@@ -75,6 +78,26 @@ func TestValidateAgentTokenSpecAgentToken(t *testing.T) {
 				},
 			},
 		},
+		"HasRotatedAt": {
+			Spec: AgentTokenSpec{
+				AgentTokens: []AgentAPIToken{
+					{
+						Name:      "this",
+						RotatedAt: pointer.PointerOf(int64(1984)),
+					},
+				},
+			},
+		},
+		"HasPreviousID": {
+			Spec: AgentTokenSpec{
+				AgentTokens: []AgentAPIToken{
+					{
+						Name:       "this",
+						PreviousID: "at-this",
+					},
+				},
+			},
+		},
 		"HasDuplicateName": {
 			Spec: AgentTokenSpec{
 				AgentTokens: []AgentAPIToken{
@@ -96,3 +119,210 @@ func TestValidateAgentTokenSpecAgentToken(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAgentTokenSpecRotation(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]*AgentTokenRotation{
+		"HasMaxAge": {
+			MaxAge: metav1.Duration{Duration: 720 * time.Hour},
+		},
+		"HasOverlapPeriod": {
+			MaxAge:        metav1.Duration{Duration: 720 * time.Hour},
+			OverlapPeriod: &metav1.Duration{Duration: time.Hour},
+		},
+		"HasZeroOverlapPeriod": {
+			MaxAge:        metav1.Duration{Duration: 720 * time.Hour},
+			OverlapPeriod: &metav1.Duration{},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := validateAgentTokenRotation(c, field.NewPath("spec").Child("rotation"))
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]*AgentTokenRotation{
+		"HasZeroMaxAge": {
+			MaxAge: metav1.Duration{},
+		},
+		"HasNegativeOverlapPeriod": {
+			MaxAge:        metav1.Duration{Duration: 720 * time.Hour},
+			OverlapPeriod: &metav1.Duration{Duration: -time.Hour},
+		},
+		"HasOverlapPeriodLongerThanMaxAge": {
+			MaxAge:        metav1.Duration{Duration: time.Hour},
+			OverlapPeriod: &metav1.Duration{Duration: 2 * time.Hour},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := validateAgentTokenRotation(c, field.NewPath("spec").Child("rotation"))
+			assert.NotEmpty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+}
+
+func TestValidateAgentTokenSpecStaleTokens(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]*StaleAgentTokens{
+		"HasUnusedFor": {
+			UnusedFor: metav1.Duration{Duration: 2160 * time.Hour},
+		},
+		"HasRevoke": {
+			UnusedFor: metav1.Duration{Duration: 2160 * time.Hour},
+			Revoke:    true,
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := validateStaleAgentTokens(c, field.NewPath("spec").Child("staleTokens"))
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]*StaleAgentTokens{
+		"HasZeroUnusedFor": {
+			UnusedFor: metav1.Duration{},
+		},
+		"HasNegativeUnusedFor": {
+			UnusedFor: metav1.Duration{Duration: -time.Hour},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := validateStaleAgentTokens(c, field.NewPath("spec").Child("staleTokens"))
+			assert.NotEmpty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+}
+
+func TestValidateAgentTokenSpecSecretTemplate(t *testing.T) {
+	t.Parallel()
+
+	tokens := []AgentAPIToken{{Name: "token-a"}, {Name: "token-b"}}
+
+	successCases := map[string]AgentToken{
+		"HasNoTemplate": {
+			Spec: AgentTokenSpec{
+				AgentTokens: tokens,
+			},
+		},
+		"HasKey": {
+			Spec: AgentTokenSpec{
+				AgentTokens: tokens,
+				SecretTemplate: &AgentTokenSecretTemplate{
+					Key: `TFC_AGENT_TOKEN_{{ .Name | upper | replace "-" "_" }}`,
+				},
+			},
+		},
+		"HasDotEnvKey": {
+			Spec: AgentTokenSpec{
+				AgentTokens: tokens,
+				SecretTemplate: &AgentTokenSecretTemplate{
+					DotEnvKey: "agents.env",
+				},
+			},
+		},
+		"HasLabelsAndAnnotations": {
+			Spec: AgentTokenSpec{
+				AgentTokens: tokens,
+				SecretTemplate: &AgentTokenSecretTemplate{
+					Labels:      map[string]string{"app": "agents"},
+					Annotations: map[string]string{"team": "platform"},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecSecretTemplate()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]AgentToken{
+		"HasInvalidTemplate": {
+			Spec: AgentTokenSpec{
+				AgentTokens: tokens,
+				SecretTemplate: &AgentTokenSecretTemplate{
+					Key: "{{ .Name",
+				},
+			},
+		},
+		"HasUnknownField": {
+			Spec: AgentTokenSpec{
+				AgentTokens: tokens,
+				SecretTemplate: &AgentTokenSecretTemplate{
+					Key: "{{ .Description }}",
+				},
+			},
+		},
+		"HasInvalidKey": {
+			Spec: AgentTokenSpec{
+				AgentTokens: tokens,
+				SecretTemplate: &AgentTokenSecretTemplate{
+					Key: "token/{{ .Name }}",
+				},
+			},
+		},
+		"HasDuplicateKey": {
+			Spec: AgentTokenSpec{
+				AgentTokens: tokens,
+				SecretTemplate: &AgentTokenSecretTemplate{
+					Key: "token",
+				},
+			},
+		},
+		"HasDotEnvKeyClash": {
+			Spec: AgentTokenSpec{
+				AgentTokens: tokens,
+				SecretTemplate: &AgentTokenSecretTemplate{
+					DotEnvKey: "token-a",
+				},
+			},
+		},
+		"HasEmptyLabelValue": {
+			Spec: AgentTokenSpec{
+				AgentTokens: tokens,
+				SecretTemplate: &AgentTokenSecretTemplate{
+					Labels: map[string]string{"app": ""},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecSecretTemplate()
+			assert.NotEmpty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+}
+
+func TestValidateAgentTokenSpecNamespaceSelector(t *testing.T) {
+	t.Parallel()
+
+	valid := AgentToken{
+		Spec: AgentTokenSpec{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"agents": "true"}},
+		},
+	}
+	assert.Empty(t, valid.validateSpecNamespaceSelector())
+
+	invalid := AgentToken{
+		Spec: AgentTokenSpec{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "agents", Operator: "Unknown"}},
+			},
+		},
+	}
+	assert.NotEmpty(t, invalid.validateSpecNamespaceSelector())
+}
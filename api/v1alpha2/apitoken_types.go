@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APITokenRotation defines when the operator rotates the API token.
+type APITokenRotation struct {
+	// ExpirationPeriodSeconds is the lifetime of an issued API token. Defaults to 2592000 (30 days).
+	//
+	//+kubebuilder:validation:Minimum:=3600
+	//+kubebuilder:default:=2592000
+	//+optional
+	ExpirationPeriodSeconds *int32 `json:"expirationPeriodSeconds,omitempty"`
+	// RenewBeforeSeconds is the time before the API token expiration when the operator issues a new token. Defaults to 604800 (7 days).
+	// Must be less than `expirationPeriodSeconds`.
+	//
+	//+kubebuilder:validation:Minimum:=0
+	//+kubebuilder:default:=604800
+	//+optional
+	RenewBeforeSeconds *int32 `json:"renewBeforeSeconds,omitempty"`
+	// OverlapSeconds is the time the previous API token stays valid after the new one is written to the Secret. Defaults to 3600.
+	// It gives consumers of the Secret time to pick up the new API token before the previous one is revoked.
+	// Must not be greater than `renewBeforeSeconds`.
+	// Applies only to team API tokens, since an organization can have only one organization API token.
+	//
+	//+kubebuilder:validation:Minimum:=0
+	//+kubebuilder:default:=3600
+	//+optional
+	OverlapSeconds *int32 `json:"overlapSeconds,omitempty"`
+}
+
+// The Deletion Policy defines how the issued API tokens should be handled when the custom resource is deleted.
+//   - `retain`: When the custom resource is deleted, the operator will remove only the resource itself.
+//     The API token will remain active on the HCP Terraform side.
+//   - `destroy`: The operator will attempt to revoke the API tokens issued by it.
+type APITokenDeletionPolicy string
+
+const (
+	APITokenDeletionPolicyRetain  APITokenDeletionPolicy = "retain"
+	APITokenDeletionPolicyDestroy APITokenDeletionPolicy = "destroy"
+)
+
+// APITokenSpec defines the desired state of APIToken.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/api-tokens
+type APITokenSpec struct {
+	// Organization name where the API token will be issued.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Organization string `json:"organization"`
+	// API Token to be used for API calls.
+	// It can refer to the Secret managed by this resource, in this case the operator uses the latest issued API token.
+	Token Token `json:"token"`
+	// Team to issue the API token for.
+	// If not set, the operator issues an organization API token.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/api-tokens#team-api-tokens
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/api-tokens#organization-api-tokens
+	//
+	//+optional
+	Team *Team `json:"team,omitempty"`
+	// Rotation settings of the API token.
+	//
+	//+kubebuilder:default:={}
+	//+optional
+	Rotation APITokenRotation `json:"rotation,omitempty"`
+	// SecretName is the name of the Kubernetes Secret where the API token is stored.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	SecretName string `json:"secretName"`
+	// SecretKey is the key of the Kubernetes Secret where the API token is stored.
+	// Default: `token`.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+kubebuilder:default:=token
+	//+optional
+	SecretKey string `json:"secretKey,omitempty"`
+	// The Deletion Policy defines how the issued API tokens should be handled when the custom resource is deleted.
+	// - `retain`: When the custom resource is deleted, the operator will remove only the resource itself.
+	//   The API token will remain active on the HCP Terraform side.
+	// - `destroy`: The operator will attempt to revoke the API tokens issued by it.
+	// Default: `retain`.
+	//
+	//+kubebuilder:validation:Enum:=retain;destroy
+	//+kubebuilder:default=retain
+	//+optional
+	DeletionPolicy APITokenDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// APITokenStatus defines the observed state of APIToken.
+type APITokenStatus struct {
+	// Real world state generation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// Current API token ID.
+	//
+	//+optional
+	ID string `json:"id,omitempty"`
+	// Team ID the API token is issued for.
+	//
+	//+optional
+	TeamID string `json:"teamID,omitempty"`
+	// Timestamp when the current API token was issued.
+	//
+	//+optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	// Timestamp when the current API token expires.
+	//
+	//+optional
+	ExpiredAt *metav1.Time `json:"expiredAt,omitempty"`
+	// Timestamp of the last API token rotation.
+	//
+	//+optional
+	RotatedAt *metav1.Time `json:"rotatedAt,omitempty"`
+	// Previous API token ID that is still valid during the overlap period.
+	//
+	//+optional
+	PreviousID string `json:"previousID,omitempty"`
+	// Timestamp when the previous API token will be revoked.
+	//
+	//+optional
+	PreviousRevokeAt *metav1.Time `json:"previousRevokeAt,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Token ID",type=string,JSONPath=`.status.id`
+//+kubebuilder:printcolumn:name="Expires At",type=string,JSONPath=`.status.expiredAt`
+//+kubebuilder:printcolumn:name="Secret",type=string,JSONPath=`.spec.secretName`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.1.0"
+
+// APIToken manages HCP Terraform team and organization API tokens and stores them in a Kubernetes Secret.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/api-tokens
+type APIToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   APITokenSpec   `json:"spec"`
+	Status APITokenStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// APITokenList contains a list of APIToken.
+type APITokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIToken `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&APIToken{}, &APITokenList{})
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (t *APIToken) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, t.validateSpecTeam()...)
+	allErrs = append(allErrs, t.validateSpecRotation()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "APIToken"},
+		t.Name,
+		allErrs,
+	)
+}
+
+func (t *APIToken) validateSpecTeam() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := t.Spec.Team
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("team")
+
+	if spec.ID == "" && spec.Name == "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"one of the field ID or Name must be set"),
+		)
+	}
+
+	if spec.ID != "" && spec.Name != "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"only one of the field ID or Name is allowed"),
+		)
+	}
+
+	return allErrs
+}
+
+// validateSpecRotation validates the following:
+//   - renewBeforeSeconds is less than expirationPeriodSeconds.
+//   - overlapSeconds is not greater than renewBeforeSeconds.
+func (t *APIToken) validateSpecRotation() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := t.Spec.Rotation
+
+	f := field.NewPath("spec").Child("rotation")
+
+	if spec.ExpirationPeriodSeconds != nil && spec.RenewBeforeSeconds != nil {
+		if *spec.RenewBeforeSeconds >= *spec.ExpirationPeriodSeconds {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("renewBeforeSeconds"),
+				*spec.RenewBeforeSeconds,
+				"must be less than 'spec.rotation.expirationPeriodSeconds'"),
+			)
+		}
+	}
+
+	if spec.RenewBeforeSeconds != nil && spec.OverlapSeconds != nil {
+		if *spec.OverlapSeconds > *spec.RenewBeforeSeconds {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("overlapSeconds"),
+				*spec.OverlapSeconds,
+				"must not be greater than 'spec.rotation.renewBeforeSeconds'"),
+			)
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+func TestValidateAPITokenSpecTeam(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]APIToken{
+		"HasNoTeam": {
+			Spec: APITokenSpec{},
+		},
+		"HasTeamID": {
+			Spec: APITokenSpec{
+				Team: &Team{
+					ID: "team-this",
+				},
+			},
+		},
+		"HasTeamName": {
+			Spec: APITokenSpec{
+				Team: &Team{
+					Name: "this",
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecTeam()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]APIToken{
+		"HasEmptyTeam": {
+			Spec: APITokenSpec{
+				Team: &Team{},
+			},
+		},
+		"HasTeamIDAndName": {
+			Spec: APITokenSpec{
+				Team: &Team{
+					ID:   "team-this",
+					Name: "this",
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecTeam()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateAPITokenSpecRotation(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]APIToken{
+		"HasDefaults": {
+			Spec: APITokenSpec{
+				Rotation: APITokenRotation{
+					ExpirationPeriodSeconds: pointer.PointerOf(int32(2592000)),
+					RenewBeforeSeconds:      pointer.PointerOf(int32(604800)),
+					OverlapSeconds:          pointer.PointerOf(int32(3600)),
+				},
+			},
+		},
+		"HasOverlapEqualRenewBefore": {
+			Spec: APITokenSpec{
+				Rotation: APITokenRotation{
+					ExpirationPeriodSeconds: pointer.PointerOf(int32(7200)),
+					RenewBeforeSeconds:      pointer.PointerOf(int32(3600)),
+					OverlapSeconds:          pointer.PointerOf(int32(3600)),
+				},
+			},
+		},
+		"HasNoRotation": {
+			Spec: APITokenSpec{},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecRotation()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]APIToken{
+		"HasRenewBeforeEqualExpirationPeriod": {
+			Spec: APITokenSpec{
+				Rotation: APITokenRotation{
+					ExpirationPeriodSeconds: pointer.PointerOf(int32(3600)),
+					RenewBeforeSeconds:      pointer.PointerOf(int32(3600)),
+				},
+			},
+		},
+		"HasOverlapGreaterRenewBefore": {
+			Spec: APITokenSpec{
+				Rotation: APITokenRotation{
+					RenewBeforeSeconds: pointer.PointerOf(int32(3600)),
+					OverlapSeconds:     pointer.PointerOf(int32(7200)),
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecRotation()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
@@ -49,3 +49,16 @@ func runApplied(status string) bool {
 
 	return false
 }
+
+func (us *NoCodeWorkspaceUpgradeStatus) RunCompleted() bool {
+	return runCompleted(us.Status)
+}
+
+func (us *NoCodeWorkspaceUpgradeStatus) RunApplied() bool {
+	return runApplied(us.Status)
+}
+
+// Failed returns true if the upgrade did not start a run or the upgrade run completed without being applied.
+func (us *NoCodeWorkspaceUpgradeStatus) Failed() bool {
+	return us.RunID == "" || (us.RunCompleted() && !us.RunApplied())
+}
@@ -149,6 +149,14 @@ type ModuleSpec struct {
 	//+kubebuilder:default:=retain
 	//+optional
 	DeletionPolicy ModuleDeletionPolicy `json:"deletionPolicy,omitempty"`
+	// The number of the most recent runs to retain in `status.runHistory`. Set to `0` to disable the run history.
+	// Default: `10`.
+	//
+	//+kubebuilder:validation:Minimum:=0
+	//+kubebuilder:validation:Maximum:=100
+	//+kubebuilder:default:=10
+	//+optional
+	RunHistoryLimit *int32 `json:"runHistoryLimit,omitempty"`
 }
 
 // ModuleStatus defines the observed state of Module.
@@ -172,12 +180,18 @@ type ModuleStatus struct {
 	//
 	//+optional
 	DestroyRunID string `json:"destroyRunID,omitempty"`
+	// Recent runs of the workspace where the module is running.
+	//
+	//+optional
+	RunHistory *RunHistoryStatus `json:"runHistory,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="CV Status",type=string,JSONPath=`.status.configurationVersion.status`
 //+kubebuilder:printcolumn:name="Run Status",type=string,JSONPath=`.status.run.status`
+//+kubebuilder:printcolumn:name="Errored Runs",type=integer,JSONPath=`.status.runHistory.errored`,priority=1
+//+kubebuilder:printcolumn:name="History Updated",type=date,JSONPath=`.status.runHistory.updatedAt`,priority=1
 //+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v25.4.0"
 
 // Module implements API-driven Run Workflows.
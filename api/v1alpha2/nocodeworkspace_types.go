@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NoCodeRegistryModule refers to a no-code enabled module in the organization's private registry.
+type NoCodeRegistryModule struct {
+	// Name of the registry module.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Provider of the registry module.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Provider string `json:"provider"`
+}
+
+// NoCodeModuleRef refers to the no-code module to provision the workspace from.
+// Only one of the fields `ID` or `RegistryModule` is allowed.
+// At least one of the fields `ID` or `RegistryModule` is mandatory.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/no-code-provisioning/module-design
+type NoCodeModuleRef struct {
+	// No-code module ID.
+	// Must match pattern: `^nocode-[a-zA-Z0-9]+$`
+	//
+	//+kubebuilder:validation:Pattern:="^nocode-[a-zA-Z0-9]+$"
+	//+optional
+	ID string `json:"id,omitempty"`
+	// Private registry module with the no-code provisioning enabled.
+	//
+	//+optional
+	RegistryModule *NoCodeRegistryModule `json:"registryModule,omitempty"`
+}
+
+// The Deletion Policy specifies the behavior of the custom resource and its associated workspace when the custom resource is deleted.
+//   - `retain`: When you delete the custom resource, the operator does not delete the workspace.
+//   - `soft`: Attempts to delete the associated workspace only if it does not contain any managed resources.
+//   - `force`: Forcefully deletes the workspace, even if it has managed resources. The resources remain in the cloud provider.
+type NoCodeWorkspaceDeletionPolicy string
+
+const (
+	NoCodeWorkspaceDeletionPolicyRetain NoCodeWorkspaceDeletionPolicy = "retain"
+	NoCodeWorkspaceDeletionPolicySoft   NoCodeWorkspaceDeletionPolicy = "soft"
+	NoCodeWorkspaceDeletionPolicyForce  NoCodeWorkspaceDeletionPolicy = "force"
+)
+
+// NoCodeWorkspaceSpec defines the desired state of NoCodeWorkspace.
+type NoCodeWorkspaceSpec struct {
+	// Organization name where the workspace will be provisioned.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Organization string `json:"organization"`
+	// API Token to be used for API calls.
+	Token Token `json:"token"`
+	// No-code module to provision the workspace from.
+	Module NoCodeModuleRef `json:"module"`
+	// Version of the registry module to provision the workspace from.
+	// It must match the version pin of the no-code module. The version pin is shared by all workspaces provisioned from the no-code module,
+	// thus the operator does not change it and reports an error if it differs from this version.
+	// If not set, the current version pin of the no-code module is used.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/no-code-provisioning/provisioning#upgrade-a-no-code-workspace
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Version string `json:"version,omitempty"`
+	// Workspace name.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Workspace description.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Description string `json:"description,omitempty"`
+	// Project where the workspace will be created.
+	// Default: default organization project.
+	//
+	//+optional
+	Project *WorkspaceProject `json:"project,omitempty"`
+	// Define either change will be applied automatically(auto) or require an operator to confirm(manual).
+	// Must be one of the following values: `auto`, `manual`.
+	// Default: `manual`.
+	//
+	//+kubebuilder:validation:Pattern:="^(auto|manual)$"
+	//+kubebuilder:default=manual
+	//+optional
+	ApplyMethod string `json:"applyMethod,omitempty"`
+	// Define where the Terraform code will be executed.
+	// Must be one of the following values: `agent`, `remote`.
+	// Default: `remote`.
+	//
+	//+kubebuilder:validation:Pattern:="^(agent|remote)$"
+	//+kubebuilder:default=remote
+	//+optional
+	ExecutionMode string `json:"executionMode,omitempty"`
+	// HCP Terraform Agents allow HCP Terraform to communicate with isolated, private, or on-premises infrastructure.
+	// Must be set if `executionMode` is `agent`.
+	//
+	//+optional
+	AgentPool *AgentPoolRef `json:"agentPool,omitempty"`
+	// Terraform variables to set on the workspace.
+	// If the no-code module restricts the variable to a list of options, the value must be one of them.
+	// The operator starts a workspace upgrade when the variables change.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	TerraformVariables []Variable `json:"terraformVariables,omitempty"`
+	// Workspace outputs to store in ConfigMap(non-sensitive) or Secret(sensitive).
+	// The name of the objects is `<metadata.name>-nocode-outputs`.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	Outputs bool `json:"outputs,omitempty"`
+	// The Deletion Policy specifies the behavior of the custom resource and its associated workspace when the custom resource is deleted.
+	// - `retain`: When you delete the custom resource, the operator does not delete the workspace.
+	// - `soft`: Attempts to delete the associated workspace only if it does not contain any managed resources.
+	// - `force`: Forcefully deletes the workspace, even if it has managed resources. The resources remain in the cloud provider.
+	// Default: `retain`.
+	//
+	//+kubebuilder:validation:Enum:=retain;soft;force
+	//+kubebuilder:default=retain
+	//+optional
+	DeletionPolicy NoCodeWorkspaceDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// NoCodeWorkspaceUpgradeStatus defines the observed state of the latest workspace upgrade.
+type NoCodeWorkspaceUpgradeStatus struct {
+	// Registry module version the workspace is upgraded to.
+	//
+	//+optional
+	Version string `json:"version,omitempty"`
+	// ID of the run that upgrades the workspace.
+	//
+	//+optional
+	RunID string `json:"runID,omitempty"`
+	// Workspace upgrade status.
+	// It reflects the status of the upgrade run once the run is known.
+	//
+	//+optional
+	Status string `json:"status,omitempty"`
+	// URL of the plan that upgrades the workspace.
+	//
+	//+optional
+	PlanURL string `json:"planURL,omitempty"`
+	// Message returned by HCP Terraform.
+	//
+	//+optional
+	Message string `json:"message,omitempty"`
+	// Generation of the NoCodeWorkspace the upgrade was started for.
+	// A failed upgrade is not retried until the generation or the version pin of the no-code module changes.
+	//
+	//+optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// NoCodeWorkspaceStatus defines the observed state of NoCodeWorkspace.
+type NoCodeWorkspaceStatus struct {
+	// Real world state generation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// No-code module ID.
+	//
+	//+optional
+	NoCodeModuleID string `json:"noCodeModuleID,omitempty"`
+	// Workspace ID.
+	//
+	//+optional
+	WorkspaceID string `json:"workspaceID,omitempty"`
+	// Registry module version the workspace is provisioned from.
+	// It is updated once the upgrade run is applied.
+	//
+	//+optional
+	Version string `json:"version,omitempty"`
+	// Workspace Runs status.
+	//
+	//+optional
+	Run *RunStatus `json:"run,omitempty"`
+	// Workspace outputs status.
+	//
+	//+optional
+	Output *OutputStatus `json:"output,omitempty"`
+	// Latest workspace upgrade status.
+	//
+	//+optional
+	Upgrade *NoCodeWorkspaceUpgradeStatus `json:"upgrade,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Workspace ID",type=string,JSONPath=`.status.workspaceID`
+//+kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+//+kubebuilder:printcolumn:name="Run Status",type=string,JSONPath=`.status.run.status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.1.0"
+
+// NoCodeWorkspace provisions an HCP Terraform workspace from a no-code module.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/no-code-provisioning/provisioning
+type NoCodeWorkspace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NoCodeWorkspaceSpec   `json:"spec"`
+	Status NoCodeWorkspaceStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// NoCodeWorkspaceList contains a list of NoCodeWorkspace.
+type NoCodeWorkspaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NoCodeWorkspace `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NoCodeWorkspace{}, &NoCodeWorkspaceList{})
+}
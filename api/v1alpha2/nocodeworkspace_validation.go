@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (w *NoCodeWorkspace) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, w.validateSpecModule()...)
+	allErrs = append(allErrs, w.validateSpecProject()...)
+	allErrs = append(allErrs, w.validateSpecAgentPool()...)
+	allErrs = append(allErrs, w.validateSpecExecutionMode()...)
+	allErrs = append(allErrs, w.validateSpecTerraformVariables()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "NoCodeWorkspace"},
+		w.Name,
+		allErrs,
+	)
+}
+
+func (w *NoCodeWorkspace) validateSpecModule() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := w.Spec.Module
+
+	f := field.NewPath("spec").Child("module")
+
+	if spec.ID == "" && spec.RegistryModule == nil {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"one of the field ID or RegistryModule must be set"),
+		)
+	}
+
+	if spec.ID != "" && spec.RegistryModule != nil {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"only one of the field ID or RegistryModule is allowed"),
+		)
+	}
+
+	return allErrs
+}
+
+func (w *NoCodeWorkspace) validateSpecProject() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := w.Spec.Project
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("project")
+
+	if spec.ID == "" && spec.Name == "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"one of the field ID or Name must be set"),
+		)
+	}
+
+	if spec.ID != "" && spec.Name != "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"only one of the field ID or Name is allowed"),
+		)
+	}
+
+	return allErrs
+}
+
+func (w *NoCodeWorkspace) validateSpecAgentPool() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := w.Spec.AgentPool
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("agentPool")
+	if w.Spec.ExecutionMode != "agent" {
+		allErrs = append(allErrs, field.Required(
+			f,
+			"'spec.executionMode' must be set to 'agent' when 'spec.agentPool' is set"),
+		)
+	}
+
+	if spec.ID == "" && spec.Name == "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"one of the field ID or Name must be set"),
+		)
+	}
+
+	if spec.ID != "" && spec.Name != "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"only one of the field ID or Name is allowed"),
+		)
+	}
+
+	return allErrs
+}
+
+func (w *NoCodeWorkspace) validateSpecExecutionMode() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := w.Spec.ExecutionMode
+
+	f := field.NewPath("spec").Child("executionMode")
+
+	if spec == "agent" && w.Spec.AgentPool == nil {
+		allErrs = append(allErrs, field.Required(
+			f,
+			"'spec.agentPool' must be set when 'spec.executionMode' is set to 'agent'"),
+		)
+	}
+
+	return allErrs
+}
+
+func (w *NoCodeWorkspace) validateSpecTerraformVariables() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := w.Spec.TerraformVariables
+
+	if spec == nil {
+		return allErrs
+	}
+
+	return validateSpecVariables(field.NewPath("spec").Child("terraformVariables"), spec)
+}
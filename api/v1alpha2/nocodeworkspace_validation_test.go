@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNoCodeWorkspaceSpecModule(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]NoCodeWorkspace{
+		"HasOnlyID": {
+			Spec: NoCodeWorkspaceSpec{
+				Module: NoCodeModuleRef{
+					ID: "nocode-this",
+				},
+			},
+		},
+		"HasOnlyRegistryModule": {
+			Spec: NoCodeWorkspaceSpec{
+				Module: NoCodeModuleRef{
+					RegistryModule: &NoCodeRegistryModule{
+						Name:     "this",
+						Provider: "aws",
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecModule()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]NoCodeWorkspace{
+		"HasIDAndRegistryModule": {
+			Spec: NoCodeWorkspaceSpec{
+				Module: NoCodeModuleRef{
+					ID: "nocode-this",
+					RegistryModule: &NoCodeRegistryModule{
+						Name:     "this",
+						Provider: "aws",
+					},
+				},
+			},
+		},
+		"HasEmptyModule": {
+			Spec: NoCodeWorkspaceSpec{
+				Module: NoCodeModuleRef{},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecModule()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateNoCodeWorkspaceSpecAgentPool(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]NoCodeWorkspace{
+		"HasOnlyID": {
+			Spec: NoCodeWorkspaceSpec{
+				AgentPool: &AgentPoolRef{
+					ID: "apool-this",
+				},
+				ExecutionMode: "agent",
+			},
+		},
+		"HasOnlyName": {
+			Spec: NoCodeWorkspaceSpec{
+				AgentPool: &AgentPoolRef{
+					Name: "this",
+				},
+				ExecutionMode: "agent",
+			},
+		},
+		"HasNoAgentPool": {
+			Spec: NoCodeWorkspaceSpec{
+				ExecutionMode: "remote",
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAgentPool()
+			errs = append(errs, c.validateSpecExecutionMode()...)
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]NoCodeWorkspace{
+		"HasIDAndName": {
+			Spec: NoCodeWorkspaceSpec{
+				AgentPool: &AgentPoolRef{
+					ID:   "apool-this",
+					Name: "this",
+				},
+				ExecutionMode: "agent",
+			},
+		},
+		"HasEmptyIDAndName": {
+			Spec: NoCodeWorkspaceSpec{
+				AgentPool:     &AgentPoolRef{},
+				ExecutionMode: "agent",
+			},
+		},
+		"HasInvalidExecutionMode": {
+			Spec: NoCodeWorkspaceSpec{
+				AgentPool: &AgentPoolRef{
+					Name: "this",
+				},
+				ExecutionMode: "remote",
+			},
+		},
+		"HasNoAgentPoolInAgentMode": {
+			Spec: NoCodeWorkspaceSpec{
+				ExecutionMode: "agent",
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAgentPool()
+			errs = append(errs, c.validateSpecExecutionMode()...)
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateNoCodeWorkspaceSpecTerraformVariables(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]NoCodeWorkspace{
+		"HasValue": {
+			Spec: NoCodeWorkspaceSpec{
+				TerraformVariables: []Variable{
+					{
+						Name:  "this",
+						Value: "this",
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecTerraformVariables()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]NoCodeWorkspace{
+		"HasDuplicateName": {
+			Spec: NoCodeWorkspaceSpec{
+				TerraformVariables: []Variable{
+					{
+						Name:  "this",
+						Value: "this",
+					},
+					{
+						Name:  "this",
+						Value: "that",
+					},
+				},
+			},
+		},
+		"HasNoValue": {
+			Spec: NoCodeWorkspaceSpec{
+				TerraformVariables: []Variable{
+					{
+						Name: "this",
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecTerraformVariables()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
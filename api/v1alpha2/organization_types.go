@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrganizationSession defines the user session settings of the organization.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations#user-sessions
+type OrganizationSession struct {
+	// Session timeout after inactivity in minutes.
+	// If not set, the operator does not manage this setting.
+	//
+	//+kubebuilder:validation:Minimum:=5
+	//+optional
+	Timeout *int `json:"timeout,omitempty"`
+	// Session expiration in minutes.
+	// If not set, the operator does not manage this setting.
+	//
+	//+kubebuilder:validation:Minimum:=5
+	//+optional
+	Remember *int `json:"remember,omitempty"`
+}
+
+// OrganizationSpec defines the desired state of Organization.
+// The operator only manages the settings that are set in the spec, all other settings are left intact.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations#general
+type OrganizationSpec struct {
+	// Name of an existing organization.
+	// The operator does not create or delete organizations.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// API Token to be used for API calls.
+	Token Token `json:"token"`
+	// Default execution mode for new workspaces in the organization.
+	// Must be one of the following values: `agent`, `local`, `remote`.
+	// If not set, the operator does not manage this setting.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations#default-execution-mode
+	//
+	//+kubebuilder:validation:Pattern:="^(agent|local|remote)$"
+	//+optional
+	DefaultExecutionMode string `json:"defaultExecutionMode,omitempty"`
+	// Default Agent Pool for new workspaces in the organization.
+	// Must be set when `defaultExecutionMode` is set to `agent`.
+	//
+	//+optional
+	DefaultAgentPool *AgentPoolRef `json:"defaultAgentPool,omitempty"`
+	// Whether cost estimation is enabled for all workspaces in the organization.
+	// If not set, the operator does not manage this setting.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/cost-estimation
+	//
+	//+optional
+	CostEstimationEnabled *bool `json:"costEstimationEnabled,omitempty"`
+	// Whether health assessments are enforced for all eligible workspaces in the organization.
+	// If not set, the operator does not manage this setting.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/health
+	//
+	//+optional
+	AssessmentsEnforced *bool `json:"assessmentsEnforced,omitempty"`
+	// Whether pending speculative plans from outdated commits are cancelled when a newer commit is pushed to the same branch.
+	// If not set, the operator does not manage this setting.
+	//
+	//+optional
+	SpeculativePlanManagementEnabled *bool `json:"speculativePlanManagementEnabled,omitempty"`
+	// User session settings.
+	//
+	//+optional
+	Session *OrganizationSession `json:"session,omitempty"`
+	// Authentication policy of the organization members.
+	// Must be one of the following values: `password`, `two_factor_mandatory`.
+	// If not set, the operator does not manage this setting.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations#authentication
+	//
+	//+kubebuilder:validation:Enum:=password;two_factor_mandatory
+	//+optional
+	CollaboratorAuthPolicy string `json:"collaboratorAuthPolicy,omitempty"`
+}
+
+// OrganizationStatus defines the observed state of Organization.
+type OrganizationStatus struct {
+	// Real world state generation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// Organization name.
+	//
+	//+optional
+	Name string `json:"name,omitempty"`
+	// Default project ID of the organization.
+	// New workspaces are created in this project when a project is not specified.
+	//
+	//+optional
+	DefaultProjectID string `json:"defaultProjectID,omitempty"`
+	// Default agent pool ID of the organization.
+	//
+	//+optional
+	DefaultAgentPoolID string `json:"defaultAgentPoolID,omitempty"`
+	// Settings that were last changed outside of the operator and reverted.
+	// The last detected drift is kept until the spec changes.
+	//
+	//+optional
+	Drift []string `json:"drift,omitempty"`
+	// Timestamp of the last detected drift.
+	//
+	//+optional
+	DriftDetectedAt *metav1.Time `json:"driftDetectedAt,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Organization",type=string,JSONPath=`.status.name`
+//+kubebuilder:printcolumn:name="Default Project ID",type=string,JSONPath=`.status.defaultProjectID`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.1.0"
+
+// Organization manages HCP Terraform Organization settings.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations
+type Organization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSpec   `json:"spec"`
+	Status OrganizationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OrganizationList contains a list of Organization.
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Organization `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Organization{}, &OrganizationList{})
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (o *Organization) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, o.validateSpecDefaultAgentPool()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "Organization"},
+		o.Name,
+		allErrs,
+	)
+}
+
+func (o *Organization) validateSpecDefaultAgentPool() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := o.Spec.DefaultAgentPool
+
+	f := field.NewPath("spec").Child("defaultAgentPool")
+
+	if spec == nil {
+		if o.Spec.DefaultExecutionMode == "agent" {
+			allErrs = append(allErrs, field.Required(
+				f,
+				"'spec.defaultAgentPool' must be set when 'spec.defaultExecutionMode' is set to 'agent'"),
+			)
+		}
+		return allErrs
+	}
+
+	if o.Spec.DefaultExecutionMode != "agent" {
+		allErrs = append(allErrs, field.Required(
+			f,
+			"'spec.defaultExecutionMode' must be set to 'agent' when 'spec.defaultAgentPool' is set"),
+		)
+	}
+
+	if spec.ID == "" && spec.Name == "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"one of the field ID or Name must be set"),
+		)
+	}
+
+	if spec.ID != "" && spec.Name != "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"only one of the field ID or Name is allowed"),
+		)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOrganizationSpecDefaultAgentPool(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]Organization{
+		"HasOnlyRemoteExecutionMode": {
+			Spec: OrganizationSpec{
+				DefaultExecutionMode: "remote",
+			},
+		},
+		"HasAgentPoolID": {
+			Spec: OrganizationSpec{
+				DefaultExecutionMode: "agent",
+				DefaultAgentPool: &AgentPoolRef{
+					ID: "apool-this",
+				},
+			},
+		},
+		"HasAgentPoolName": {
+			Spec: OrganizationSpec{
+				DefaultExecutionMode: "agent",
+				DefaultAgentPool: &AgentPoolRef{
+					Name: "this",
+				},
+			},
+		},
+		"HasNoExecutionMode": {
+			Spec: OrganizationSpec{},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecDefaultAgentPool()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]Organization{
+		"HasAgentExecutionModeWithoutAgentPool": {
+			Spec: OrganizationSpec{
+				DefaultExecutionMode: "agent",
+			},
+		},
+		"HasAgentPoolWithRemoteExecutionMode": {
+			Spec: OrganizationSpec{
+				DefaultExecutionMode: "remote",
+				DefaultAgentPool: &AgentPoolRef{
+					ID: "apool-this",
+				},
+			},
+		},
+		"HasEmptyAgentPool": {
+			Spec: OrganizationSpec{
+				DefaultExecutionMode: "agent",
+				DefaultAgentPool:     &AgentPoolRef{},
+			},
+		},
+		"HasAgentPoolIDAndName": {
+			Spec: OrganizationSpec{
+				DefaultExecutionMode: "agent",
+				DefaultAgentPool: &AgentPoolRef{
+					ID:   "apool-this",
+					Name: "this",
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecDefaultAgentPool()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SSH private key source.
+type OrganizationSSHKeyPrivateKey struct {
+	// Selects a key of a secret in the SSH key's namespace.
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef"`
+}
+
+// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete an SSH key, either manually or by a system event.
+//
+// You must use one of the following values:
+// - `retain`: When the custom resource is deleted, the operator will not delete the associated SSH key.
+// - `destroy`: The operator will attempt to remove the SSH key from HCP Terraform.
+type OrganizationSSHKeyDeletionPolicy string
+
+const (
+	OrganizationSSHKeyDeletionPolicyRetain  OrganizationSSHKeyDeletionPolicy = "retain"
+	OrganizationSSHKeyDeletionPolicyDestroy OrganizationSSHKeyDeletionPolicy = "destroy"
+)
+
+// OrganizationSSHKeySpec defines the desired state of OrganizationSSHKey.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/settings/ssh-keys
+type OrganizationSSHKeySpec struct {
+	// Organization name where the SSH key will be created.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Organization string `json:"organization"`
+	// API Token to be used for API calls.
+	Token Token `json:"token"`
+	// Name of the SSH key.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// SSH private key.
+	// When the content of the referenced Secret key changes, the operator creates a new SSH key with the new content.
+	// The previous SSH key is deleted once all Workspaces that reference this object via `spec.sshKey.objectRef` use the new one.
+	PrivateKey OrganizationSSHKeyPrivateKey `json:"privateKey"`
+	// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete an SSH key, either manually or by a system event.
+	//
+	// You must use one of the following values:
+	// - `retain`: When the custom resource is deleted, the operator will not delete the associated SSH key.
+	// - `destroy`: The operator will attempt to remove the SSH key from HCP Terraform.
+	// Default: `retain`.
+	//
+	//+kubebuilder:validation:Enum:=retain;destroy
+	//+kubebuilder:default=retain
+	//+optional
+	DeletionPolicy OrganizationSSHKeyDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// OrganizationSSHKeyStatus defines the observed state of OrganizationSSHKey.
+type OrganizationSSHKeyStatus struct {
+	// Real world state generation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// SSH key ID.
+	//
+	//+optional
+	ID string `json:"id,omitempty"`
+	// SSH key name.
+	//
+	//+optional
+	Name string `json:"name,omitempty"`
+	// ValueID is a hash of the SSH private key on the Kubernetes end.
+	//
+	//+optional
+	ValueID string `json:"valueID,omitempty"`
+	// Timestamp of the last SSH key rotation.
+	//
+	//+optional
+	RotatedAt *metav1.Time `json:"rotatedAt,omitempty"`
+	// IDs of the SSH keys replaced by a rotation that are still pending deletion.
+	// They are deleted once all Workspaces that reference this object use the current SSH key ID.
+	//
+	//+optional
+	PreviousIDs []string `json:"previousIDs,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="SSH Key Name",type=string,JSONPath=`.status.name`
+//+kubebuilder:printcolumn:name="SSH Key ID",type=string,JSONPath=`.status.id`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.1.0"
+
+// OrganizationSSHKey manages HCP Terraform SSH Keys.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/settings/ssh-keys
+type OrganizationSSHKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSSHKeySpec   `json:"spec"`
+	Status OrganizationSSHKeyStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OrganizationSSHKeyList contains a list of OrganizationSSHKey.
+type OrganizationSSHKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationSSHKey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OrganizationSSHKey{}, &OrganizationSSHKeyList{})
+}
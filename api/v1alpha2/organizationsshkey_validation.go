@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *OrganizationSSHKey) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, s.validateSpecPrivateKey()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "OrganizationSSHKey"},
+		s.Name,
+		allErrs,
+	)
+}
+
+func (s *OrganizationSSHKey) validateSpecPrivateKey() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := s.Spec.PrivateKey
+
+	f := field.NewPath("spec").Child("privateKey").Child("secretKeyRef")
+
+	if spec.SecretKeyRef == nil {
+		allErrs = append(allErrs, field.Required(
+			f,
+			"secretKeyRef must be set"),
+		)
+		return allErrs
+	}
+
+	if spec.SecretKeyRef.Name == "" {
+		allErrs = append(allErrs, field.Required(
+			f.Child("name"),
+			"secret name must be set"),
+		)
+	}
+
+	if spec.SecretKeyRef.Key == "" {
+		allErrs = append(allErrs, field.Required(
+			f.Child("key"),
+			"secret key must be set"),
+		)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateSSHKeySpecPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]OrganizationSSHKey{
+		"HasSecretKeyRef": {
+			Spec: OrganizationSSHKeySpec{
+				PrivateKey: OrganizationSSHKeyPrivateKey{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "this",
+						},
+						Key: "id_rsa",
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecPrivateKey()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]OrganizationSSHKey{
+		"HasNoSecretKeyRef": {
+			Spec: OrganizationSSHKeySpec{
+				PrivateKey: OrganizationSSHKeyPrivateKey{},
+			},
+		},
+		"HasEmptySecretName": {
+			Spec: OrganizationSSHKeySpec{
+				PrivateKey: OrganizationSSHKeyPrivateKey{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						Key: "id_rsa",
+					},
+				},
+			},
+		},
+		"HasEmptySecretKey": {
+			Spec: OrganizationSSHKeySpec{
+				PrivateKey: OrganizationSSHKeyPrivateKey{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "this",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecPrivateKey()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+func (m *RegistryModule) IsCreationCandidate() bool {
+	return m.Status.ID == ""
+}
+
+// IsVersionControlled returns true if the module is published from a VCS repository.
+func (m *RegistryModule) IsVersionControlled() bool {
+	return m.Spec.VersionControl != nil
+}
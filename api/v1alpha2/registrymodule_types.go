@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RegistryModuleVersionControl connects a private registry module to a VCS repository.
+// HCP Terraform publishes new versions of the module when new tags are pushed to the repository,
+// or from the given branch when `branch` is set.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/registry/publish-modules
+type RegistryModuleVersionControl struct {
+	// The VCS Connection (OAuth Connection + Token) to use.
+	// Must match pattern: `^ot-[a-zA-Z0-9]+$`
+	//
+	//+kubebuilder:validation:Pattern:="^ot-[a-zA-Z0-9]+$"
+	OAuthTokenID string `json:"oAuthTokenID"`
+	// A reference to your VCS repository in the format `<organization>/<repository>` where `<organization>` and `<repository>` refer to the organization and repository in your VCS provider.
+	// The repository name must follow the `terraform-<PROVIDER>-<NAME>` format.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Repository string `json:"repository"`
+	// The repository branch to publish the module from.
+	// If not set, the module is published based on the repository tags.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Branch string `json:"branch,omitempty"`
+	// The initial version of a branch-based module.
+	// Applies only when `branch` is set.
+	// Default: `0.0.0`.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	InitialVersion string `json:"initialVersion,omitempty"`
+	// The tag prefix used to select tags for publishing new versions.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	TagPrefix string `json:"tagPrefix,omitempty"`
+	// The path to the module in the repository, when the repository hosts multiple modules.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	SourceDirectory string `json:"sourceDirectory,omitempty"`
+}
+
+// RegistryModuleVersionSource is the source of a module version content.
+// Only one of the fields `ConfigMapRef` or `TarballRef` is allowed.
+// At least one of the fields `ConfigMapRef` or `TarballRef` is mandatory.
+type RegistryModuleVersionSource struct {
+	// Selects a ConfigMap in the registry module's namespace.
+	// Each key of the ConfigMap `data` is uploaded as a file in the root of the module.
+	//
+	//+optional
+	ConfigMapRef *corev1.LocalObjectReference `json:"configMapRef,omitempty"`
+	// Selects a key of a ConfigMap `binaryData` in the registry module's namespace.
+	// The value must be a `.tar.gz` archive of the module.
+	//
+	//+optional
+	TarballRef *corev1.ConfigMapKeySelector `json:"tarballRef,omitempty"`
+}
+
+// RegistryModuleVersion is a module version published via the API.
+// Published versions are immutable, changes of the source content are not uploaded again.
+type RegistryModuleVersion struct {
+	// Module version.
+	// Must be a valid semantic version.
+	// Must match pattern: `^[0-9]+\.[0-9]+\.[0-9]+(-[0-9A-Za-z.-]+)?$`
+	//
+	//+kubebuilder:validation:Pattern:="^[0-9]+\\.[0-9]+\\.[0-9]+(-[0-9A-Za-z.-]+)?$"
+	Version string `json:"version"`
+	// Source of the module version content.
+	Source RegistryModuleVersionSource `json:"source"`
+}
+
+// RegistryModuleTestConfig defines the test settings of a VCS-backed module.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/registry/test
+type RegistryModuleTestConfig struct {
+	// Whether to run tests when a new version of the module is published or a pull request is opened.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	TestsEnabled bool `json:"testsEnabled,omitempty"`
+	// Agent Pool to execute tests on.
+	// If not set, tests are executed remotely by HCP Terraform.
+	//
+	//+optional
+	AgentPool *AgentPoolRef `json:"agentPool,omitempty"`
+}
+
+// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete a registry module, either manually or by a system event.
+//
+// You must use one of the following values:
+// - `retain`: When the custom resource is deleted, the operator will not delete the associated registry module.
+// - `destroy`: The operator will attempt to remove the registry module and all its versions from the private registry.
+type RegistryModuleDeletionPolicy string
+
+const (
+	RegistryModuleDeletionPolicyRetain  RegistryModuleDeletionPolicy = "retain"
+	RegistryModuleDeletionPolicyDestroy RegistryModuleDeletionPolicy = "destroy"
+)
+
+// RegistryModuleSpec defines the desired state of RegistryModule.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/registry/publish-modules
+type RegistryModuleSpec struct {
+	// Organization name where the registry module will be created.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Organization string `json:"organization"`
+	// API Token to be used for API calls.
+	Token Token `json:"token"`
+	// Name of the module.
+	// Required when `versionControl` is not set, otherwise it is derived from the repository name.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Name string `json:"name,omitempty"`
+	// Name of the main provider used by the module.
+	// Required when `versionControl` is not set, otherwise it is derived from the repository name.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Provider string `json:"provider,omitempty"`
+	// VCS repository the module is published from.
+	// Cannot be used together with `versions`.
+	//
+	//+optional
+	VersionControl *RegistryModuleVersionControl `json:"versionControl,omitempty"`
+	// Module versions to publish via the API.
+	// Cannot be used together with `versionControl`.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Versions []RegistryModuleVersion `json:"versions,omitempty"`
+	// Whether the module is enabled for no-code provisioning.
+	// Default: `false`.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/no-code-provisioning/module-design
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	NoCode bool `json:"noCode,omitempty"`
+	// Test settings of the module.
+	// Applies only when `versionControl` is set.
+	//
+	//+optional
+	TestConfig *RegistryModuleTestConfig `json:"testConfig,omitempty"`
+	// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete a registry module, either manually or by a system event.
+	//
+	// You must use one of the following values:
+	// - `retain`: When the custom resource is deleted, the operator will not delete the associated registry module.
+	// - `destroy`: The operator will attempt to remove the registry module and all its versions from the private registry.
+	// Default: `retain`.
+	//
+	//+kubebuilder:validation:Enum:=retain;destroy
+	//+kubebuilder:default=retain
+	//+optional
+	DeletionPolicy RegistryModuleDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// RegistryModuleVersionStatus is the observed state of a published module version.
+type RegistryModuleVersionStatus struct {
+	// Module version.
+	Version string `json:"version"`
+	// Module version status.
+	Status string `json:"status"`
+	// Error message if the version failed to publish.
+	//
+	//+optional
+	Error string `json:"error,omitempty"`
+}
+
+// RegistryModuleStatus defines the observed state of RegistryModule.
+type RegistryModuleStatus struct {
+	// Real world state generation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// Registry module ID.
+	//
+	//+optional
+	ID string `json:"id,omitempty"`
+	// Registry module name.
+	//
+	//+optional
+	Name string `json:"name,omitempty"`
+	// Registry module provider.
+	//
+	//+optional
+	Provider string `json:"provider,omitempty"`
+	// Registry module source address.
+	// Can be used as `spec.module.source` of a Module.
+	//
+	//+optional
+	Source string `json:"source,omitempty"`
+	// Registry module status.
+	//
+	//+optional
+	Status string `json:"status,omitempty"`
+	// Published versions of the registry module.
+	//
+	//+optional
+	Versions []RegistryModuleVersionStatus `json:"versions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Module Source",type=string,JSONPath=`.status.source`
+//+kubebuilder:printcolumn:name="Module ID",type=string,JSONPath=`.status.id`
+//+kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.1.0"
+
+// RegistryModule manages HCP Terraform private registry modules.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/registry/publish-modules
+type RegistryModule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegistryModuleSpec   `json:"spec"`
+	Status RegistryModuleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RegistryModuleList contains a list of RegistryModule.
+type RegistryModuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegistryModule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RegistryModule{}, &RegistryModuleList{})
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (m *RegistryModule) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, m.validateSpecSource()...)
+	allErrs = append(allErrs, m.validateSpecVersions()...)
+	allErrs = append(allErrs, m.validateSpecTestConfig()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "RegistryModule"},
+		m.Name,
+		allErrs,
+	)
+}
+
+// validateSpecSource validates that the module is either VCS-backed or API-driven.
+func (m *RegistryModule) validateSpecSource() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := m.Spec
+
+	f := field.NewPath("spec")
+
+	if spec.VersionControl != nil && len(spec.Versions) > 0 {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("versions"),
+			"",
+			"'spec.versions' cannot be used together with 'spec.versionControl'"),
+		)
+	}
+
+	if spec.VersionControl == nil {
+		if spec.Name == "" {
+			allErrs = append(allErrs, field.Required(
+				f.Child("name"),
+				"'spec.name' must be set when 'spec.versionControl' is not set"),
+			)
+		}
+		if spec.Provider == "" {
+			allErrs = append(allErrs, field.Required(
+				f.Child("provider"),
+				"'spec.provider' must be set when 'spec.versionControl' is not set"),
+			)
+		}
+	}
+
+	if spec.VersionControl != nil && spec.VersionControl.InitialVersion != "" && spec.VersionControl.Branch == "" {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("versionControl").Child("initialVersion"),
+			spec.VersionControl.InitialVersion,
+			"'spec.versionControl.initialVersion' can only be used together with 'spec.versionControl.branch'"),
+		)
+	}
+
+	return allErrs
+}
+
+// validateSpecVersions validates the following:
+//   - versions are unique.
+//   - each version has exactly one source.
+func (m *RegistryModule) validateSpecVersions() field.ErrorList {
+	allErrs := field.ErrorList{}
+	versions := make(map[string]int)
+
+	for i, v := range m.Spec.Versions {
+		f := field.NewPath("spec").Child(fmt.Sprintf("versions[%d]", i))
+		if _, ok := versions[v.Version]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Child("version"), v.Version))
+		}
+		versions[v.Version] = i
+
+		s := v.Source
+		fs := f.Child("source")
+		if s.ConfigMapRef == nil && s.TarballRef == nil {
+			allErrs = append(allErrs, field.Invalid(
+				fs,
+				"",
+				"one of the field ConfigMapRef or TarballRef must be set"),
+			)
+		}
+		if s.ConfigMapRef != nil && s.TarballRef != nil {
+			allErrs = append(allErrs, field.Invalid(
+				fs,
+				"",
+				"only one of the field ConfigMapRef or TarballRef is allowed"),
+			)
+		}
+		if s.ConfigMapRef != nil && s.ConfigMapRef.Name == "" {
+			allErrs = append(allErrs, field.Required(
+				fs.Child("configMapRef").Child("name"),
+				"ConfigMap name must be set"),
+			)
+		}
+		if s.TarballRef != nil {
+			if s.TarballRef.Name == "" {
+				allErrs = append(allErrs, field.Required(
+					fs.Child("tarballRef").Child("name"),
+					"ConfigMap name must be set"),
+				)
+			}
+			if s.TarballRef.Key == "" {
+				allErrs = append(allErrs, field.Required(
+					fs.Child("tarballRef").Child("key"),
+					"ConfigMap key must be set"),
+				)
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (m *RegistryModule) validateSpecTestConfig() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := m.Spec.TestConfig
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("testConfig")
+
+	if m.Spec.VersionControl == nil {
+		allErrs = append(allErrs, field.Required(
+			f,
+			"'spec.versionControl' must be set when 'spec.testConfig' is set"),
+		)
+	}
+
+	if spec.AgentPool != nil {
+		if spec.AgentPool.ID == "" && spec.AgentPool.Name == "" {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("agentPool"),
+				"",
+				"one of the field ID or Name must be set"),
+			)
+		}
+		if spec.AgentPool.ID != "" && spec.AgentPool.Name != "" {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("agentPool"),
+				"",
+				"only one of the field ID or Name is allowed"),
+			)
+		}
+	}
+
+	return allErrs
+}
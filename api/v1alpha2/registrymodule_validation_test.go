@@ -0,0 +1,332 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateRegistryModuleSpecSource(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]RegistryModule{
+		"HasVersionControl": {
+			Spec: RegistryModuleSpec{
+				VersionControl: &RegistryModuleVersionControl{
+					OAuthTokenID: "ot-this",
+					Repository:   "this/terraform-aws-this",
+				},
+			},
+		},
+		"HasVersionControlWithBranchAndInitialVersion": {
+			Spec: RegistryModuleSpec{
+				VersionControl: &RegistryModuleVersionControl{
+					OAuthTokenID:   "ot-this",
+					Repository:     "this/terraform-aws-this",
+					Branch:         "main",
+					InitialVersion: "1.0.0",
+				},
+			},
+		},
+		"HasNameAndProvider": {
+			Spec: RegistryModuleSpec{
+				Name:     "this",
+				Provider: "aws",
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecSource()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]RegistryModule{
+		"HasVersionControlAndVersions": {
+			Spec: RegistryModuleSpec{
+				VersionControl: &RegistryModuleVersionControl{
+					OAuthTokenID: "ot-this",
+					Repository:   "this/terraform-aws-this",
+				},
+				Versions: []RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+					},
+				},
+			},
+		},
+		"HasOnlyName": {
+			Spec: RegistryModuleSpec{
+				Name: "this",
+			},
+		},
+		"HasOnlyProvider": {
+			Spec: RegistryModuleSpec{
+				Provider: "aws",
+			},
+		},
+		"HasInitialVersionWithoutBranch": {
+			Spec: RegistryModuleSpec{
+				VersionControl: &RegistryModuleVersionControl{
+					OAuthTokenID:   "ot-this",
+					Repository:     "this/terraform-aws-this",
+					InitialVersion: "1.0.0",
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecSource()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateRegistryModuleSpecVersions(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]RegistryModule{
+		"HasConfigMapRef": {
+			Spec: RegistryModuleSpec{
+				Versions: []RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+						Source: RegistryModuleVersionSource{
+							ConfigMapRef: &corev1.LocalObjectReference{
+								Name: "this",
+							},
+						},
+					},
+				},
+			},
+		},
+		"HasTarballRef": {
+			Spec: RegistryModuleSpec{
+				Versions: []RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+						Source: RegistryModuleVersionSource{
+							TarballRef: &corev1.ConfigMapKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "this",
+								},
+								Key: "module.tar.gz",
+							},
+						},
+					},
+				},
+			},
+		},
+		"HasMultipleVersions": {
+			Spec: RegistryModuleSpec{
+				Versions: []RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+						Source: RegistryModuleVersionSource{
+							ConfigMapRef: &corev1.LocalObjectReference{
+								Name: "this",
+							},
+						},
+					},
+					{
+						Version: "1.1.0",
+						Source: RegistryModuleVersionSource{
+							ConfigMapRef: &corev1.LocalObjectReference{
+								Name: "that",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecVersions()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]RegistryModule{
+		"HasDuplicateVersions": {
+			Spec: RegistryModuleSpec{
+				Versions: []RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+						Source: RegistryModuleVersionSource{
+							ConfigMapRef: &corev1.LocalObjectReference{
+								Name: "this",
+							},
+						},
+					},
+					{
+						Version: "1.0.0",
+						Source: RegistryModuleVersionSource{
+							ConfigMapRef: &corev1.LocalObjectReference{
+								Name: "that",
+							},
+						},
+					},
+				},
+			},
+		},
+		"HasNoSource": {
+			Spec: RegistryModuleSpec{
+				Versions: []RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+					},
+				},
+			},
+		},
+		"HasConfigMapRefAndTarballRef": {
+			Spec: RegistryModuleSpec{
+				Versions: []RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+						Source: RegistryModuleVersionSource{
+							ConfigMapRef: &corev1.LocalObjectReference{
+								Name: "this",
+							},
+							TarballRef: &corev1.ConfigMapKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "this",
+								},
+								Key: "module.tar.gz",
+							},
+						},
+					},
+				},
+			},
+		},
+		"HasEmptyConfigMapName": {
+			Spec: RegistryModuleSpec{
+				Versions: []RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+						Source: RegistryModuleVersionSource{
+							ConfigMapRef: &corev1.LocalObjectReference{},
+						},
+					},
+				},
+			},
+		},
+		"HasEmptyTarballKey": {
+			Spec: RegistryModuleSpec{
+				Versions: []RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+						Source: RegistryModuleVersionSource{
+							TarballRef: &corev1.ConfigMapKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "this",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecVersions()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateRegistryModuleSpecTestConfig(t *testing.T) {
+	t.Parallel()
+
+	vcs := &RegistryModuleVersionControl{
+		OAuthTokenID: "ot-this",
+		Repository:   "this/terraform-aws-this",
+	}
+
+	successCases := map[string]RegistryModule{
+		"HasOnlyTestsEnabled": {
+			Spec: RegistryModuleSpec{
+				VersionControl: vcs,
+				TestConfig: &RegistryModuleTestConfig{
+					TestsEnabled: true,
+				},
+			},
+		},
+		"HasAgentPoolID": {
+			Spec: RegistryModuleSpec{
+				VersionControl: vcs,
+				TestConfig: &RegistryModuleTestConfig{
+					TestsEnabled: true,
+					AgentPool: &AgentPoolRef{
+						ID: "apool-this",
+					},
+				},
+			},
+		},
+		"HasAgentPoolName": {
+			Spec: RegistryModuleSpec{
+				VersionControl: vcs,
+				TestConfig: &RegistryModuleTestConfig{
+					TestsEnabled: true,
+					AgentPool: &AgentPoolRef{
+						Name: "this",
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecTestConfig()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]RegistryModule{
+		"HasNoVersionControl": {
+			Spec: RegistryModuleSpec{
+				TestConfig: &RegistryModuleTestConfig{
+					TestsEnabled: true,
+				},
+			},
+		},
+		"HasEmptyAgentPool": {
+			Spec: RegistryModuleSpec{
+				VersionControl: vcs,
+				TestConfig: &RegistryModuleTestConfig{
+					AgentPool: &AgentPoolRef{},
+				},
+			},
+		},
+		"HasAgentPoolIDAndName": {
+			Spec: RegistryModuleSpec{
+				VersionControl: vcs,
+				TestConfig: &RegistryModuleTestConfig{
+					AgentPool: &AgentPoolRef{
+						ID:   "apool-this",
+						Name: "this",
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecTestConfig()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
@@ -3,10 +3,15 @@
 
 package v1alpha2
 
+import "slices"
+
 func (rc *RunsCollector) NeedUpdateStatus() bool {
 	if rc.Status.AgentPool == nil {
 		return true
 	}
+	if rc.Spec.AgentPool == nil {
+		return true
+	}
 	if rc.Spec.AgentPool.Name != "" {
 		return rc.Spec.AgentPool.Name != rc.Status.AgentPool.Name
 	}
@@ -16,3 +21,8 @@ func (rc *RunsCollector) NeedUpdateStatus() bool {
 
 	return false
 }
+
+// HasBreakdown returns true if pending runs are broken down by the given dimension.
+func (rc *RunsCollector) HasBreakdown(b RunsCollectorBreakdown) bool {
+	return slices.Contains(rc.Spec.Breakdown, b)
+}
@@ -52,6 +52,17 @@ func TestNeedUpdateStatus(t *testing.T) {
 			},
 			true,
 		},
+		"NilSpecWithStatus": {
+			RunsCollector{
+				Spec: RunsCollectorSpec{AgentPool: nil},
+				Status: RunsCollectorStatus{
+					AgentPool: &AgentPoolRef{
+						Name: "this",
+					},
+				},
+			},
+			true,
+		},
 		"SpecNameMatchesStatusName": {
 			RunsCollector{
 				Spec: RunsCollectorSpec{
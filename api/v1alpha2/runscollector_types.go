@@ -18,16 +18,83 @@ type RunsCollectorSpec struct {
 	Token Token `json:"token"`
 
 	// The Agent Pool name or ID from which the controller will collect runs.
+	// If neither `agentPool` nor `agentPools` is set, the controller collects runs of the entire organization,
+	// including runs of workspaces with the remote execution mode.
 	// More information:
 	//   - https://developer.hashicorp.com/terraform/cloud-docs/run/states
-	AgentPool *AgentPoolRef `json:"agentPool"`
+	//
+	//+optional
+	AgentPool *AgentPoolRef `json:"agentPool,omitempty"`
+	// The Agent Pools from which the controller will collect runs.
+	// Runs are listed once for all matching Agent Pools and exported per Agent Pool.
+	// Mutually exclusive with `agentPool`.
+	//
+	//+optional
+	AgentPools *RunsCollectorAgentPools `json:"agentPools,omitempty"`
+	// Additional dimensions by which pending runs are broken down in the `hcp_tf_runs_breakdown` metric.
+	// Valid values are `workspace` and `project`.
+	//
+	//+listType=set
+	//+optional
+	Breakdown []RunsCollectorBreakdown `json:"breakdown,omitempty"`
+	// Whether to export histograms of the queue time and the plan and apply duration of completed runs.
+	// They are computed from the run status timestamps.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	Histograms bool `json:"histograms,omitempty"`
 }
 
+// RunsCollectorAgentPools selects the Agent Pools from which the controller will collect runs.
+// Only one of the fields `namePattern`, `selector`, or `all` can be set.
+type RunsCollectorAgentPools struct {
+	// Regular expression that the names of the Agent Pools in the organization must match.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	NamePattern string `json:"namePattern,omitempty"`
+	// Label selector of the AgentPool objects in the namespace of the RunsCollector.
+	// Only AgentPool objects of the same organization are taken into account.
+	//
+	//+optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Whether to collect runs of all Agent Pools in the organization.
+	//
+	//+optional
+	All bool `json:"all,omitempty"`
+}
+
+// RunsCollectorBreakdown is a dimension by which pending runs are broken down.
+// Must be one of the following values: `project`, `workspace`.
+//
+// +kubebuilder:validation:Enum:=project;workspace
+type RunsCollectorBreakdown string
+
+const (
+	RunsCollectorBreakdownProject   RunsCollectorBreakdown = "project"
+	RunsCollectorBreakdownWorkspace RunsCollectorBreakdown = "workspace"
+)
+
 type RunsCollectorStatus struct {
 	// Real world state generation.
 	ObservedGeneration int64 `json:"observedGeneration"`
 	// The Agent Pool name or ID from which the controller will collect runs.
 	AgentPool *AgentPoolRef `json:"agentPool,omitempty"`
+	// The Agent Pools matching `spec.agentPools` from which the controller collects runs.
+	//
+	//+optional
+	AgentPools []AgentPoolRef `json:"agentPools,omitempty"`
+	// The latest completion time of the runs observed in the histograms.
+	// Runs that completed before this time are not observed again.
+	//
+	//+optional
+	RunsObservedUntil *metav1.Time `json:"runsObservedUntil,omitempty"`
+	// IDs of the runs that were not completed at the last observation.
+	// They are observed in the histograms once they complete, however long they take.
+	//
+	//+optional
+	PendingRunIDs []string `json:"pendingRunIDs,omitempty"`
 }
 
 //+kubebuilder:object:root=true
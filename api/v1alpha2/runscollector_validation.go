@@ -4,7 +4,10 @@
 package v1alpha2
 
 import (
+	"regexp"
+
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -13,6 +16,8 @@ func (rc *RunsCollector) ValidateSpec() error {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, rc.validateSpecAgentPool()...)
+	allErrs = append(allErrs, rc.validateSpecAgentPools()...)
+	allErrs = append(allErrs, rc.validateSpecBreakdown()...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -53,3 +58,86 @@ func (rc *RunsCollector) validateSpecAgentPool() field.ErrorList {
 
 	return allErrs
 }
+
+func (rc *RunsCollector) validateSpecAgentPools() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := rc.Spec.AgentPools
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("agentPools")
+
+	if rc.Spec.AgentPool != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			f,
+			"only one of the field agentPool or agentPools is allowed"),
+		)
+	}
+
+	n := 0
+	if spec.NamePattern != "" {
+		n++
+		if _, err := regexp.Compile(spec.NamePattern); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("namePattern"),
+				spec.NamePattern,
+				err.Error()),
+			)
+		}
+	}
+	if spec.Selector != nil {
+		n++
+		if _, err := metav1.LabelSelectorAsSelector(spec.Selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child("selector"),
+				spec.Selector,
+				err.Error()),
+			)
+		}
+	}
+	if spec.All {
+		n++
+	}
+
+	if n == 0 {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"one of the field namePattern, selector, or all must be set"),
+		)
+	}
+	if n > 1 {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"only one of the field namePattern, selector, or all is allowed"),
+		)
+	}
+
+	return allErrs
+}
+
+func (rc *RunsCollector) validateSpecBreakdown() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	f := field.NewPath("spec").Child("breakdown")
+	b := make(map[RunsCollectorBreakdown]struct{}, len(rc.Spec.Breakdown))
+	for i, v := range rc.Spec.Breakdown {
+		if v != RunsCollectorBreakdownProject && v != RunsCollectorBreakdownWorkspace {
+			allErrs = append(allErrs, field.NotSupported(
+				f.Index(i),
+				v,
+				[]RunsCollectorBreakdown{RunsCollectorBreakdownProject, RunsCollectorBreakdownWorkspace},
+			))
+			continue
+		}
+		if _, ok := b[v]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Index(i), v))
+		}
+		b[v] = struct{}{}
+	}
+
+	return allErrs
+}
@@ -7,12 +7,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestValidateRunsCollectorSpecAgentPool(t *testing.T) {
 	t.Parallel()
 
 	successCases := map[string]RunsCollector{
+		"HasNoAgentPool": {
+			Spec: RunsCollectorSpec{},
+		},
 		"HasOnlyID": {
 			Spec: RunsCollectorSpec{
 				AgentPool: &AgentPoolRef{
@@ -67,3 +71,138 @@ func TestValidateRunsCollectorSpecAgentPool(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRunsCollectorSpecAgentPools(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]RunsCollector{
+		"HasNamePattern": {
+			Spec: RunsCollectorSpec{
+				AgentPools: &RunsCollectorAgentPools{
+					NamePattern: "^team-.*$",
+				},
+			},
+		},
+		"HasSelector": {
+			Spec: RunsCollectorSpec{
+				AgentPools: &RunsCollectorAgentPools{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"team": "this"},
+					},
+				},
+			},
+		},
+		"HasAll": {
+			Spec: RunsCollectorSpec{
+				AgentPools: &RunsCollectorAgentPools{
+					All: true,
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAgentPools()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]RunsCollector{
+		"HasAgentPoolAndAgentPools": {
+			Spec: RunsCollectorSpec{
+				AgentPool: &AgentPoolRef{
+					Name: "this",
+				},
+				AgentPools: &RunsCollectorAgentPools{
+					All: true,
+				},
+			},
+		},
+		"HasNothing": {
+			Spec: RunsCollectorSpec{
+				AgentPools: &RunsCollectorAgentPools{},
+			},
+		},
+		"HasNamePatternAndAll": {
+			Spec: RunsCollectorSpec{
+				AgentPools: &RunsCollectorAgentPools{
+					NamePattern: "^team-.*$",
+					All:         true,
+				},
+			},
+		},
+		"HasInvalidNamePattern": {
+			Spec: RunsCollectorSpec{
+				AgentPools: &RunsCollectorAgentPools{
+					NamePattern: "team-(",
+				},
+			},
+		},
+		"HasInvalidSelector": {
+			Spec: RunsCollectorSpec{
+				AgentPools: &RunsCollectorAgentPools{
+					Selector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "team", Operator: "Near"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAgentPools()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateRunsCollectorSpecBreakdown(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]RunsCollector{
+		"HasNoBreakdown": {
+			Spec: RunsCollectorSpec{},
+		},
+		"HasWorkspace": {
+			Spec: RunsCollectorSpec{
+				Breakdown: []RunsCollectorBreakdown{RunsCollectorBreakdownWorkspace},
+			},
+		},
+		"HasWorkspaceAndProject": {
+			Spec: RunsCollectorSpec{
+				Breakdown: []RunsCollectorBreakdown{RunsCollectorBreakdownWorkspace, RunsCollectorBreakdownProject},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecBreakdown()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]RunsCollector{
+		"HasDuplicate": {
+			Spec: RunsCollectorSpec{
+				Breakdown: []RunsCollectorBreakdown{RunsCollectorBreakdownProject, RunsCollectorBreakdownProject},
+			},
+		},
+		"HasUnsupportedValue": {
+			Spec: RunsCollectorSpec{
+				Breakdown: []RunsCollectorBreakdown{"organization"},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecBreakdown()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
@@ -328,8 +328,8 @@ type VersionControl struct {
 }
 
 // SSH key used to clone Terraform modules.
-// Only one of the fields `ID` or `Name` is allowed.
-// At least one of the fields `ID` or `Name` is mandatory.
+// Only one of the fields `ID`, `Name`, or `ObjectRef` is allowed.
+// At least one of the fields `ID`, `Name`, or `ObjectRef` is mandatory.
 // More information:
 //   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/settings/ssh-keys
 type SSHKey struct {
@@ -344,6 +344,11 @@ type SSHKey struct {
 	//+kubebuilder:validation:MinLength:=1
 	//+optional
 	Name string `json:"name,omitempty"`
+	// Selects an OrganizationSSHKey custom resource in the workspace's namespace.
+	// The SSH key ID is taken from the status of the referenced object and follows its rotations.
+	//
+	//+optional
+	ObjectRef *corev1.LocalObjectReference `json:"objectRef,omitempty"`
 }
 
 // Tags allows you to correlate, organize, and even filter workspaces based on the assigned tags.
@@ -369,6 +374,44 @@ const (
 	DeletionPolicyForce   DeletionPolicy = "force"
 )
 
+// BudgetAction defines what the Kubernetes operator does with a run whose cost estimate exceeds the workspace budget.
+//
+// You must use one of the following values:
+// - `report`: The operator records the exceeded budget in the status and emits a warning event. The run is left as is and can still be confirmed.
+// - `discard`: The operator discards the run.
+type BudgetAction string
+
+const (
+	BudgetActionReport  BudgetAction = "report"
+	BudgetActionDiscard BudgetAction = "discard"
+)
+
+// WorkspaceBudget defines the monthly cost threshold of the workspace.
+// A run whose cost estimate exceeds one of the thresholds is reported or discarded according to the action.
+// At least one of the fields `maxMonthlyCost` or `maxMonthlyCostDelta` must be set.
+// Budget requires cost estimation to be enabled in the organization and `spec.applyMethod` to be set to `manual`.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/cost-estimation
+type WorkspaceBudget struct {
+	// Maximum proposed monthly cost of the workspace resources in USD.
+	//
+	//+kubebuilder:validation:Pattern:="^\\d+(\\.\\d+)?$"
+	//+optional
+	MaxMonthlyCost string `json:"maxMonthlyCost,omitempty"`
+	// Maximum increase of the monthly cost of the workspace resources in USD that a single run can introduce.
+	//
+	//+kubebuilder:validation:Pattern:="^\\d+(\\.\\d+)?$"
+	//+optional
+	MaxMonthlyCostDelta string `json:"maxMonthlyCostDelta,omitempty"`
+	// The action to take on a run that exceeds the budget.
+	// Default: `report`.
+	//
+	//+kubebuilder:validation:Enum:=report;discard
+	//+kubebuilder:default=report
+	//+optional
+	Action BudgetAction `json:"action,omitempty"`
+}
+
 // NotificationTrigger represents the different TFC notifications that can be sent as a run's progress transitions between different states.
 // This must be aligned with go-tfe type `NotificationTriggerType`.
 // Must be one of the following values: `run:applying`, `assessment:check_failure`, `run:completed`, `run:created`, `assessment:drifted`, `run:errored`, `assessment:failed`, `run:needs_attention`, `run:planning`.
@@ -646,6 +689,20 @@ type WorkspaceSpec struct {
 	//+kubebuilder:validation:MinItems:=1
 	//+optional
 	VariableSets []WorkspaceVariableSet `json:"variableSets,omitempty"`
+	// Monthly cost budget of the workspace. Runs whose cost estimate exceeds the budget are held or discarded.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/cost-estimation
+	//
+	//+optional
+	Budget *WorkspaceBudget `json:"budget,omitempty"`
+	// The number of the most recent runs to retain in `status.runHistory`. Set to `0` to disable the run history.
+	// Default: `10`.
+	//
+	//+kubebuilder:validation:Minimum:=0
+	//+kubebuilder:validation:Maximum:=100
+	//+kubebuilder:default:=10
+	//+optional
+	RunHistoryLimit *int32 `json:"runHistoryLimit,omitempty"`
 }
 
 type PlanStatus struct {
@@ -683,6 +740,223 @@ type RunStatus struct {
 	OutputRunID string `json:"outputRunID,omitempty"`
 }
 
+type CostEstimationStatus struct {
+	// HCP Terraform run ID the cost estimate belongs to.
+	//
+	//+optional
+	RunID string `json:"runID,omitempty"`
+	// Cost estimate status.
+	//
+	//+optional
+	Status string `json:"status,omitempty"`
+	// Monthly cost of the workspace resources before the run in USD.
+	//
+	//+optional
+	PriorMonthlyCost string `json:"priorMonthlyCost,omitempty"`
+	// Monthly cost of the workspace resources after the run in USD.
+	//
+	//+optional
+	ProposedMonthlyCost string `json:"proposedMonthlyCost,omitempty"`
+	// Difference between the proposed and the prior monthly cost in USD.
+	//
+	//+optional
+	DeltaMonthlyCost string `json:"deltaMonthlyCost,omitempty"`
+	// Number of resources in the plan.
+	//
+	//+optional
+	ResourcesCount int `json:"resourcesCount,omitempty"`
+	// Number of resources whose cost could be estimated.
+	//
+	//+optional
+	MatchedResourcesCount int `json:"matchedResourcesCount,omitempty"`
+	// Number of resources whose cost could not be estimated.
+	//
+	//+optional
+	UnmatchedResourcesCount int `json:"unmatchedResourcesCount,omitempty"`
+	// Whether the cost estimate exceeds the workspace budget.
+	//
+	//+optional
+	BudgetExceeded bool `json:"budgetExceeded,omitempty"`
+	// The action the operator took on the run that exceeds the workspace budget.
+	//
+	//+optional
+	BudgetAction BudgetAction `json:"budgetAction,omitempty"`
+}
+
+type RunChecksStatus struct {
+	// HCP Terraform run ID the checks belong to.
+	//
+	//+optional
+	RunID string `json:"runID,omitempty"`
+	// Run task stages of the run.
+	//
+	//+optional
+	Stages []RunStageStatus `json:"stages,omitempty"`
+	// Policy outcomes of the policy evaluations of the run.
+	//
+	//+optional
+	Policies []PolicyOutcomeStatus `json:"policies,omitempty"`
+	// Legacy Sentinel policy checks of the run.
+	//
+	//+optional
+	PolicyChecks []PolicyCheckStatus `json:"policyChecks,omitempty"`
+	// Run task results of the run.
+	//
+	//+optional
+	RunTasks []RunTaskResultStatus `json:"runTasks,omitempty"`
+}
+
+type RunStageStatus struct {
+	// Task stage ID.
+	ID string `json:"id"`
+	// Stage of the run: `pre_plan`, `post_plan`, `pre_apply`, or `post_apply`.
+	Stage string `json:"stage"`
+	// Status of the stage.
+	Status string `json:"status"`
+}
+
+type PolicyOutcomeStatus struct {
+	// Stage of the run the policy was evaluated in.
+	//
+	//+optional
+	Stage string `json:"stage,omitempty"`
+	// Policy kind: `opa` or `sentinel`.
+	//
+	//+optional
+	Kind string `json:"kind,omitempty"`
+	// Policy set name.
+	//
+	//+optional
+	PolicySet string `json:"policySet,omitempty"`
+	// Policy name.
+	//
+	//+optional
+	Policy string `json:"policy,omitempty"`
+	// Result of the policy evaluation.
+	//
+	//+optional
+	Result string `json:"result,omitempty"`
+	// Enforcement level of the policy.
+	//
+	//+optional
+	EnforcementLevel string `json:"enforcementLevel,omitempty"`
+}
+
+type PolicyCheckStatus struct {
+	// Policy check ID.
+	ID string `json:"id"`
+	// Status of the policy check.
+	Status string `json:"status"`
+	// Number of passed policies.
+	//
+	//+optional
+	Passed int `json:"passed,omitempty"`
+	// Number of failed advisory policies.
+	//
+	//+optional
+	AdvisoryFailed int `json:"advisoryFailed,omitempty"`
+	// Number of failed soft-mandatory policies.
+	//
+	//+optional
+	SoftFailed int `json:"softFailed,omitempty"`
+	// Number of failed hard-mandatory policies.
+	//
+	//+optional
+	HardFailed int `json:"hardFailed,omitempty"`
+}
+
+type RunTaskResultStatus struct {
+	// Stage of the run the run task ran in.
+	//
+	//+optional
+	Stage string `json:"stage,omitempty"`
+	// Run task name.
+	//
+	//+optional
+	Name string `json:"name,omitempty"`
+	// Status of the run task result.
+	//
+	//+optional
+	Status string `json:"status,omitempty"`
+	// Enforcement level of the run task.
+	//
+	//+optional
+	EnforcementLevel string `json:"enforcementLevel,omitempty"`
+	// Outcome message of the run task.
+	//
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+type RunOverrideStatus struct {
+	// HCP Terraform run ID the override was requested for.
+	RunID string `json:"runID"`
+	// Justification of the override.
+	//
+	//+optional
+	Justification string `json:"justification,omitempty"`
+	// Result of the override request: `overridden` or `rejected`.
+	Result string `json:"result"`
+	// Details of the result.
+	//
+	//+optional
+	Message string `json:"message,omitempty"`
+	// Timestamp of when the override request was processed.
+	//
+	//+optional
+	ProcessedAt *metav1.Time `json:"processedAt,omitempty"`
+}
+
+type RunHistoryStatus struct {
+	// Most recent runs of the workspace, ordered from the newest to the oldest.
+	//
+	//+optional
+	Runs []RunHistoryEntry `json:"runs,omitempty"`
+	// Number of errored runs in the history.
+	Errored int32 `json:"errored"`
+	// Timestamp of the last change of the runs in the history.
+	//
+	//+optional
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+type RunHistoryEntry struct {
+	// HCP Terraform run ID.
+	ID string `json:"id"`
+	// Run type: `plan`, `apply`, `refresh`, or `destroy`.
+	Type string `json:"type"`
+	// Source that triggered the run.
+	//
+	//+optional
+	Source string `json:"source,omitempty"`
+	// Run status.
+	Status string `json:"status"`
+	// Timestamp of when the run was created.
+	//
+	//+optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	// Timestamp of when the run reached a final status.
+	//
+	//+optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Username of the user who triggered the run.
+	//
+	//+optional
+	TriggeredBy string `json:"triggeredBy,omitempty"`
+	// Number of resources to add.
+	//
+	//+optional
+	Additions int `json:"additions,omitempty"`
+	// Number of resources to change.
+	//
+	//+optional
+	Changes int `json:"changes,omitempty"`
+	// Number of resources to destroy.
+	//
+	//+optional
+	Destructions int `json:"destructions,omitempty"`
+}
+
 type VariableStatus struct {
 	// Name of the variable.
 	Name string `json:"name"`
@@ -742,6 +1016,22 @@ type WorkspaceStatus struct {
 	//
 	//+optional
 	VariableSets []VariableSetStatus `json:"variableSet,omitempty"`
+	// Cost estimate of the current non-speculative run.
+	//
+	//+optional
+	CostEstimation *CostEstimationStatus `json:"costEstimation,omitempty"`
+	// Policy evaluations and run task results of the current non-speculative run.
+	//
+	//+optional
+	RunChecks *RunChecksStatus `json:"runChecks,omitempty"`
+	// Latest request to override failed policies or run tasks of a run.
+	//
+	//+optional
+	RunOverride *RunOverrideStatus `json:"runOverride,omitempty"`
+	// Recent runs of the workspace.
+	//
+	//+optional
+	RunHistory *RunHistoryStatus `json:"runHistory,omitempty"`
 }
 
 type VariableSetStatus struct {
@@ -752,6 +1042,9 @@ type VariableSetStatus struct {
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="Workspace ID",type=string,JSONPath=`.status.workspaceID`
+//+kubebuilder:printcolumn:name="Run Status",type=string,JSONPath=`.status.runStatus.status`
+//+kubebuilder:printcolumn:name="Errored Runs",type=integer,JSONPath=`.status.runHistory.errored`,priority=1
+//+kubebuilder:printcolumn:name="History Updated",type=date,JSONPath=`.status.runHistory.updatedAt`,priority=1
 //+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v25.4.0"
 
 // Workspace manages HCP Terraform Workspaces.
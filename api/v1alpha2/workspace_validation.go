@@ -5,6 +5,7 @@ package v1alpha2
 
 import (
 	"fmt"
+	"strconv"
 
 	tfc "github.com/hashicorp/go-tfe"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -28,6 +29,7 @@ func (w *Workspace) ValidateSpec() error {
 	allErrs = append(allErrs, w.validateSpecDeletionPolicy()...)
 	allErrs = append(allErrs, w.validateSpecVariableSets()...)
 	allErrs = append(allErrs, w.validateSpecVersionControl()...)
+	allErrs = append(allErrs, w.validateSpecBudget()...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -414,19 +416,26 @@ func (w *Workspace) validateSpecSSHKey() field.ErrorList {
 
 	f := field.NewPath("spec").Child("sshKey")
 
-	if spec.ID == "" && spec.Name == "" {
+	if spec.ID == "" && spec.Name == "" && spec.ObjectRef == nil {
 		allErrs = append(allErrs, field.Invalid(
 			f,
 			"",
-			"one of the field ID or Name must be set"),
+			"one of the field ID, Name, or ObjectRef must be set"),
 		)
 	}
 
-	if w.Spec.SSHKey.ID != "" && w.Spec.SSHKey.Name != "" {
+	if (spec.ID != "" && spec.Name != "") || (spec.ID != "" && spec.ObjectRef != nil) || (spec.Name != "" && spec.ObjectRef != nil) {
 		allErrs = append(allErrs, field.Invalid(
 			f,
 			"",
-			"only one of the field ID or Name is allowed"),
+			"only one of the field ID, Name, or ObjectRef is allowed"),
+		)
+	}
+
+	if spec.ObjectRef != nil && spec.ObjectRef.Name == "" {
+		allErrs = append(allErrs, field.Required(
+			f.Child("objectRef").Child("name"),
+			"object name must be set"),
 		)
 	}
 
@@ -661,3 +670,53 @@ func (w *Workspace) validateSpecVersionControlFileTriggers() field.ErrorList {
 // + Tags duplicate: spec.tags[]
 // + VariableSets duplicate: spec.variableSets[]
 // + Invalid CR cannot be deleted until it is fixed -- need to discuss if we want to do something about it
+
+// validateSpecBudget validates the following:
+//   - at least one of the fields `maxMonthlyCost` or `maxMonthlyCostDelta` is set.
+//   - both thresholds, when set, are non-negative decimal numbers.
+//   - the budget is used only with the apply method `manual`, since HCP Terraform applies auto-apply runs without waiting.
+func (w *Workspace) validateSpecBudget() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := w.Spec.Budget
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("budget")
+	if spec.MaxMonthlyCost == "" && spec.MaxMonthlyCostDelta == "" {
+		allErrs = append(allErrs, field.Required(
+			f,
+			"at least one of the fields 'maxMonthlyCost' or 'maxMonthlyCostDelta' must be set"),
+		)
+	}
+
+	if w.Spec.ApplyMethod == "auto" {
+		allErrs = append(allErrs, field.Forbidden(
+			f,
+			"budget requires 'spec.applyMethod' to be set to 'manual', since HCP Terraform applies auto-apply runs before the budget is enforced"),
+		)
+	}
+
+	thresholds := []struct {
+		name  string
+		value string
+	}{
+		{name: "maxMonthlyCost", value: spec.MaxMonthlyCost},
+		{name: "maxMonthlyCostDelta", value: spec.MaxMonthlyCostDelta},
+	}
+	for _, t := range thresholds {
+		if t.value == "" {
+			continue
+		}
+		if c, err := strconv.ParseFloat(t.value, 64); err != nil || c < 0 {
+			allErrs = append(allErrs, field.Invalid(
+				f.Child(t.name),
+				t.value,
+				"must be a non-negative decimal number"),
+			)
+		}
+	}
+
+	return allErrs
+}
@@ -848,6 +848,15 @@ func TestValidateWorkspaceSpecSSHKey(t *testing.T) {
 				},
 			},
 		},
+		"HasOnlyObjectRef": {
+			Spec: WorkspaceSpec{
+				SSHKey: &SSHKey{
+					ObjectRef: &corev1.LocalObjectReference{
+						Name: "this",
+					},
+				},
+			},
+		},
 	}
 
 	for n, c := range successCases {
@@ -871,6 +880,33 @@ func TestValidateWorkspaceSpecSSHKey(t *testing.T) {
 				SSHKey: &SSHKey{},
 			},
 		},
+		"HasIDandObjectRef": {
+			Spec: WorkspaceSpec{
+				SSHKey: &SSHKey{
+					ID: "this",
+					ObjectRef: &corev1.LocalObjectReference{
+						Name: "this",
+					},
+				},
+			},
+		},
+		"HasNameandObjectRef": {
+			Spec: WorkspaceSpec{
+				SSHKey: &SSHKey{
+					Name: "this",
+					ObjectRef: &corev1.LocalObjectReference{
+						Name: "this",
+					},
+				},
+			},
+		},
+		"HasEmptyObjectRefName": {
+			Spec: WorkspaceSpec{
+				SSHKey: &SSHKey{
+					ObjectRef: &corev1.LocalObjectReference{},
+				},
+			},
+		},
 	}
 
 	for n, c := range errorCases {
@@ -1230,3 +1266,95 @@ func TestValidateSpecVersionControl(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSpecBudget(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]Workspace{
+		"HasNoBudget": {
+			Spec: WorkspaceSpec{
+				Budget: nil,
+			},
+		},
+		"HasOnlyMaxMonthlyCost": {
+			Spec: WorkspaceSpec{
+				Budget: &WorkspaceBudget{
+					MaxMonthlyCost: "100.50",
+				},
+			},
+		},
+		"HasOnlyMaxMonthlyCostDelta": {
+			Spec: WorkspaceSpec{
+				Budget: &WorkspaceBudget{
+					MaxMonthlyCostDelta: "10",
+					Action:              BudgetActionDiscard,
+				},
+			},
+		},
+		"HasBothThresholds": {
+			Spec: WorkspaceSpec{
+				Budget: &WorkspaceBudget{
+					MaxMonthlyCost:      "100",
+					MaxMonthlyCostDelta: "0",
+					Action:              BudgetActionReport,
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecBudget()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]Workspace{
+		"HasNoThresholds": {
+			Spec: WorkspaceSpec{
+				Budget: &WorkspaceBudget{
+					Action: BudgetActionReport,
+				},
+			},
+		},
+		"HasInvalidMaxMonthlyCost": {
+			Spec: WorkspaceSpec{
+				Budget: &WorkspaceBudget{
+					MaxMonthlyCost: "ten",
+				},
+			},
+		},
+		"ReportWithAutoApplyMethod": {
+			Spec: WorkspaceSpec{
+				ApplyMethod: "auto",
+				Budget: &WorkspaceBudget{
+					MaxMonthlyCost: "100",
+					Action:         BudgetActionReport,
+				},
+			},
+		},
+		"DiscardWithAutoApplyMethod": {
+			Spec: WorkspaceSpec{
+				ApplyMethod: "auto",
+				Budget: &WorkspaceBudget{
+					MaxMonthlyCost: "100",
+					Action:         BudgetActionDiscard,
+				},
+			},
+		},
+		"HasNegativeMaxMonthlyCostDelta": {
+			Spec: WorkspaceSpec{
+				Budget: &WorkspaceBudget{
+					MaxMonthlyCostDelta: "-1",
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecBudget()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
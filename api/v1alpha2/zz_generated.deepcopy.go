@@ -8,10 +8,153 @@
 package v1alpha2
 
 import (
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIToken) DeepCopyInto(out *APIToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIToken.
+func (in *APIToken) DeepCopy() *APIToken {
+	if in == nil {
+		return nil
+	}
+	out := new(APIToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APITokenList) DeepCopyInto(out *APITokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APIToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenList.
+func (in *APITokenList) DeepCopy() *APITokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(APITokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APITokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APITokenRotation) DeepCopyInto(out *APITokenRotation) {
+	*out = *in
+	if in.ExpirationPeriodSeconds != nil {
+		in, out := &in.ExpirationPeriodSeconds, &out.ExpirationPeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RenewBeforeSeconds != nil {
+		in, out := &in.RenewBeforeSeconds, &out.RenewBeforeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OverlapSeconds != nil {
+		in, out := &in.OverlapSeconds, &out.OverlapSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenRotation.
+func (in *APITokenRotation) DeepCopy() *APITokenRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(APITokenRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APITokenSpec) DeepCopyInto(out *APITokenSpec) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	if in.Team != nil {
+		in, out := &in.Team, &out.Team
+		*out = new(Team)
+		**out = **in
+	}
+	in.Rotation.DeepCopyInto(&out.Rotation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenSpec.
+func (in *APITokenSpec) DeepCopy() *APITokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APITokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APITokenStatus) DeepCopyInto(out *APITokenStatus) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiredAt != nil {
+		in, out := &in.ExpiredAt, &out.ExpiredAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RotatedAt != nil {
+		in, out := &in.RotatedAt, &out.RotatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousRevokeAt != nil {
+		in, out := &in.PreviousRevokeAt, &out.PreviousRevokeAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenStatus.
+func (in *APITokenStatus) DeepCopy() *APITokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APITokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentAPIToken) DeepCopyInto(out *AgentAPIToken) {
 	*out = *in
@@ -25,6 +168,11 @@ func (in *AgentAPIToken) DeepCopyInto(out *AgentAPIToken) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.RotatedAt != nil {
+		in, out := &in.RotatedAt, &out.RotatedAt
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentAPIToken.
@@ -37,6 +185,66 @@ func (in *AgentAPIToken) DeepCopy() *AgentAPIToken {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentCacheVolume) DeepCopyInto(out *AgentCacheVolume) {
+	*out = *in
+	if in.VolumeClaimTemplate != nil {
+		in, out := &in.VolumeClaimTemplate, &out.VolumeClaimTemplate
+		*out = new(corev1.PersistentVolumeClaimTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentCacheVolume.
+func (in *AgentCacheVolume) DeepCopy() *AgentCacheVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentCacheVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentConfig) DeepCopyInto(out *AgentConfig) {
+	*out = *in
+	if in.Single != nil {
+		in, out := &in.Single, &out.Single
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequestForwarding != nil {
+		in, out := &in.RequestForwarding, &out.RequestForwarding
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(AgentHooks)
+		**out = **in
+	}
+	if in.OpenTelemetry != nil {
+		in, out := &in.OpenTelemetry, &out.OpenTelemetry
+		*out = new(AgentOpenTelemetry)
+		**out = **in
+	}
+	if in.CacheVolume != nil {
+		in, out := &in.CacheVolume, &out.CacheVolume
+		*out = new(AgentCacheVolume)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentConfig.
+func (in *AgentConfig) DeepCopy() *AgentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentDeployment) DeepCopyInto(out *AgentDeployment) {
 	*out = *in
@@ -47,7 +255,7 @@ func (in *AgentDeployment) DeepCopyInto(out *AgentDeployment) {
 	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
-		*out = new(v1.PodSpec)
+		*out = new(corev1.PodSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Annotations != nil {
@@ -64,6 +272,21 @@ func (in *AgentDeployment) DeepCopyInto(out *AgentDeployment) {
 			(*out)[key] = val
 		}
 	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(AgentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(AgentPodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpread != nil {
+		in, out := &in.TopologySpread, &out.TopologySpread
+		*out = new(AgentTopologySpread)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentDeployment.
@@ -95,7 +318,9 @@ func (in *AgentDeploymentAutoscaling) DeepCopyInto(out *AgentDeploymentAutoscali
 		if **in != nil {
 			in, out := *in, *out
 			*out = make([]TargetWorkspace, len(*in))
-			copy(*out, *in)
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
 		}
 	}
 	if in.CooldownPeriodSeconds != nil {
@@ -108,6 +333,13 @@ func (in *AgentDeploymentAutoscaling) DeepCopyInto(out *AgentDeploymentAutoscali
 		*out = new(AgentDeploymentAutoscalingCooldownPeriod)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]AgentDeploymentAutoscalingSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentDeploymentAutoscaling.
@@ -145,6 +377,32 @@ func (in *AgentDeploymentAutoscalingCooldownPeriod) DeepCopy() *AgentDeploymentA
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentDeploymentAutoscalingSchedule) DeepCopyInto(out *AgentDeploymentAutoscalingSchedule) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentDeploymentAutoscalingSchedule.
+func (in *AgentDeploymentAutoscalingSchedule) DeepCopy() *AgentDeploymentAutoscalingSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentDeploymentAutoscalingSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentDeploymentAutoscalingStatus) DeepCopyInto(out *AgentDeploymentAutoscalingStatus) {
 	*out = *in
@@ -157,6 +415,16 @@ func (in *AgentDeploymentAutoscalingStatus) DeepCopyInto(out *AgentDeploymentAut
 		in, out := &in.LastScalingEvent, &out.LastScalingEvent
 		*out = (*in).DeepCopy()
 	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentDeploymentAutoscalingStatus.
@@ -170,168 +438,1543 @@ func (in *AgentDeploymentAutoscalingStatus) DeepCopy() *AgentDeploymentAutoscali
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AgentPool) DeepCopyInto(out *AgentPool) {
+func (in *AgentDeploymentClass) DeepCopyInto(out *AgentDeploymentClass) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.AgentDeployment.DeepCopyInto(&out.AgentDeployment)
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AgentDeploymentAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPool.
-func (in *AgentPool) DeepCopy() *AgentPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentDeploymentClass.
+func (in *AgentDeploymentClass) DeepCopy() *AgentDeploymentClass {
 	if in == nil {
 		return nil
 	}
-	out := new(AgentPool)
+	out := new(AgentDeploymentClass)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AgentPool) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentDeploymentClassStatus) DeepCopyInto(out *AgentDeploymentClassStatus) {
+	*out = *in
+	in.AgentDeploymentAutoscalingStatus.DeepCopyInto(&out.AgentDeploymentAutoscalingStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentDeploymentClassStatus.
+func (in *AgentDeploymentClassStatus) DeepCopy() *AgentDeploymentClassStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(AgentDeploymentClassStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AgentPoolList) DeepCopyInto(out *AgentPoolList) {
+func (in *AgentDeploymentStatus) DeepCopyInto(out *AgentDeploymentStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]AgentPool, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.CrashLoopingPods != nil {
+		in, out := &in.CrashLoopingPods, &out.CrashLoopingPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentDeploymentStatus.
+func (in *AgentDeploymentStatus) DeepCopy() *AgentDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentExternalAutoscaling) DeepCopyInto(out *AgentExternalAutoscaling) {
+	*out = *in
+	if in.TargetWorkspaces != nil {
+		in, out := &in.TargetWorkspaces, &out.TargetWorkspaces
+		*out = new([]TargetWorkspace)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]TargetWorkspace, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolList.
-func (in *AgentPoolList) DeepCopy() *AgentPoolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentExternalAutoscaling.
+func (in *AgentExternalAutoscaling) DeepCopy() *AgentExternalAutoscaling {
 	if in == nil {
 		return nil
 	}
-	out := new(AgentPoolList)
+	out := new(AgentExternalAutoscaling)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AgentPoolList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentExternalAutoscalingStatus) DeepCopyInto(out *AgentExternalAutoscalingStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentExternalAutoscalingStatus.
+func (in *AgentExternalAutoscalingStatus) DeepCopy() *AgentExternalAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentExternalAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentHooks) DeepCopyInto(out *AgentHooks) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentHooks.
+func (in *AgentHooks) DeepCopy() *AgentHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentJobs) DeepCopyInto(out *AgentJobs) {
+	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(corev1.PodSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MaxJobs != nil {
+		in, out := &in.MaxJobs, &out.MaxJobs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinJobs != nil {
+		in, out := &in.MinJobs, &out.MinJobs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentJobs.
+func (in *AgentJobs) DeepCopy() *AgentJobs {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentJobs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentJobsStatus) DeepCopyInto(out *AgentJobsStatus) {
+	*out = *in
+	if in.LastJobCreated != nil {
+		in, out := &in.LastJobCreated, &out.LastJobCreated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentJobsStatus.
+func (in *AgentJobsStatus) DeepCopy() *AgentJobsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentJobsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentOpenTelemetry) DeepCopyInto(out *AgentOpenTelemetry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentOpenTelemetry.
+func (in *AgentOpenTelemetry) DeepCopy() *AgentOpenTelemetry {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentOpenTelemetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPodDisruptionBudget) DeepCopyInto(out *AgentPodDisruptionBudget) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPodDisruptionBudget.
+func (in *AgentPodDisruptionBudget) DeepCopy() *AgentPodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPool) DeepCopyInto(out *AgentPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPool.
+func (in *AgentPool) DeepCopy() *AgentPool {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolList) DeepCopyInto(out *AgentPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AgentPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolList.
+func (in *AgentPoolList) DeepCopy() *AgentPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolRef) DeepCopyInto(out *AgentPoolRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolRef.
+func (in *AgentPoolRef) DeepCopy() *AgentPoolRef {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolSpec) DeepCopyInto(out *AgentPoolSpec) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	if in.AgentTokens != nil {
+		in, out := &in.AgentTokens, &out.AgentTokens
+		*out = make([]*AgentAPIToken, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AgentAPIToken)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AgentTokenRotation != nil {
+		in, out := &in.AgentTokenRotation, &out.AgentTokenRotation
+		*out = new(AgentTokenRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaleAgentTokens != nil {
+		in, out := &in.StaleAgentTokens, &out.StaleAgentTokens
+		*out = new(StaleAgentTokens)
+		**out = **in
+	}
+	if in.AgentDeployment != nil {
+		in, out := &in.AgentDeployment, &out.AgentDeployment
+		*out = new(AgentDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AgentDeploymentAutoscaling != nil {
+		in, out := &in.AgentDeploymentAutoscaling, &out.AgentDeploymentAutoscaling
+		*out = new(AgentDeploymentAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalAutoscaling != nil {
+		in, out := &in.ExternalAutoscaling, &out.ExternalAutoscaling
+		*out = new(AgentExternalAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AgentJobs != nil {
+		in, out := &in.AgentJobs, &out.AgentJobs
+		*out = new(AgentJobs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AgentDeploymentClasses != nil {
+		in, out := &in.AgentDeploymentClasses, &out.AgentDeploymentClasses
+		*out = make([]AgentDeploymentClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolSpec.
+func (in *AgentPoolSpec) DeepCopy() *AgentPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolStatus) DeepCopyInto(out *AgentPoolStatus) {
+	*out = *in
+	if in.AgentTokens != nil {
+		in, out := &in.AgentTokens, &out.AgentTokens
+		*out = make([]*AgentAPIToken, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AgentAPIToken)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AgentDeployment != nil {
+		in, out := &in.AgentDeployment, &out.AgentDeployment
+		*out = new(AgentDeploymentStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AgentDeploymentAutoscalingStatus != nil {
+		in, out := &in.AgentDeploymentAutoscalingStatus, &out.AgentDeploymentAutoscalingStatus
+		*out = new(AgentDeploymentAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AgentJobsStatus != nil {
+		in, out := &in.AgentJobsStatus, &out.AgentJobsStatus
+		*out = new(AgentJobsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AgentDeploymentClasses != nil {
+		in, out := &in.AgentDeploymentClasses, &out.AgentDeploymentClasses
+		*out = make([]AgentDeploymentClassStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalAutoscaling != nil {
+		in, out := &in.ExternalAutoscaling, &out.ExternalAutoscaling
+		*out = new(AgentExternalAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Agents != nil {
+		in, out := &in.Agents, &out.Agents
+		*out = new(AgentsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaleAgentTokens != nil {
+		in, out := &in.StaleAgentTokens, &out.StaleAgentTokens
+		*out = make([]StaleAgentToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolStatus.
+func (in *AgentPoolStatus) DeepCopy() *AgentPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentStatus) DeepCopyInto(out *AgentStatus) {
+	*out = *in
+	if in.LastPingAt != nil {
+		in, out := &in.LastPingAt, &out.LastPingAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentStatus.
+func (in *AgentStatus) DeepCopy() *AgentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentToken) DeepCopyInto(out *AgentToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentToken.
+func (in *AgentToken) DeepCopy() *AgentToken {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentTokenList) DeepCopyInto(out *AgentTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AgentToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTokenList.
+func (in *AgentTokenList) DeepCopy() *AgentTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentTokenRotation) DeepCopyInto(out *AgentTokenRotation) {
+	*out = *in
+	out.MaxAge = in.MaxAge
+	if in.OverlapPeriod != nil {
+		in, out := &in.OverlapPeriod, &out.OverlapPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTokenRotation.
+func (in *AgentTokenRotation) DeepCopy() *AgentTokenRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentTokenRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentTokenSecretKeyData) DeepCopyInto(out *AgentTokenSecretKeyData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTokenSecretKeyData.
+func (in *AgentTokenSecretKeyData) DeepCopy() *AgentTokenSecretKeyData {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentTokenSecretKeyData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentTokenSecretTemplate) DeepCopyInto(out *AgentTokenSecretTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTokenSecretTemplate.
+func (in *AgentTokenSecretTemplate) DeepCopy() *AgentTokenSecretTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentTokenSecretTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentTokenSpec) DeepCopyInto(out *AgentTokenSpec) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	out.AgentPool = in.AgentPool
+	if in.AgentTokens != nil {
+		in, out := &in.AgentTokens, &out.AgentTokens
+		*out = make([]AgentAPIToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(AgentTokenRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaleTokens != nil {
+		in, out := &in.StaleTokens, &out.StaleTokens
+		*out = new(StaleAgentTokens)
+		**out = **in
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(AgentTokenSecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTokenSpec.
+func (in *AgentTokenSpec) DeepCopy() *AgentTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentTokenStatus) DeepCopyInto(out *AgentTokenStatus) {
+	*out = *in
+	if in.AgentPool != nil {
+		in, out := &in.AgentPool, &out.AgentPool
+		*out = new(AgentPoolRef)
+		**out = **in
+	}
+	if in.AgentTokens != nil {
+		in, out := &in.AgentTokens, &out.AgentTokens
+		*out = make([]*AgentAPIToken, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AgentAPIToken)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.StaleTokens != nil {
+		in, out := &in.StaleTokens, &out.StaleTokens
+		*out = make([]StaleAgentToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecretKeys != nil {
+		in, out := &in.SecretKeys, &out.SecretKeys
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretLabels != nil {
+		in, out := &in.SecretLabels, &out.SecretLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretAnnotations != nil {
+		in, out := &in.SecretAnnotations, &out.SecretAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProjectedNamespaces != nil {
+		in, out := &in.ProjectedNamespaces, &out.ProjectedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTokenStatus.
+func (in *AgentTokenStatus) DeepCopy() *AgentTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentTopologySpread) DeepCopyInto(out *AgentTopologySpread) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTopologySpread.
+func (in *AgentTopologySpread) DeepCopy() *AgentTopologySpread {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentTopologySpread)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentsStatus) DeepCopyInto(out *AgentsStatus) {
+	*out = *in
+	if in.Agents != nil {
+		in, out := &in.Agents, &out.Agents
+		*out = make([]AgentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentsStatus.
+func (in *AgentsStatus) DeepCopy() *AgentsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationVersionStatus) DeepCopyInto(out *ConfigurationVersionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationVersionStatus.
+func (in *ConfigurationVersionStatus) DeepCopy() *ConfigurationVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsumerWorkspace) DeepCopyInto(out *ConsumerWorkspace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsumerWorkspace.
+func (in *ConsumerWorkspace) DeepCopy() *ConsumerWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsumerWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostEstimationStatus) DeepCopyInto(out *CostEstimationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostEstimationStatus.
+func (in *CostEstimationStatus) DeepCopy() *CostEstimationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CostEstimationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPermissions) DeepCopyInto(out *CustomPermissions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPermissions.
+func (in *CustomPermissions) DeepCopy() *CustomPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomProjectPermissions) DeepCopyInto(out *CustomProjectPermissions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomProjectPermissions.
+func (in *CustomProjectPermissions) DeepCopy() *CustomProjectPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomProjectPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Module) DeepCopyInto(out *Module) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Module.
+func (in *Module) DeepCopy() *Module {
+	if in == nil {
+		return nil
+	}
+	out := new(Module)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Module) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleList) DeepCopyInto(out *ModuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Module, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleList.
+func (in *ModuleList) DeepCopy() *ModuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleOutput) DeepCopyInto(out *ModuleOutput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleOutput.
+func (in *ModuleOutput) DeepCopy() *ModuleOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleSource) DeepCopyInto(out *ModuleSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleSource.
+func (in *ModuleSource) DeepCopy() *ModuleSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleSpec) DeepCopyInto(out *ModuleSpec) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	if in.Module != nil {
+		in, out := &in.Module, &out.Module
+		*out = new(ModuleSource)
+		**out = **in
+	}
+	if in.Workspace != nil {
+		in, out := &in.Workspace, &out.Workspace
+		*out = new(ModuleWorkspace)
+		**out = **in
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]ModuleVariable, len(*in))
+		copy(*out, *in)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]ModuleOutput, len(*in))
+		copy(*out, *in)
+	}
+	if in.RunHistoryLimit != nil {
+		in, out := &in.RunHistoryLimit, &out.RunHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleSpec.
+func (in *ModuleSpec) DeepCopy() *ModuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleStatus) DeepCopyInto(out *ModuleStatus) {
+	*out = *in
+	if in.ConfigurationVersion != nil {
+		in, out := &in.ConfigurationVersion, &out.ConfigurationVersion
+		*out = new(ConfigurationVersionStatus)
+		**out = **in
+	}
+	if in.Run != nil {
+		in, out := &in.Run, &out.Run
+		*out = new(RunStatus)
+		**out = **in
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(OutputStatus)
+		**out = **in
+	}
+	if in.RunHistory != nil {
+		in, out := &in.RunHistory, &out.RunHistory
+		*out = new(RunHistoryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleStatus.
+func (in *ModuleStatus) DeepCopy() *ModuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleVariable) DeepCopyInto(out *ModuleVariable) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleVariable.
+func (in *ModuleVariable) DeepCopy() *ModuleVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleWorkspace) DeepCopyInto(out *ModuleWorkspace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleWorkspace.
+func (in *ModuleWorkspace) DeepCopy() *ModuleWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoCodeModuleRef) DeepCopyInto(out *NoCodeModuleRef) {
+	*out = *in
+	if in.RegistryModule != nil {
+		in, out := &in.RegistryModule, &out.RegistryModule
+		*out = new(NoCodeRegistryModule)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoCodeModuleRef.
+func (in *NoCodeModuleRef) DeepCopy() *NoCodeModuleRef {
+	if in == nil {
+		return nil
+	}
+	out := new(NoCodeModuleRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoCodeRegistryModule) DeepCopyInto(out *NoCodeRegistryModule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoCodeRegistryModule.
+func (in *NoCodeRegistryModule) DeepCopy() *NoCodeRegistryModule {
+	if in == nil {
+		return nil
+	}
+	out := new(NoCodeRegistryModule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoCodeWorkspace) DeepCopyInto(out *NoCodeWorkspace) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoCodeWorkspace.
+func (in *NoCodeWorkspace) DeepCopy() *NoCodeWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(NoCodeWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NoCodeWorkspace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoCodeWorkspaceList) DeepCopyInto(out *NoCodeWorkspaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NoCodeWorkspace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoCodeWorkspaceList.
+func (in *NoCodeWorkspaceList) DeepCopy() *NoCodeWorkspaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(NoCodeWorkspaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NoCodeWorkspaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoCodeWorkspaceSpec) DeepCopyInto(out *NoCodeWorkspaceSpec) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	in.Module.DeepCopyInto(&out.Module)
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(WorkspaceProject)
+		**out = **in
+	}
+	if in.AgentPool != nil {
+		in, out := &in.AgentPool, &out.AgentPool
+		*out = new(AgentPoolRef)
+		**out = **in
+	}
+	if in.TerraformVariables != nil {
+		in, out := &in.TerraformVariables, &out.TerraformVariables
+		*out = make([]Variable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoCodeWorkspaceSpec.
+func (in *NoCodeWorkspaceSpec) DeepCopy() *NoCodeWorkspaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NoCodeWorkspaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoCodeWorkspaceStatus) DeepCopyInto(out *NoCodeWorkspaceStatus) {
+	*out = *in
+	if in.Run != nil {
+		in, out := &in.Run, &out.Run
+		*out = new(RunStatus)
+		**out = **in
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(OutputStatus)
+		**out = **in
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(NoCodeWorkspaceUpgradeStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoCodeWorkspaceStatus.
+func (in *NoCodeWorkspaceStatus) DeepCopy() *NoCodeWorkspaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NoCodeWorkspaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoCodeWorkspaceUpgradeStatus) DeepCopyInto(out *NoCodeWorkspaceUpgradeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoCodeWorkspaceUpgradeStatus.
+func (in *NoCodeWorkspaceUpgradeStatus) DeepCopy() *NoCodeWorkspaceUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NoCodeWorkspaceUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notification) DeepCopyInto(out *Notification) {
+	*out = *in
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]NotificationTrigger, len(*in))
+		copy(*out, *in)
+	}
+	if in.EmailAddresses != nil {
+		in, out := &in.EmailAddresses, &out.EmailAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EmailUsers != nil {
+		in, out := &in.EmailUsers, &out.EmailUsers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notification.
+func (in *Notification) DeepCopy() *Notification {
+	if in == nil {
+		return nil
+	}
+	out := new(Notification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Organization) DeepCopyInto(out *Organization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
+func (in *Organization) DeepCopy() *Organization {
+	if in == nil {
+		return nil
+	}
+	out := new(Organization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Organization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Organization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
+func (in *OrganizationList) DeepCopy() *OrganizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSSHKey) DeepCopyInto(out *OrganizationSSHKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSSHKey.
+func (in *OrganizationSSHKey) DeepCopy() *OrganizationSSHKey {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSSHKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationSSHKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSSHKeyList) DeepCopyInto(out *OrganizationSSHKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationSSHKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSSHKeyList.
+func (in *OrganizationSSHKeyList) DeepCopy() *OrganizationSSHKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSSHKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationSSHKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSSHKeyPrivateKey) DeepCopyInto(out *OrganizationSSHKeyPrivateKey) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSSHKeyPrivateKey.
+func (in *OrganizationSSHKeyPrivateKey) DeepCopy() *OrganizationSSHKeyPrivateKey {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSSHKeyPrivateKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSSHKeySpec) DeepCopyInto(out *OrganizationSSHKeySpec) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	in.PrivateKey.DeepCopyInto(&out.PrivateKey)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSSHKeySpec.
+func (in *OrganizationSSHKeySpec) DeepCopy() *OrganizationSSHKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSSHKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSSHKeyStatus) DeepCopyInto(out *OrganizationSSHKeyStatus) {
+	*out = *in
+	if in.RotatedAt != nil {
+		in, out := &in.RotatedAt, &out.RotatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousIDs != nil {
+		in, out := &in.PreviousIDs, &out.PreviousIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSSHKeyStatus.
+func (in *OrganizationSSHKeyStatus) DeepCopy() *OrganizationSSHKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSSHKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSession) DeepCopyInto(out *OrganizationSession) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.Remember != nil {
+		in, out := &in.Remember, &out.Remember
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSession.
+func (in *OrganizationSession) DeepCopy() *OrganizationSession {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	if in.DefaultAgentPool != nil {
+		in, out := &in.DefaultAgentPool, &out.DefaultAgentPool
+		*out = new(AgentPoolRef)
+		**out = **in
+	}
+	if in.CostEstimationEnabled != nil {
+		in, out := &in.CostEstimationEnabled, &out.CostEstimationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AssessmentsEnforced != nil {
+		in, out := &in.AssessmentsEnforced, &out.AssessmentsEnforced
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SpeculativePlanManagementEnabled != nil {
+		in, out := &in.SpeculativePlanManagementEnabled, &out.SpeculativePlanManagementEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Session != nil {
+		in, out := &in.Session, &out.Session
+		*out = new(OrganizationSession)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
+func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
+	*out = *in
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DriftDetectedAt != nil {
+		in, out := &in.DriftDetectedAt, &out.DriftDetectedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
+func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputStatus) DeepCopyInto(out *OutputStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputStatus.
+func (in *OutputStatus) DeepCopy() *OutputStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(OutputStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AgentPoolRef) DeepCopyInto(out *AgentPoolRef) {
+func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolRef.
-func (in *AgentPoolRef) DeepCopy() *AgentPoolRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus.
+func (in *PlanStatus) DeepCopy() *PlanStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AgentPoolRef)
+	out := new(PlanStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AgentPoolSpec) DeepCopyInto(out *AgentPoolSpec) {
+func (in *PolicyCheckStatus) DeepCopyInto(out *PolicyCheckStatus) {
 	*out = *in
-	in.Token.DeepCopyInto(&out.Token)
-	if in.AgentTokens != nil {
-		in, out := &in.AgentTokens, &out.AgentTokens
-		*out = make([]*AgentAPIToken, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(AgentAPIToken)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.AgentDeployment != nil {
-		in, out := &in.AgentDeployment, &out.AgentDeployment
-		*out = new(AgentDeployment)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AgentDeploymentAutoscaling != nil {
-		in, out := &in.AgentDeploymentAutoscaling, &out.AgentDeploymentAutoscaling
-		*out = new(AgentDeploymentAutoscaling)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolSpec.
-func (in *AgentPoolSpec) DeepCopy() *AgentPoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyCheckStatus.
+func (in *PolicyCheckStatus) DeepCopy() *PolicyCheckStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AgentPoolSpec)
+	out := new(PolicyCheckStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AgentPoolStatus) DeepCopyInto(out *AgentPoolStatus) {
+func (in *PolicyOutcomeStatus) DeepCopyInto(out *PolicyOutcomeStatus) {
 	*out = *in
-	if in.AgentTokens != nil {
-		in, out := &in.AgentTokens, &out.AgentTokens
-		*out = make([]*AgentAPIToken, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(AgentAPIToken)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.AgentDeploymentAutoscalingStatus != nil {
-		in, out := &in.AgentDeploymentAutoscalingStatus, &out.AgentDeploymentAutoscalingStatus
-		*out = new(AgentDeploymentAutoscalingStatus)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolStatus.
-func (in *AgentPoolStatus) DeepCopy() *AgentPoolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyOutcomeStatus.
+func (in *PolicyOutcomeStatus) DeepCopy() *PolicyOutcomeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AgentPoolStatus)
+	out := new(PolicyOutcomeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AgentToken) DeepCopyInto(out *AgentToken) {
+func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentToken.
-func (in *AgentToken) DeepCopy() *AgentToken {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
 	if in == nil {
 		return nil
 	}
-	out := new(AgentToken)
+	out := new(Project)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AgentToken) DeepCopyObject() runtime.Object {
+func (in *Project) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -339,31 +1982,31 @@ func (in *AgentToken) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AgentTokenList) DeepCopyInto(out *AgentTokenList) {
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AgentToken, len(*in))
+		*out = make([]Project, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTokenList.
-func (in *AgentTokenList) DeepCopy() *AgentTokenList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
 	if in == nil {
 		return nil
 	}
-	out := new(AgentTokenList)
+	out := new(ProjectList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AgentTokenList) DeepCopyObject() runtime.Object {
+func (in *ProjectList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -371,122 +2014,70 @@ func (in *AgentTokenList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AgentTokenSpec) DeepCopyInto(out *AgentTokenSpec) {
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
 	in.Token.DeepCopyInto(&out.Token)
-	out.AgentPool = in.AgentPool
-	if in.AgentTokens != nil {
-		in, out := &in.AgentTokens, &out.AgentTokens
-		*out = make([]AgentAPIToken, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTokenSpec.
-func (in *AgentTokenSpec) DeepCopy() *AgentTokenSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(AgentTokenSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AgentTokenStatus) DeepCopyInto(out *AgentTokenStatus) {
-	*out = *in
-	if in.AgentPool != nil {
-		in, out := &in.AgentPool, &out.AgentPool
-		*out = new(AgentPoolRef)
-		**out = **in
-	}
-	if in.AgentTokens != nil {
-		in, out := &in.AgentTokens, &out.AgentTokens
-		*out = make([]*AgentAPIToken, len(*in))
+	if in.TeamAccess != nil {
+		in, out := &in.TeamAccess, &out.TeamAccess
+		*out = make([]*ProjectTeamAccess, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(AgentAPIToken)
+				*out = new(ProjectTeamAccess)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTokenStatus.
-func (in *AgentTokenStatus) DeepCopy() *AgentTokenStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(AgentTokenStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigurationVersionStatus) DeepCopyInto(out *ConfigurationVersionStatus) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationVersionStatus.
-func (in *ConfigurationVersionStatus) DeepCopy() *ConfigurationVersionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigurationVersionStatus)
+	out := new(ProjectSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConsumerWorkspace) DeepCopyInto(out *ConsumerWorkspace) {
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsumerWorkspace.
-func (in *ConsumerWorkspace) DeepCopy() *ConsumerWorkspace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConsumerWorkspace)
+	out := new(ProjectStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomPermissions) DeepCopyInto(out *CustomPermissions) {
+func (in *ProjectTeamAccess) DeepCopyInto(out *ProjectTeamAccess) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPermissions.
-func (in *CustomPermissions) DeepCopy() *CustomPermissions {
-	if in == nil {
-		return nil
+	out.Team = in.Team
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = new(CustomProjectPermissions)
+		**out = **in
 	}
-	out := new(CustomPermissions)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomProjectPermissions) DeepCopyInto(out *CustomProjectPermissions) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomProjectPermissions.
-func (in *CustomProjectPermissions) DeepCopy() *CustomProjectPermissions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamAccess.
+func (in *ProjectTeamAccess) DeepCopy() *ProjectTeamAccess {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomProjectPermissions)
+	out := new(ProjectTeamAccess)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Module) DeepCopyInto(out *Module) {
+func (in *RegistryModule) DeepCopyInto(out *RegistryModule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -494,18 +2085,18 @@ func (in *Module) DeepCopyInto(out *Module) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Module.
-func (in *Module) DeepCopy() *Module {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryModule.
+func (in *RegistryModule) DeepCopy() *RegistryModule {
 	if in == nil {
 		return nil
 	}
-	out := new(Module)
+	out := new(RegistryModule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Module) DeepCopyObject() runtime.Object {
+func (in *RegistryModule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -513,31 +2104,31 @@ func (in *Module) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleList) DeepCopyInto(out *ModuleList) {
+func (in *RegistryModuleList) DeepCopyInto(out *RegistryModuleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Module, len(*in))
+		*out = make([]RegistryModule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleList.
-func (in *ModuleList) DeepCopy() *ModuleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryModuleList.
+func (in *RegistryModuleList) DeepCopy() *RegistryModuleList {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleList)
+	out := new(RegistryModuleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ModuleList) DeepCopyObject() runtime.Object {
+func (in *RegistryModuleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -545,350 +2136,319 @@ func (in *ModuleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleOutput) DeepCopyInto(out *ModuleOutput) {
+func (in *RegistryModuleSpec) DeepCopyInto(out *RegistryModuleSpec) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleOutput.
-func (in *ModuleOutput) DeepCopy() *ModuleOutput {
-	if in == nil {
-		return nil
+	in.Token.DeepCopyInto(&out.Token)
+	if in.VersionControl != nil {
+		in, out := &in.VersionControl, &out.VersionControl
+		*out = new(RegistryModuleVersionControl)
+		**out = **in
+	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]RegistryModuleVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TestConfig != nil {
+		in, out := &in.TestConfig, &out.TestConfig
+		*out = new(RegistryModuleTestConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ModuleOutput)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleSource) DeepCopyInto(out *ModuleSource) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleSource.
-func (in *ModuleSource) DeepCopy() *ModuleSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryModuleSpec.
+func (in *RegistryModuleSpec) DeepCopy() *RegistryModuleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleSource)
+	out := new(RegistryModuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleSpec) DeepCopyInto(out *ModuleSpec) {
+func (in *RegistryModuleStatus) DeepCopyInto(out *RegistryModuleStatus) {
 	*out = *in
-	in.Token.DeepCopyInto(&out.Token)
-	if in.Module != nil {
-		in, out := &in.Module, &out.Module
-		*out = new(ModuleSource)
-		**out = **in
-	}
-	if in.Workspace != nil {
-		in, out := &in.Workspace, &out.Workspace
-		*out = new(ModuleWorkspace)
-		**out = **in
-	}
-	if in.Variables != nil {
-		in, out := &in.Variables, &out.Variables
-		*out = make([]ModuleVariable, len(*in))
-		copy(*out, *in)
-	}
-	if in.Outputs != nil {
-		in, out := &in.Outputs, &out.Outputs
-		*out = make([]ModuleOutput, len(*in))
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]RegistryModuleVersionStatus, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleSpec.
-func (in *ModuleSpec) DeepCopy() *ModuleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryModuleStatus.
+func (in *RegistryModuleStatus) DeepCopy() *RegistryModuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleSpec)
+	out := new(RegistryModuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleStatus) DeepCopyInto(out *ModuleStatus) {
+func (in *RegistryModuleTestConfig) DeepCopyInto(out *RegistryModuleTestConfig) {
 	*out = *in
-	if in.ConfigurationVersion != nil {
-		in, out := &in.ConfigurationVersion, &out.ConfigurationVersion
-		*out = new(ConfigurationVersionStatus)
-		**out = **in
-	}
-	if in.Run != nil {
-		in, out := &in.Run, &out.Run
-		*out = new(RunStatus)
-		**out = **in
-	}
-	if in.Output != nil {
-		in, out := &in.Output, &out.Output
-		*out = new(OutputStatus)
+	if in.AgentPool != nil {
+		in, out := &in.AgentPool, &out.AgentPool
+		*out = new(AgentPoolRef)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleStatus.
-func (in *ModuleStatus) DeepCopy() *ModuleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryModuleTestConfig.
+func (in *RegistryModuleTestConfig) DeepCopy() *RegistryModuleTestConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleStatus)
+	out := new(RegistryModuleTestConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleVariable) DeepCopyInto(out *ModuleVariable) {
+func (in *RegistryModuleVersion) DeepCopyInto(out *RegistryModuleVersion) {
 	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleVariable.
-func (in *ModuleVariable) DeepCopy() *ModuleVariable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryModuleVersion.
+func (in *RegistryModuleVersion) DeepCopy() *RegistryModuleVersion {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleVariable)
+	out := new(RegistryModuleVersion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModuleWorkspace) DeepCopyInto(out *ModuleWorkspace) {
+func (in *RegistryModuleVersionControl) DeepCopyInto(out *RegistryModuleVersionControl) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleWorkspace.
-func (in *ModuleWorkspace) DeepCopy() *ModuleWorkspace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryModuleVersionControl.
+func (in *RegistryModuleVersionControl) DeepCopy() *RegistryModuleVersionControl {
 	if in == nil {
 		return nil
 	}
-	out := new(ModuleWorkspace)
+	out := new(RegistryModuleVersionControl)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Notification) DeepCopyInto(out *Notification) {
+func (in *RegistryModuleVersionSource) DeepCopyInto(out *RegistryModuleVersionSource) {
 	*out = *in
-	if in.Triggers != nil {
-		in, out := &in.Triggers, &out.Triggers
-		*out = make([]NotificationTrigger, len(*in))
-		copy(*out, *in)
-	}
-	if in.EmailAddresses != nil {
-		in, out := &in.EmailAddresses, &out.EmailAddresses
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
 	}
-	if in.EmailUsers != nil {
-		in, out := &in.EmailUsers, &out.EmailUsers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.TarballRef != nil {
+		in, out := &in.TarballRef, &out.TarballRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notification.
-func (in *Notification) DeepCopy() *Notification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryModuleVersionSource.
+func (in *RegistryModuleVersionSource) DeepCopy() *RegistryModuleVersionSource {
 	if in == nil {
 		return nil
 	}
-	out := new(Notification)
+	out := new(RegistryModuleVersionSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OutputStatus) DeepCopyInto(out *OutputStatus) {
+func (in *RegistryModuleVersionStatus) DeepCopyInto(out *RegistryModuleVersionStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputStatus.
-func (in *OutputStatus) DeepCopy() *OutputStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryModuleVersionStatus.
+func (in *RegistryModuleVersionStatus) DeepCopy() *RegistryModuleVersionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OutputStatus)
+	out := new(RegistryModuleVersionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
+func (in *RemoteStateSharing) DeepCopyInto(out *RemoteStateSharing) {
 	*out = *in
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]*ConsumerWorkspace, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConsumerWorkspace)
+				**out = **in
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus.
-func (in *PlanStatus) DeepCopy() *PlanStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteStateSharing.
+func (in *RemoteStateSharing) DeepCopy() *RemoteStateSharing {
 	if in == nil {
 		return nil
 	}
-	out := new(PlanStatus)
+	out := new(RemoteStateSharing)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *RunChecksStatus) DeepCopyInto(out *RunChecksStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]RunStageStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]PolicyOutcomeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyChecks != nil {
+		in, out := &in.PolicyChecks, &out.PolicyChecks
+		*out = make([]PolicyCheckStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.RunTasks != nil {
+		in, out := &in.RunTasks, &out.RunTasks
+		*out = make([]RunTaskResultStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunChecksStatus.
+func (in *RunChecksStatus) DeepCopy() *RunChecksStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(RunChecksStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *RunHistoryEntry) DeepCopyInto(out *RunHistoryEntry) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunHistoryEntry.
+func (in *RunHistoryEntry) DeepCopy() *RunHistoryEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectList)
+	out := new(RunHistoryEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *RunHistoryStatus) DeepCopyInto(out *RunHistoryStatus) {
 	*out = *in
-	in.Token.DeepCopyInto(&out.Token)
-	if in.TeamAccess != nil {
-		in, out := &in.TeamAccess, &out.TeamAccess
-		*out = make([]*ProjectTeamAccess, len(*in))
+	if in.Runs != nil {
+		in, out := &in.Runs, &out.Runs
+		*out = make([]RunHistoryEntry, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ProjectTeamAccess)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunHistoryStatus.
+func (in *RunHistoryStatus) DeepCopy() *RunHistoryStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(RunHistoryStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *RunOverrideStatus) DeepCopyInto(out *RunOverrideStatus) {
 	*out = *in
+	if in.ProcessedAt != nil {
+		in, out := &in.ProcessedAt, &out.ProcessedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunOverrideStatus.
+func (in *RunOverrideStatus) DeepCopy() *RunOverrideStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(RunOverrideStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectTeamAccess) DeepCopyInto(out *ProjectTeamAccess) {
+func (in *RunStageStatus) DeepCopyInto(out *RunStageStatus) {
 	*out = *in
-	out.Team = in.Team
-	if in.Custom != nil {
-		in, out := &in.Custom, &out.Custom
-		*out = new(CustomProjectPermissions)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamAccess.
-func (in *ProjectTeamAccess) DeepCopy() *ProjectTeamAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunStageStatus.
+func (in *RunStageStatus) DeepCopy() *RunStageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectTeamAccess)
+	out := new(RunStageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RemoteStateSharing) DeepCopyInto(out *RemoteStateSharing) {
+func (in *RunStatus) DeepCopyInto(out *RunStatus) {
 	*out = *in
-	if in.Workspaces != nil {
-		in, out := &in.Workspaces, &out.Workspaces
-		*out = make([]*ConsumerWorkspace, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConsumerWorkspace)
-				**out = **in
-			}
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteStateSharing.
-func (in *RemoteStateSharing) DeepCopy() *RemoteStateSharing {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunStatus.
+func (in *RunStatus) DeepCopy() *RunStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RemoteStateSharing)
+	out := new(RunStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunStatus) DeepCopyInto(out *RunStatus) {
+func (in *RunTaskResultStatus) DeepCopyInto(out *RunTaskResultStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunStatus.
-func (in *RunStatus) DeepCopy() *RunStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunTaskResultStatus.
+func (in *RunTaskResultStatus) DeepCopy() *RunTaskResultStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RunStatus)
+	out := new(RunTaskResultStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -935,6 +2495,26 @@ func (in *RunsCollector) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunsCollectorAgentPools) DeepCopyInto(out *RunsCollectorAgentPools) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollectorAgentPools.
+func (in *RunsCollectorAgentPools) DeepCopy() *RunsCollectorAgentPools {
+	if in == nil {
+		return nil
+	}
+	out := new(RunsCollectorAgentPools)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RunsCollectorList) DeepCopyInto(out *RunsCollectorList) {
 	*out = *in
@@ -976,6 +2556,16 @@ func (in *RunsCollectorSpec) DeepCopyInto(out *RunsCollectorSpec) {
 		*out = new(AgentPoolRef)
 		**out = **in
 	}
+	if in.AgentPools != nil {
+		in, out := &in.AgentPools, &out.AgentPools
+		*out = new(RunsCollectorAgentPools)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Breakdown != nil {
+		in, out := &in.Breakdown, &out.Breakdown
+		*out = make([]RunsCollectorBreakdown, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollectorSpec.
@@ -996,6 +2586,20 @@ func (in *RunsCollectorStatus) DeepCopyInto(out *RunsCollectorStatus) {
 		*out = new(AgentPoolRef)
 		**out = **in
 	}
+	if in.AgentPools != nil {
+		in, out := &in.AgentPools, &out.AgentPools
+		*out = make([]AgentPoolRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.RunsObservedUntil != nil {
+		in, out := &in.RunsObservedUntil, &out.RunsObservedUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingRunIDs != nil {
+		in, out := &in.PendingRunIDs, &out.PendingRunIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollectorStatus.
@@ -1011,6 +2615,11 @@ func (in *RunsCollectorStatus) DeepCopy() *RunsCollectorStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHKey) DeepCopyInto(out *SSHKey) {
 	*out = *in
+	if in.ObjectRef != nil {
+		in, out := &in.ObjectRef, &out.ObjectRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKey.
@@ -1023,9 +2632,65 @@ func (in *SSHKey) DeepCopy() *SSHKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaleAgentToken) DeepCopyInto(out *StaleAgentToken) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastUsedAt != nil {
+		in, out := &in.LastUsedAt, &out.LastUsedAt
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaleAgentToken.
+func (in *StaleAgentToken) DeepCopy() *StaleAgentToken {
+	if in == nil {
+		return nil
+	}
+	out := new(StaleAgentToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaleAgentTokens) DeepCopyInto(out *StaleAgentTokens) {
+	*out = *in
+	out.UnusedFor = in.UnusedFor
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaleAgentTokens.
+func (in *StaleAgentTokens) DeepCopy() *StaleAgentTokens {
+	if in == nil {
+		return nil
+	}
+	out := new(StaleAgentTokens)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetWorkspace) DeepCopyInto(out *TargetWorkspace) {
 	*out = *in
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(WorkspaceProject)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkspaceSelector != nil {
+		in, out := &in.WorkspaceSelector, &out.WorkspaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetWorkspace.
@@ -1075,7 +2740,7 @@ func (in *Token) DeepCopyInto(out *Token) {
 	*out = *in
 	if in.SecretKeyRef != nil {
 		in, out := &in.SecretKeyRef, &out.SecretKeyRef
-		*out = new(v1.SecretKeySelector)
+		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1095,12 +2760,12 @@ func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
 	*out = *in
 	if in.ConfigMapKeyRef != nil {
 		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
-		*out = new(v1.ConfigMapKeySelector)
+		*out = new(corev1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.SecretKeyRef != nil {
 		in, out := &in.SecretKeyRef, &out.SecretKeyRef
-		*out = new(v1.SecretKeySelector)
+		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1217,6 +2882,21 @@ func (in *Workspace) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceBudget) DeepCopyInto(out *WorkspaceBudget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceBudget.
+func (in *WorkspaceBudget) DeepCopy() *WorkspaceBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkspaceList) DeepCopyInto(out *WorkspaceList) {
 	*out = *in
@@ -1341,7 +3021,7 @@ func (in *WorkspaceSpec) DeepCopyInto(out *WorkspaceSpec) {
 	if in.SSHKey != nil {
 		in, out := &in.SSHKey, &out.SSHKey
 		*out = new(SSHKey)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Notifications != nil {
 		in, out := &in.Notifications, &out.Notifications
@@ -1360,6 +3040,16 @@ func (in *WorkspaceSpec) DeepCopyInto(out *WorkspaceSpec) {
 		*out = make([]WorkspaceVariableSet, len(*in))
 		copy(*out, *in)
 	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(WorkspaceBudget)
+		**out = **in
+	}
+	if in.RunHistoryLimit != nil {
+		in, out := &in.RunHistoryLimit, &out.RunHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSpec.
@@ -1395,6 +3085,26 @@ func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 		*out = make([]VariableSetStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.CostEstimation != nil {
+		in, out := &in.CostEstimation, &out.CostEstimation
+		*out = new(CostEstimationStatus)
+		**out = **in
+	}
+	if in.RunChecks != nil {
+		in, out := &in.RunChecks, &out.RunChecks
+		*out = new(RunChecksStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunOverride != nil {
+		in, out := &in.RunOverride, &out.RunOverride
+		*out = new(RunOverrideStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunHistory != nil {
+		in, out := &in.RunHistory, &out.RunHistory
+		*out = new(RunHistoryStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceStatus.
@@ -50,12 +50,22 @@ func defaultDeployment() appsv1.Deployment {
 								"--agent-pool-sync-period=30s",
 								"--agent-token-workers=1",
 								"--agent-token-sync-period=15m",
+								"--api-token-workers=1",
+								"--api-token-sync-period=5m",
 								"--module-workers=1",
 								"--module-sync-period=5m",
+								"--no-code-workspace-workers=1",
+								"--no-code-workspace-sync-period=5m",
+								"--organization-workers=1",
+								"--organization-sync-period=5m",
 								"--project-workers=1",
 								"--project-sync-period=5m",
+								"--registry-module-workers=1",
+								"--registry-module-sync-period=5m",
 								"--runs-collector-workers=1",
 								"--runs-collector-sync-period=15s",
+								"--ssh-key-workers=1",
+								"--ssh-key-sync-period=5m",
 								"--workspace-workers=1",
 								"--workspace-sync-period=5m",
 							},
@@ -413,12 +423,22 @@ func TestDeploymentOperatorSyncPeriod(t *testing.T) {
 		"--agent-pool-sync-period=30s",
 		"--agent-token-workers=1",
 		"--agent-token-sync-period=15m",
+		"--api-token-workers=1",
+		"--api-token-sync-period=5m",
 		"--module-workers=1",
 		"--module-sync-period=5m",
+		"--no-code-workspace-workers=1",
+		"--no-code-workspace-sync-period=5m",
+		"--organization-workers=1",
+		"--organization-sync-period=5m",
 		"--project-workers=1",
 		"--project-sync-period=5m",
+		"--registry-module-workers=1",
+		"--registry-module-sync-period=5m",
 		"--runs-collector-workers=1",
 		"--runs-collector-sync-period=15s",
+		"--ssh-key-workers=1",
+		"--ssh-key-sync-period=5m",
 		"--workspace-workers=1",
 		"--workspace-sync-period=5m",
 	}
@@ -444,6 +464,42 @@ func TestDeploymentOperatorWatchedNamespaces(t *testing.T) {
 	assert.Equal(t, dd, deployment)
 }
 
+func TestDeploymentOperatorExternalScaler(t *testing.T) {
+	options := &helm.Options{
+		SetValues: map[string]string{
+			"operator.externalScaler.enabled": "true",
+			"operator.externalScaler.port":    "9191",
+		},
+		Version: helmChartVersion,
+	}
+	deployment := renderDeploymentManifest(t, options)
+	dd := defaultDeployment()
+	dd.Spec.Template.Spec.Containers[0].Args = append(dd.Spec.Template.Spec.Containers[0].Args, "--external-scaler-bind-address=:9191")
+	dd.Spec.Template.Spec.Containers[0].Ports = []corev1.ContainerPort{
+		{
+			Name:          "grpc-scaler",
+			ContainerPort: 9191,
+			Protocol:      corev1.ProtocolTCP,
+		},
+	}
+
+	assert.Equal(t, dd, deployment)
+}
+
+func TestDeploymentOperatorRunEventsSinkURL(t *testing.T) {
+	options := &helm.Options{
+		SetValues: map[string]string{
+			"operator.runEventsSinkURL": "http://sink.default.svc",
+		},
+		Version: helmChartVersion,
+	}
+	deployment := renderDeploymentManifest(t, options)
+	dd := defaultDeployment()
+	dd.Spec.Template.Spec.Containers[0].Args = append(dd.Spec.Template.Spec.Containers[0].Args, "--run-events-sink-url=http://sink.default.svc")
+
+	assert.Equal(t, dd, deployment)
+}
+
 func TestDeploymentOperatorTFEAddress(t *testing.T) {
 	options := &helm.Options{
 		SetValues: map[string]string{
@@ -582,18 +638,28 @@ func TestDeploymentKubeRbacProxyResources(t *testing.T) {
 func TestDeploymentControllers(t *testing.T) {
 	options := &helm.Options{
 		SetValues: map[string]string{
-			"controllers.agentPool.workers":        "5",
-			"controllers.agentPool.syncPeriod":     "15m",
-			"controllers.agentToken.workers":       "5",
-			"controllers.agentToken.syncPeriod":    "15m",
-			"controllers.module.workers":           "5",
-			"controllers.module.syncPeriod":        "15m",
-			"controllers.project.workers":          "5",
-			"controllers.project.syncPeriod":       "15m",
-			"controllers.runsCollector.workers":    "5",
-			"controllers.runsCollector.syncPeriod": "15m",
-			"controllers.workspace.workers":        "5",
-			"controllers.workspace.syncPeriod":     "15m",
+			"controllers.agentPool.workers":          "5",
+			"controllers.agentPool.syncPeriod":       "15m",
+			"controllers.agentToken.workers":         "5",
+			"controllers.agentToken.syncPeriod":      "15m",
+			"controllers.apiToken.workers":           "5",
+			"controllers.apiToken.syncPeriod":        "15m",
+			"controllers.module.workers":             "5",
+			"controllers.module.syncPeriod":          "15m",
+			"controllers.noCodeWorkspace.workers":    "5",
+			"controllers.noCodeWorkspace.syncPeriod": "15m",
+			"controllers.organization.workers":       "5",
+			"controllers.organization.syncPeriod":    "15m",
+			"controllers.project.workers":            "5",
+			"controllers.project.syncPeriod":         "15m",
+			"controllers.registryModule.workers":     "5",
+			"controllers.registryModule.syncPeriod":  "15m",
+			"controllers.runsCollector.workers":      "5",
+			"controllers.runsCollector.syncPeriod":   "15m",
+			"controllers.sshKey.workers":             "5",
+			"controllers.sshKey.syncPeriod":          "15m",
+			"controllers.workspace.workers":          "5",
+			"controllers.workspace.syncPeriod":       "15m",
 		},
 		Version: helmChartVersion,
 	}
@@ -605,12 +671,22 @@ func TestDeploymentControllers(t *testing.T) {
 		"--agent-pool-sync-period=15m",
 		"--agent-token-workers=5",
 		"--agent-token-sync-period=15m",
+		"--api-token-workers=5",
+		"--api-token-sync-period=15m",
 		"--module-workers=5",
 		"--module-sync-period=15m",
+		"--no-code-workspace-workers=5",
+		"--no-code-workspace-sync-period=15m",
+		"--organization-workers=5",
+		"--organization-sync-period=15m",
 		"--project-workers=5",
 		"--project-sync-period=15m",
+		"--registry-module-workers=5",
+		"--registry-module-sync-period=15m",
 		"--runs-collector-workers=5",
 		"--runs-collector-sync-period=15m",
+		"--ssh-key-workers=5",
+		"--ssh-key-sync-period=15m",
 		"--workspace-workers=5",
 		"--workspace-sync-period=15m",
 	}
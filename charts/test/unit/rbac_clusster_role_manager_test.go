@@ -64,6 +64,16 @@ func testRBACClusterRoleManagerRules(t *testing.T, rbac rbacv1.ClusterRole) {
 			APIGroups: []string{""},
 			Resources: []string{"events"},
 		},
+		{
+			Verbs: []string{
+				"get",
+				"list",
+				"patch",
+				"watch",
+			},
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+		},
 		{
 			Verbs: []string{
 				"create",
@@ -78,8 +88,13 @@ func testRBACClusterRoleManagerRules(t *testing.T, rbac rbacv1.ClusterRole) {
 			Resources: []string{
 				"agentpools",
 				"agenttokens",
+				"apitokens",
 				"modules",
+				"nocodeworkspaces",
+				"organizations",
+				"organizationsshkeys",
 				"projects",
+				"registrymodules",
 				"runscollectors",
 				"workspaces",
 			},
@@ -92,8 +107,13 @@ func testRBACClusterRoleManagerRules(t *testing.T, rbac rbacv1.ClusterRole) {
 			Resources: []string{
 				"agentpools/finalizers",
 				"agenttokens/finalizers",
+				"apitokens/finalizers",
 				"modules/finalizers",
+				"nocodeworkspaces/finalizers",
+				"organizations/finalizers",
+				"organizationsshkeys/finalizers",
 				"projects/finalizers",
+				"registrymodules/finalizers",
 				"runscollectors/finalizers",
 				"workspaces/finalizers",
 			},
@@ -108,8 +128,13 @@ func testRBACClusterRoleManagerRules(t *testing.T, rbac rbacv1.ClusterRole) {
 			Resources: []string{
 				"agentpools/status",
 				"agenttokens/status",
+				"apitokens/status",
 				"modules/status",
+				"nocodeworkspaces/status",
+				"organizations/status",
+				"organizationsshkeys/status",
 				"projects/status",
+				"registrymodules/status",
 				"runscollectors/status",
 				"workspaces/status",
 			},
@@ -127,6 +152,46 @@ func testRBACClusterRoleManagerRules(t *testing.T, rbac rbacv1.ClusterRole) {
 			APIGroups: []string{"apps"},
 			Resources: []string{"deployments"},
 		},
+		{
+			Verbs: []string{
+				"create",
+				"delete",
+				"get",
+				"list",
+				"watch",
+			},
+			APIGroups: []string{"batch"},
+			Resources: []string{"jobs"},
+		},
+		{
+			Verbs: []string{
+				"create",
+				"delete",
+				"get",
+				"list",
+				"update",
+				"watch",
+			},
+			APIGroups: []string{"policy"},
+			Resources: []string{"poddisruptionbudgets"},
+		},
+		{
+			Verbs: []string{
+				"get",
+				"list",
+				"watch",
+			},
+			APIGroups: []string{""},
+			Resources: []string{"namespaces"},
+		},
+		{
+			Verbs: []string{
+				"delete",
+				"get",
+			},
+			APIGroups: []string{""},
+			Resources: []string{"secrets"},
+		},
 	}
 	assert.Equal(t, rules, rbac.Rules)
 }
@@ -9,6 +9,8 @@ import (
 	"os"
 	"strings"
 	"time"
+	// Embed the IANA Time Zone database to evaluate AgentPool autoscaling schedules in images without it.
+	_ "time/tzdata"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -18,12 +20,14 @@ import (
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -32,6 +36,7 @@ import (
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 	"github.com/hashicorp/hcp-terraform-operator/internal/controller"
+	"github.com/hashicorp/hcp-terraform-operator/internal/externalscaler"
 	"github.com/hashicorp/hcp-terraform-operator/version"
 	//+kubebuilder:scaffold:imports
 )
@@ -64,6 +69,9 @@ func main() {
 	flag.Var(&watchNamespaces, "namespace", "Namespace to watch")
 	var opVersion bool
 	flag.BoolVar(&opVersion, "version", false, "Print operator version")
+	var externalScalerBindAddress string
+	flag.StringVar(&externalScalerBindAddress, "external-scaler-bind-address", "",
+		"The address the KEDA external scaler gRPC service binds to. Format: :9090. Disabled when empty.")
 	// AGENT POOL CONTROLLER OPTIONS
 	var agentPoolWorkers int
 	flag.IntVar(&agentPoolWorkers, "agent-pool-workers", 1,
@@ -76,24 +84,54 @@ func main() {
 		"The number of the Agent Token controller workers.")
 	flag.DurationVar(&controller.AgentTokenSyncPeriod, "agent-token-sync-period", 15*time.Minute,
 		"The minimum frequency at which watched agent token resources are reconciled. Format: 5s, 1m, etc.")
+	// API TOKEN CONTROLLER OPTIONS
+	var apiTokenWorkers int
+	flag.IntVar(&apiTokenWorkers, "api-token-workers", 1,
+		"The number of the API Token controller workers.")
+	flag.DurationVar(&controller.APITokenSyncPeriod, "api-token-sync-period", 5*time.Minute,
+		"The minimum frequency at which watched API token resources are reconciled. Format: 5s, 1m, etc.")
 	// MODULE CONTROLLER OPTIONS
 	var moduleWorkers int
 	flag.IntVar(&moduleWorkers, "module-workers", 1,
 		"The number of the Module controller workers.")
 	flag.DurationVar(&controller.ModuleSyncPeriod, "module-sync-period", 5*time.Minute,
 		"The minimum frequency at which watched workspace resources are reconciled. Format: 5s, 1m, etc.")
+	// NO-CODE WORKSPACE CONTROLLER OPTIONS
+	var noCodeWorkspaceWorkers int
+	flag.IntVar(&noCodeWorkspaceWorkers, "no-code-workspace-workers", 1,
+		"The number of the No-Code Workspace controller workers.")
+	flag.DurationVar(&controller.NoCodeWorkspaceSyncPeriod, "no-code-workspace-sync-period", 5*time.Minute,
+		"The minimum frequency at which watched no-code workspace resources are reconciled. Format: 5s, 1m, etc.")
+	// ORGANIZATION CONTROLLER OPTIONS
+	var organizationWorkers int
+	flag.IntVar(&organizationWorkers, "organization-workers", 1,
+		"The number of the Organization controller workers.")
+	flag.DurationVar(&controller.OrganizationSyncPeriod, "organization-sync-period", 5*time.Minute,
+		"The minimum frequency at which watched organization resources are reconciled. Format: 5s, 1m, etc.")
 	// PROJECT CONTROLLER OPTIONS
 	var projectWorkers int
 	flag.IntVar(&projectWorkers, "project-workers", 1,
 		"The number of the Project controller workers.")
 	flag.DurationVar(&controller.ProjectSyncPeriod, "project-sync-period", 5*time.Minute,
 		"The minimum frequency at which watched project resources are reconciled. Format: 5s, 1m, etc.")
+	// REGISTRY MODULE CONTROLLER OPTIONS
+	var registryModuleWorkers int
+	flag.IntVar(&registryModuleWorkers, "registry-module-workers", 1,
+		"The number of the Registry Module controller workers.")
+	flag.DurationVar(&controller.RegistryModuleSyncPeriod, "registry-module-sync-period", 5*time.Minute,
+		"The minimum frequency at which watched registry module resources are reconciled. Format: 5s, 1m, etc.")
 	// RUNS COLLECTOR CONTROLLER OPTIONS
 	var runsCollectorWorkers int
 	flag.IntVar(&runsCollectorWorkers, "runs-collector-workers", 1,
 		"The number of the Runs Collector controller workers.")
 	flag.DurationVar(&controller.RunsCollectorSyncPeriod, "runs-collector-sync-period", 15*time.Second,
 		"The minimum frequency at which watched runs collector resources are reconciled. Format: 5s, 1m, etc.")
+	// SSH KEY CONTROLLER OPTIONS
+	var sshKeyWorkers int
+	flag.IntVar(&sshKeyWorkers, "ssh-key-workers", 1,
+		"The number of the SSH Key controller workers.")
+	flag.DurationVar(&controller.SSHKeySyncPeriod, "ssh-key-sync-period", 5*time.Minute,
+		"The minimum frequency at which watched ssh key resources are reconciled. Format: 5s, 1m, etc.")
 	// WORKSPACE CONTROLLER OPTIONS
 	var workspaceWorkers int
 	flag.IntVar(&workspaceWorkers, "workspace-workers", 1,
@@ -101,6 +139,9 @@ func main() {
 	flag.DurationVar(&controller.WorkspaceSyncPeriod, "workspace-sync-period", 5*time.Minute,
 		"The minimum frequency at which watched workspace resources are reconciled. Format: 5s, 1m, etc.")
 
+	flag.StringVar(&controller.RunEventsSinkURL, "run-events-sink-url", "",
+		"The URL of the HTTP sink to which CloudEvents of run status transitions are sent. CloudEvents are not sent if it is empty.")
+
 	// TODO
 	// - Add validation that 'sync-period' has a higher value than '*-sync-period'
 	// - Add a new CLI option named 'status' (or consider a different name) that will print out the operator settings passed via flags.
@@ -139,18 +180,26 @@ func main() {
 	options := ctrl.Options{
 		Controller: config.Controller{
 			GroupKindConcurrency: map[string]int{
-				"AgentPool.app.terraform.io":     agentPoolWorkers,
-				"AgentToken.app.terraform.io":    agentTokenWorkers,
-				"Module.app.terraform.io":        moduleWorkers,
-				"Project.app.terraform.io":       projectWorkers,
-				"RunsCollector.app.terraform.io": runsCollectorWorkers,
-				"Workspace.app.terraform.io":     workspaceWorkers,
+				"AgentPool.app.terraform.io":          agentPoolWorkers,
+				"AgentToken.app.terraform.io":         agentTokenWorkers,
+				"APIToken.app.terraform.io":           apiTokenWorkers,
+				"Module.app.terraform.io":             moduleWorkers,
+				"NoCodeWorkspace.app.terraform.io":    noCodeWorkspaceWorkers,
+				"Organization.app.terraform.io":       organizationWorkers,
+				"Project.app.terraform.io":            projectWorkers,
+				"RegistryModule.app.terraform.io":     registryModuleWorkers,
+				"RunsCollector.app.terraform.io":      runsCollectorWorkers,
+				"OrganizationSSHKey.app.terraform.io": sshKeyWorkers,
+				"Workspace.app.terraform.io":          workspaceWorkers,
 			},
 		},
 		Scheme: scheme,
 		Cache: cache.Options{
 			DefaultNamespaces: map[string]cache.Config{},
 			SyncPeriod:        &syncPeriod,
+			ByObject: map[client.Object]cache.ByObject{
+				&corev1.Pod{}: controller.AgentPodCacheOptions(),
+			},
 		},
 		Metrics: server.Options{
 			BindAddress: "127.0.0.1:8080",
@@ -189,9 +238,14 @@ func main() {
 	setupLog.Info(fmt.Sprintf("Operator sync period: %s", syncPeriod))
 	setupLog.Info(fmt.Sprintf("Agent Pool sync period: %s", controller.AgentPoolSyncPeriod))
 	setupLog.Info(fmt.Sprintf("Agent Token sync period: %s", controller.AgentTokenSyncPeriod))
+	setupLog.Info(fmt.Sprintf("API Token sync period: %s", controller.APITokenSyncPeriod))
 	setupLog.Info(fmt.Sprintf("Module sync period: %s", controller.ModuleSyncPeriod))
+	setupLog.Info(fmt.Sprintf("No-Code Workspace sync period: %s", controller.NoCodeWorkspaceSyncPeriod))
+	setupLog.Info(fmt.Sprintf("Organization sync period: %s", controller.OrganizationSyncPeriod))
 	setupLog.Info(fmt.Sprintf("Project sync period: %s", controller.ProjectSyncPeriod))
+	setupLog.Info(fmt.Sprintf("Registry Module sync period: %s", controller.RegistryModuleSyncPeriod))
 	setupLog.Info(fmt.Sprintf("Runs Collector sync period: %s", controller.RunsCollectorSyncPeriod))
+	setupLog.Info(fmt.Sprintf("SSH Key sync period: %s", controller.SSHKeySyncPeriod))
 	setupLog.Info(fmt.Sprintf("Workspace sync period: %s", controller.WorkspaceSyncPeriod))
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), options)
@@ -216,6 +270,14 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "AgentToken")
 		os.Exit(1)
 	}
+	if err := (&controller.APITokenReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("APITokenController"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "APIToken")
+		os.Exit(1)
+	}
 	if err = (&controller.ModuleReconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
@@ -224,6 +286,22 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "Module")
 		os.Exit(1)
 	}
+	if err := (&controller.NoCodeWorkspaceReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("NoCodeWorkspaceController"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NoCodeWorkspace")
+		os.Exit(1)
+	}
+	if err := (&controller.OrganizationReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("OrganizationController"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Organization")
+		os.Exit(1)
+	}
 	if err := (&controller.ProjectReconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
@@ -232,6 +310,14 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "Project")
 		os.Exit(1)
 	}
+	if err := (&controller.RegistryModuleReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("RegistryModuleController"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RegistryModule")
+		os.Exit(1)
+	}
 	if err := (&controller.RunsCollectorReconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
@@ -240,6 +326,14 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "RunsCollector")
 		os.Exit(1)
 	}
+	if err := (&controller.OrganizationSSHKeyReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("OrganizationSSHKeyController"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OrganizationSSHKey")
+		os.Exit(1)
+	}
 	if err := (&controller.WorkspaceReconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
@@ -250,6 +344,17 @@ func main() {
 	}
 	//+kubebuilder:scaffold:builder
 
+	if externalScalerBindAddress != "" {
+		if err := mgr.Add(&externalscaler.Server{
+			Reader:      mgr.GetClient(),
+			BindAddress: externalScalerBindAddress,
+			Log:         ctrl.Log.WithName("external-scaler"),
+		}); err != nil {
+			setupLog.Error(err, "unable to set up external scaler")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -13,14 +13,21 @@ import (
 
 	"github.com/go-logr/logr"
 	tfc "github.com/hashicorp/go-tfe"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
@@ -46,8 +53,11 @@ type agentPoolInstance struct {
 //+kubebuilder:rbac:groups=app.terraform.io,resources=agentpools/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=app.terraform.io,resources=agentpools/finalizers,verbs=update
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;patch;watch
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=create;list;update;watch
 //+kubebuilder:rbac:groups="apps",resources=deployments,verbs=create;delete;get;list;patch;update;watch
+//+kubebuilder:rbac:groups="batch",resources=jobs,verbs=create;delete;get;list;watch
+//+kubebuilder:rbac:groups="policy",resources=poddisruptionbudgets,verbs=create;delete;get;list;update;watch
 
 func (r *AgentPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	ap := agentPoolInstance{}
@@ -72,6 +82,13 @@ func (r *AgentPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return doNotRequeue()
 	}
 
+	// Agent deployments and pods are watched too. Do not react to their deletion while Kubernetes deletes the dependents
+	// of the agent pool in the foreground, the agent pool gets reconciled once all dependents are removed.
+	if controllerutil.ContainsFinalizer(&ap.instance, metav1.FinalizerDeleteDependents) {
+		ap.log.Info("Agent Pool Controller", "msg", "waiting for the dependent objects to be deleted")
+		return doNotRequeue()
+	}
+
 	ap.log.Info("Spec Validation", "msg", "validating instance object spec")
 	if err := ap.instance.ValidateSpec(); err != nil {
 		ap.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
@@ -114,11 +131,26 @@ func (r *AgentPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	return requeueAfter(AgentPoolSyncPeriod)
 }
 
+// AgentPodCacheOptions returns the cache options that limit cached pods to the agent pods of agent pools.
+// The agent pool controller watches and lists agent pods only, caching all pods of the cluster is not needed.
+func AgentPodCacheOptions() cache.ByObject {
+	selector := labels.NewSelector()
+	r, err := labels.NewRequirement(poolNameLabel, selection.Exists, nil)
+	if err == nil {
+		selector = selector.Add(*r)
+	}
+
+	return cache.ByObject{Label: selector}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *AgentPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&appv1alpha2.AgentPool{}).
-		WithEventFilter(predicate.Or(genericPredicates())).
+		For(&appv1alpha2.AgentPool{}, builder.WithPredicates(predicate.Or(genericPredicates()))).
+		// Repair the agent deployments as soon as they are modified or deleted, and track their rollout.
+		Owns(&appsv1.Deployment{}, builder.WithPredicates(agentPoolDeploymentPredicates())).
+		// The pod cache is limited to agent pods, see AgentPodCacheOptions.
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(agentPodToAgentPool), builder.WithPredicates(agentPoolPodPredicates())).
 		Complete(r)
 }
 
@@ -173,9 +205,13 @@ func (r *AgentPoolReconciler) removeFinalizer(ctx context.Context, ap *agentPool
 	if err != nil {
 		ap.log.Error(err, "Reconcile Agent Pool", "msg", fmt.Sprintf("failed to remove finalizer %s", agentPoolFinalizer))
 		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "RemoveFinalizer", "Failed to remove finalizer %s", agentPoolFinalizer)
+		return err
 	}
 
-	return err
+	deleteAgentPoolMetrics(&ap.instance)
+	deleteAgentTokenMetrics(agentPoolKind, &ap.instance)
+
+	return nil
 }
 
 func (r *AgentPoolReconciler) updateStatus(ctx context.Context, ap *agentPoolInstance, agentPool *tfc.AgentPool) error {
@@ -329,6 +365,33 @@ func (r *AgentPoolReconciler) reconcileAgentPool(ctx context.Context, ap *agentP
 	ap.log.Info("Reconcile Agent Deployment", "msg", "successfully reconcilied agent deployment")
 	r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "ReconcileAgentDeployment", "Reconcilied agent deployment in agent pool ID %s", ap.instance.Status.AgentPoolID)
 
+	// Reconcile Agent Deployment Classes
+	err = r.reconcileAgentDeploymentClasses(ctx, ap)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agent Deployment Classes", "msg", fmt.Sprintf("failed to reconcile agent deployment classes in agent pool ID %s: %s", ap.instance.Status.AgentPoolID, err))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentDeploymentClasses", "Failed to reconcile agent deployment classes in agent pool: %s", err)
+		return err
+	}
+	ap.log.Info("Reconcile Agent Deployment Classes", "msg", "successfully reconcilied agent deployment classes")
+
+	// Reconcile Agent Jobs
+	err = r.reconcileAgentJobs(ctx, ap)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agent Jobs", "msg", fmt.Sprintf("failed to reconcile agent jobs in agent pool ID %s: %s", ap.instance.Status.AgentPoolID, err))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentJobs", "Failed to reconcile agent jobs in agent pool: %s", err)
+		return err
+	}
+	ap.log.Info("Reconcile Agent Jobs", "msg", "successfully reconcilied agent jobs")
+
+	// Reconcile Agent External Autoscaling
+	err = r.reconcileAgentExternalAutoscaling(ctx, ap)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agent External Autoscaling", "msg", fmt.Sprintf("failed to reconcile agent external autoscaling in agent pool ID %s: %s", ap.instance.Status.AgentPoolID, err))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentExternalAutoscaling", "Failed to reconcile agent external autoscaling in agent pool: %s", err)
+		return err
+	}
+	ap.log.Info("Reconcile Agent External Autoscaling", "msg", "successfully reconcilied agent external autoscaling")
+
 	// Reconcile Agent Autoscaling
 	err = r.reconcileAgentAutoscaling(ctx, ap)
 	if err != nil {
@@ -339,5 +402,32 @@ func (r *AgentPoolReconciler) reconcileAgentPool(ctx context.Context, ap *agentP
 	ap.log.Info("Reconcile Agent Autoscaling", "msg", "successfully reconcilied agent autoscaling")
 	r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "ReconcileAgentAutoscaling", "Reconcilied agent autoscaling in agent pool ID %s", ap.instance.Status.AgentPoolID)
 
+	// Reconcile Agents
+	err = r.reconcileAgents(ctx, ap)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agents", "msg", fmt.Sprintf("failed to reconcile agents in agent pool ID %s: %s", ap.instance.Status.AgentPoolID, err))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgents", "Failed to reconcile agents in agent pool: %s", err)
+		return err
+	}
+	ap.log.Info("Reconcile Agents", "msg", "successfully reconcilied agents")
+
+	// Reconcile Agent PodDisruptionBudgets
+	err = r.reconcileAgentPDBs(ctx, ap)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agent PodDisruptionBudgets", "msg", fmt.Sprintf("failed to reconcile agent pod disruption budgets in agent pool ID %s: %s", ap.instance.Status.AgentPoolID, err))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentPodDisruptionBudgets", "Failed to reconcile agent pod disruption budgets in agent pool: %s", err)
+		return err
+	}
+	ap.log.Info("Reconcile Agent PodDisruptionBudgets", "msg", "successfully reconcilied agent pod disruption budgets")
+
+	// Reconcile Agent Deployment Status
+	err = r.reconcileAgentDeploymentStatus(ctx, ap)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agent Deployment Status", "msg", fmt.Sprintf("failed to reconcile agent deployment status in agent pool ID %s: %s", ap.instance.Status.AgentPoolID, err))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentDeploymentStatus", "Failed to reconcile agent deployment status in agent pool: %s", err)
+		return err
+	}
+	ap.log.Info("Reconcile Agent Deployment Status", "msg", "successfully reconcilied agent deployment status")
+
 	return r.updateStatus(ctx, ap, agentPool)
 }
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"path"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+const (
+	agentHooksVolumeName = "tfc-agent-hooks"
+	agentCacheVolumeName = "tfc-agent-cache"
+	// defaultAgentDataDir is the agent data directory of the `hashicorp/tfc-agent` image.
+	defaultAgentDataDir = "/home/tfc-agent/.tfc-agent"
+	// agentHooksFileMode allows the agent to execute the hook scripts.
+	agentHooksFileMode int32 = 0755
+)
+
+// agentConfigEnvVars returns environment variables that the agent configuration renders.
+func agentConfigEnvVars(c *appv1alpha2.AgentConfig) []corev1.EnvVar {
+	envs := []corev1.EnvVar{}
+	if c.LogLevel != "" {
+		envs = append(envs, corev1.EnvVar{Name: "TFC_AGENT_LOG_LEVEL", Value: c.LogLevel})
+	}
+	if c.Single != nil {
+		envs = append(envs, corev1.EnvVar{Name: "TFC_AGENT_SINGLE", Value: strconv.FormatBool(*c.Single)})
+	}
+	if c.RequestForwarding != nil {
+		envs = append(envs, corev1.EnvVar{Name: "TFC_AGENT_REQUEST_FORWARDING", Value: strconv.FormatBool(*c.RequestForwarding)})
+	}
+	if o := c.OpenTelemetry; o != nil {
+		envs = append(envs, corev1.EnvVar{Name: "TFC_AGENT_OTLP_ADDRESS", Value: o.Address})
+		if o.CertFile != "" {
+			envs = append(envs, corev1.EnvVar{Name: "TFC_AGENT_OTLP_CERT_FILE", Value: o.CertFile})
+		}
+	}
+	if c.DataDir != "" {
+		envs = append(envs, corev1.EnvVar{Name: "TFC_AGENT_DATA_DIR", Value: c.DataDir})
+	}
+	if c.CacheDir != "" {
+		envs = append(envs, corev1.EnvVar{Name: "TFC_AGENT_CACHE_DIR", Value: c.CacheDir})
+	}
+	return envs
+}
+
+// agentConfigVolumes returns volumes and volume mounts that the agent configuration renders.
+func agentConfigVolumes(c *appv1alpha2.AgentConfig) ([]corev1.Volume, []corev1.VolumeMount) {
+	volumes := []corev1.Volume{}
+	mounts := []corev1.VolumeMount{}
+	if h := c.Hooks; h != nil {
+		dataDir := defaultAgentDataDir
+		if c.DataDir != "" {
+			dataDir = c.DataDir
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name: agentHooksVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: h.ConfigMapName},
+					DefaultMode:          pointer.PointerOf(agentHooksFileMode),
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      agentHooksVolumeName,
+			MountPath: path.Join(dataDir, "hooks"),
+			ReadOnly:  true,
+		})
+	}
+	if v := c.CacheVolume; v != nil {
+		vs := corev1.VolumeSource{}
+		if v.ClaimName != "" {
+			vs.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{ClaimName: v.ClaimName}
+		}
+		if v.VolumeClaimTemplate != nil {
+			vs.Ephemeral = &corev1.EphemeralVolumeSource{VolumeClaimTemplate: v.VolumeClaimTemplate.DeepCopy()}
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name:         agentCacheVolumeName,
+			VolumeSource: vs,
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      agentCacheVolumeName,
+			MountPath: c.CacheDir,
+		})
+	}
+	return volumes, mounts
+}
+
+// applyAgentConfig renders the agent configuration into the pod spec.
+// Environment variables and volume mounts are added to each container.
+func applyAgentConfig(c *appv1alpha2.AgentConfig, s *corev1.PodSpec) {
+	if c == nil {
+		return
+	}
+	envs := agentConfigEnvVars(c)
+	volumes, mounts := agentConfigVolumes(c)
+	s.Volumes = append(s.Volumes, volumes...)
+	for ci := range s.Containers {
+		s.Containers[ci].Env = append(s.Containers[ci].Env, envs...)
+		s.Containers[ci].VolumeMounts = append(s.Containers[ci].VolumeMounts, mounts...)
+	}
+}
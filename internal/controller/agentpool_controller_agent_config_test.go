@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+func TestApplyAgentConfig(t *testing.T) {
+	c := &appv1alpha2.AgentConfig{
+		LogLevel:          "debug",
+		Single:            pointer.PointerOf(true),
+		RequestForwarding: pointer.PointerOf(false),
+		Hooks:             &appv1alpha2.AgentHooks{ConfigMapName: "hooks"},
+		OpenTelemetry:     &appv1alpha2.AgentOpenTelemetry{Address: "otel:4317"},
+		CacheDir:          "/cache",
+		CacheVolume:       &appv1alpha2.AgentCacheVolume{ClaimName: "cache"},
+	}
+	s := &corev1.PodSpec{
+		Containers: []corev1.Container{
+			{
+				Name: DefaultAgentContainerName,
+				Env:  []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:3128"}},
+			},
+		},
+	}
+
+	applyAgentConfig(c, s)
+
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "HTTP_PROXY", Value: "http://proxy:3128"},
+		{Name: "TFC_AGENT_LOG_LEVEL", Value: "debug"},
+		{Name: "TFC_AGENT_SINGLE", Value: "true"},
+		{Name: "TFC_AGENT_REQUEST_FORWARDING", Value: "false"},
+		{Name: "TFC_AGENT_OTLP_ADDRESS", Value: "otel:4317"},
+		{Name: "TFC_AGENT_CACHE_DIR", Value: "/cache"},
+	}, s.Containers[0].Env)
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: agentHooksVolumeName, MountPath: "/home/tfc-agent/.tfc-agent/hooks", ReadOnly: true},
+		{Name: agentCacheVolumeName, MountPath: "/cache"},
+	}, s.Containers[0].VolumeMounts)
+	assert.Equal(t, []corev1.Volume{
+		{
+			Name: agentHooksVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "hooks"},
+					DefaultMode:          pointer.PointerOf(agentHooksFileMode),
+				},
+			},
+		},
+		{
+			Name: agentCacheVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "cache"},
+			},
+		},
+	}, s.Volumes)
+}
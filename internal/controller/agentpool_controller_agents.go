@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+const (
+	agentStatusUnknown = "unknown"
+	agentStatusErrored = "errored"
+	agentStatusExited  = "exited"
+)
+
+// listAgents returns all agents registered to the agent pool.
+func listAgents(ctx context.Context, ap *agentPoolInstance) ([]*tfc.Agent, error) {
+	agents := []*tfc.Agent{}
+	listOpts := &tfc.AgentListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize:   MaxPageSize,
+			PageNumber: InitPageNumber,
+		},
+	}
+	for {
+		agentsList, err := ap.tfClient.Client.Agents.List(ctx, ap.instance.Status.AgentPoolID, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		agents = append(agents, agentsList.Items...)
+		if agentsList.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = agentsList.NextPage
+	}
+
+	return agents, nil
+}
+
+// agentPodNamePrefix returns the name prefix of the pods managed by the operator.
+// It matches pods of the agent deployment, agent deployment classes, and agent Jobs.
+func agentPodNamePrefix(ap *appv1alpha2.AgentPool) string {
+	return fmt.Sprintf("%s-", AgentPoolDeploymentName(ap))
+}
+
+// isOrphanedAgent checks if the agent runs in a pod managed by the operator that no longer exists.
+// Busy and idle agents are never considered orphaned, since they still ping HCP Terraform.
+func isOrphanedAgent(ap *appv1alpha2.AgentPool, agent *tfc.Agent, pods map[string]struct{}) bool {
+	if !strings.HasPrefix(agent.Name, agentPodNamePrefix(ap)) {
+		return false
+	}
+	if _, ok := pods[agent.Name]; ok {
+		return false
+	}
+	switch agent.Status {
+	case agentStatusUnknown, agentStatusErrored, agentStatusExited:
+		return true
+	}
+	return false
+}
+
+// agentsStatus returns the status of the agents and the pods they run in.
+// The agent name matches the pod name since the operator sets it via the `TFC_AGENT_NAME` environment variable.
+func agentsStatus(agents []*tfc.Agent, pods map[string]struct{}) *appv1alpha2.AgentsStatus {
+	status := &appv1alpha2.AgentsStatus{}
+	for _, a := range agents {
+		switch a.Status {
+		case agentStatusIdle:
+			status.Idle++
+		case agentStatusBusy:
+			status.Busy++
+		case agentStatusUnknown:
+			status.Unknown++
+		case agentStatusErrored:
+			status.Errored++
+		case agentStatusExited:
+			status.Exited++
+			continue
+		}
+		s := appv1alpha2.AgentStatus{
+			ID:     a.ID,
+			Name:   a.Name,
+			Status: a.Status,
+		}
+		if t, err := time.Parse(time.RFC3339, a.LastPingAt); err == nil {
+			s.LastPingAt = &metav1.Time{Time: t}
+		}
+		if _, ok := pods[a.Name]; ok {
+			s.PodName = a.Name
+		}
+		status.Agents = append(status.Agents, s)
+	}
+
+	return status
+}
+
+// deregisterAgent deletes the agent from the agent pool.
+// The go-tfe client does not implement this endpoint, therefore, the request is sent directly.
+func deregisterAgent(ctx context.Context, ap *agentPoolInstance, agentID string) error {
+	req, err := ap.tfClient.Client.NewRequest(http.MethodDelete, fmt.Sprintf("agents/%s", url.PathEscape(agentID)), nil)
+	if err != nil {
+		return err
+	}
+	return req.Do(ctx, nil)
+}
+
+// agentPodNames returns names of the pods managed by the operator.
+func (r *AgentPoolReconciler) agentPodNames(ctx context.Context, ap *agentPoolInstance) (map[string]struct{}, error) {
+	pods := &corev1.PodList{}
+	err := r.Client.List(ctx, pods, client.InNamespace(ap.instance.Namespace), client.MatchingLabels{poolNameLabel: ap.instance.Name})
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]struct{}, len(pods.Items))
+	for _, p := range pods.Items {
+		names[p.Name] = struct{}{}
+	}
+
+	return names, nil
+}
+
+// reconcileAgents records the agents registered to the agent pool in the status
+// and deregisters orphaned agents if it is enabled.
+func (r *AgentPoolReconciler) reconcileAgents(ctx context.Context, ap *agentPoolInstance) error {
+	ap.log.Info("Reconcile Agents", "msg", "new reconciliation event")
+
+	agents, err := listAgents(ctx, ap)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agents", "msg", "Failed to list agents")
+		return err
+	}
+
+	pods, err := r.agentPodNames(ctx, ap)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agents", "msg", "Failed to list agent pods")
+		return err
+	}
+
+	if ap.instance.Spec.DeregisterOrphanedAgents {
+		var errs []error
+		registered := []*tfc.Agent{}
+		for _, a := range agents {
+			if !isOrphanedAgent(&ap.instance, a, pods) {
+				registered = append(registered, a)
+				continue
+			}
+			ap.log.Info("Reconcile Agents", "msg", fmt.Sprintf("deregistering agent %s with status %s, pod %s no longer exists", a.ID, a.Status, a.Name))
+			if err := deregisterAgent(ctx, ap, a.ID); err != nil && err != tfc.ErrResourceNotFound {
+				ap.log.Error(err, "Reconcile Agents", "msg", fmt.Sprintf("Failed to deregister agent %s", a.ID))
+				r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgents", "Failed to deregister agent %s: %v", a.ID, err)
+				errs = append(errs, err)
+				registered = append(registered, a)
+				continue
+			}
+			r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "ReconcileAgents", "Deregistered agent %s, pod %s no longer exists", a.ID, a.Name)
+		}
+		agents = registered
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+	}
+
+	status := agentsStatus(agents, pods)
+	status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+	ap.instance.Status.Agents = status
+	ap.log.Info("Reconcile Agents", "msg", fmt.Sprintf("agents: %d idle, %d busy, %d unknown, %d errored, %d exited", status.Idle, status.Busy, status.Unknown, status.Errored, status.Exited))
+
+	return nil
+}
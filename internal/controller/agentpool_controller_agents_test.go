@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestAgentsStatus(t *testing.T) {
+	pods := map[string]struct{}{
+		"agents-of-this-1": {},
+		"agents-of-this-2": {},
+	}
+	agents := []*tfc.Agent{
+		{ID: "agent-1", Name: "agents-of-this-1", Status: agentStatusBusy, LastPingAt: "2025-06-02T07:00:00Z"},
+		{ID: "agent-2", Name: "agents-of-this-2", Status: agentStatusIdle, LastPingAt: "2025-06-02T07:00:05Z"},
+		{ID: "agent-3", Name: "agents-of-this-3", Status: agentStatusErrored},
+		{ID: "agent-4", Name: "vm-agent", Status: agentStatusUnknown},
+		{ID: "agent-5", Name: "agents-of-this-5", Status: agentStatusExited},
+	}
+
+	status := agentsStatus(agents, pods)
+
+	assert.Equal(t, int32(1), status.Idle)
+	assert.Equal(t, int32(1), status.Busy)
+	assert.Equal(t, int32(1), status.Unknown)
+	assert.Equal(t, int32(1), status.Errored)
+	assert.Equal(t, int32(1), status.Exited)
+	assert.Equal(t, []appv1alpha2.AgentStatus{
+		{
+			ID:         "agent-1",
+			Name:       "agents-of-this-1",
+			Status:     agentStatusBusy,
+			LastPingAt: &metav1.Time{Time: time.Date(2025, time.June, 2, 7, 0, 0, 0, time.UTC)},
+			PodName:    "agents-of-this-1",
+		},
+		{
+			ID:         "agent-2",
+			Name:       "agents-of-this-2",
+			Status:     agentStatusIdle,
+			LastPingAt: &metav1.Time{Time: time.Date(2025, time.June, 2, 7, 0, 5, 0, time.UTC)},
+			PodName:    "agents-of-this-2",
+		},
+		{
+			ID:     "agent-3",
+			Name:   "agents-of-this-3",
+			Status: agentStatusErrored,
+		},
+		{
+			ID:     "agent-4",
+			Name:   "vm-agent",
+			Status: agentStatusUnknown,
+		},
+	}, status.Agents)
+}
+
+func TestIsOrphanedAgent(t *testing.T) {
+	ap := &appv1alpha2.AgentPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "this",
+		},
+	}
+	pods := map[string]struct{}{
+		"agents-of-this-1": {},
+	}
+
+	tests := []struct {
+		name     string
+		agent    *tfc.Agent
+		expected bool
+	}{
+		{
+			name:     "errored agent without pod",
+			agent:    &tfc.Agent{Name: "agents-of-this-2", Status: agentStatusErrored},
+			expected: true,
+		},
+		{
+			name:     "unknown agent without pod",
+			agent:    &tfc.Agent{Name: "agents-of-this-2", Status: agentStatusUnknown},
+			expected: true,
+		},
+		{
+			name:     "exited agent without pod",
+			agent:    &tfc.Agent{Name: "agents-of-this-2", Status: agentStatusExited},
+			expected: true,
+		},
+		{
+			name:     "busy agent without pod",
+			agent:    &tfc.Agent{Name: "agents-of-this-2", Status: agentStatusBusy},
+			expected: false,
+		},
+		{
+			name:     "errored agent with pod",
+			agent:    &tfc.Agent{Name: "agents-of-this-1", Status: agentStatusErrored},
+			expected: false,
+		},
+		{
+			name:     "agent not managed by the operator",
+			agent:    &tfc.Agent{Name: "vm-agent", Status: agentStatusErrored},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isOrphanedAgent(ap, tt.agent, pods))
+		})
+	}
+}
@@ -6,6 +6,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 )
@@ -28,6 +30,16 @@ var userInteractionRunStatuses = map[tfc.RunStatus]struct{}{
 	tfc.RunPostPlanCompleted:        {},
 }
 
+const (
+	// podDeletionCostAnnotation is used by the ReplicaSet controller to pick pods to remove when scaling down.
+	// Pods with a lower deletion cost are removed first.
+	podDeletionCostAnnotation = "controller.kubernetes.io/pod-deletion-cost"
+	idleAgentPodDeletionCost  = "-100"
+	busyAgentPodDeletionCost  = "100"
+	agentStatusBusy           = "busy"
+	agentStatusIdle           = "idle"
+)
+
 // matchWildcardName checks if a given string matches a specified wildcard pattern.
 // The wildcard pattern can contain '*' at the beginning and/or end to match any sequence of characters.
 // If the pattern contains '*' at both ends, the function checks if the substring exists within the string.
@@ -62,9 +74,25 @@ func matchWildcardName(wildcard string, str string) bool {
 }
 
 // pendingRuns returns the number pending runs for a given agent pool.
+// Only runs of the target workspaces are counted.
+// This function is compatible with HCP Terraform and TFE version v202409-1 and later.
+func pendingRuns(ctx context.Context, ap *agentPoolInstance, targets *targetWorkspaces) (int32, error) {
+	runs, err := pendingRunsByTargets(ctx, ap, []*targetWorkspaces{targets})
+	if err != nil {
+		return 0, err
+	}
+	return runs[0], nil
+}
+
+// pendingRunsByTargets returns the number pending runs for a given agent pool per target workspaces.
+// Each run is counted only once, for the first target workspaces that match the run workspace.
 // This function is compatible with HCP Terraform and TFE version v202409-1 and later.
-func pendingRuns(ctx context.Context, ap *agentPoolInstance) (int32, error) {
-	applyRuns := map[string]struct{}{}
+func pendingRunsByTargets(ctx context.Context, ap *agentPoolInstance, targets []*targetWorkspaces) ([]int32, error) {
+	applyRuns := make([]map[string]struct{}, len(targets))
+	for i := range applyRuns {
+		applyRuns[i] = map[string]struct{}{}
+	}
+	planOnlyRuns := make([]int, len(targets))
 	awaitingUserInteractionRuns := map[string]int{} // Track runs awaiting user interaction by status for future metrics
 	listOpts := &tfc.RunListForOrganizationOptions{
 		AgentPoolNames: ap.instance.Spec.Name,
@@ -74,13 +102,26 @@ func pendingRuns(ctx context.Context, ap *agentPoolInstance) (int32, error) {
 			PageNumber: InitPageNumber,
 		},
 	}
-	planOnlyRuns := 0
+	// Workspace attributes are required to match target workspaces.
+	for _, t := range targets {
+		if t != nil {
+			listOpts.Include = []tfc.RunIncludeOpt{tfc.RunWorkspace}
+			break
+		}
+	}
 	for {
 		runsList, err := ap.tfClient.Client.Runs.ListForOrganization(ctx, ap.instance.Spec.Organization, listOpts)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		for _, run := range runsList.Items {
+			// Skip runs of workspaces that are not targeted
+			i := slices.IndexFunc(targets, func(t *targetWorkspaces) bool {
+				return t.match(run.Workspace)
+			})
+			if i < 0 {
+				continue
+			}
 			// Skip runs that require user interaction
 			if _, ok := userInteractionRunStatuses[run.Status]; ok {
 				// Save the user interactable run statuses for future metrics with count split by status
@@ -89,10 +130,10 @@ func pendingRuns(ctx context.Context, ap *agentPoolInstance) (int32, error) {
 			}
 			// Count plan-only runs separately so agents can scale up and execute runs parallely
 			if run.PlanOnly {
-				planOnlyRuns++
+				planOnlyRuns[i]++
 				continue
 			}
-			applyRuns[run.Workspace.ID] = struct{}{}
+			applyRuns[i][run.Workspace.ID] = struct{}{}
 		}
 		if runsList.NextPage == 0 {
 			break
@@ -102,19 +143,20 @@ func pendingRuns(ctx context.Context, ap *agentPoolInstance) (int32, error) {
 
 	// TODO:
 	// Add metric(s) for runs awaiting user interaction
-	totalPendingRuns := len(applyRuns) + planOnlyRuns
-	ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("apply/plan-only runs: %d/%d", len(applyRuns), planOnlyRuns))
-	return int32(totalPendingRuns), nil
+	totalPendingRuns := make([]int32, len(targets))
+	for i := range targets {
+		totalPendingRuns[i] = int32(len(applyRuns[i]) + planOnlyRuns[i])
+		ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("apply/plan-only runs: %d/%d", len(applyRuns[i]), planOnlyRuns[i]))
+	}
+	return totalPendingRuns, nil
 }
 
-// computeRequiredAgents is a legacy algorithm that is used to compute the number of agents needed.
+// computeRequiredAgents is a legacy algorithm that is used to compute the number of agents needed per target workspaces.
+// It counts workspaces of the agent pool with an active run, each workspace is counted only once,
+// for the first target workspaces that match it.
 // It is used when the TFE version is less than v202409-1.
-func computeRequiredAgents(ctx context.Context, ap *agentPoolInstance) (int32, error) {
-	required := 0
-	// NOTE:
-	// - Two maps are used here to simplify target workspace searching by ID, name, and wildcard.
-	workspaceNames := map[string]struct{}{}
-	workspaceIDs := map[string]struct{}{}
+func computeRequiredAgents(ctx context.Context, ap *agentPoolInstance, targets []*targetWorkspaces) ([]int32, error) {
+	required := make([]int32, len(targets))
 
 	listOpts := &tfc.WorkspaceListOptions{
 		CurrentRunStatus: strings.Join([]string{
@@ -131,12 +173,17 @@ func computeRequiredAgents(ctx context.Context, ap *agentPoolInstance) (int32, e
 	for {
 		workspaceList, err := ap.tfClient.Client.Workspaces.List(ctx, ap.instance.Spec.Organization, listOpts)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		for _, ws := range workspaceList.Items {
-			if ws.AgentPool != nil && ws.AgentPool.ID == ap.instance.Status.AgentPoolID {
-				workspaceNames[ws.Name] = struct{}{}
-				workspaceIDs[ws.ID] = struct{}{}
+			if ws.AgentPool == nil || ws.AgentPool.ID != ap.instance.Status.AgentPoolID {
+				continue
+			}
+			i := slices.IndexFunc(targets, func(t *targetWorkspaces) bool {
+				return t.match(ws)
+			})
+			if i >= 0 {
+				required[i]++
 			}
 		}
 		if workspaceList.NextPage == 0 {
@@ -145,32 +192,7 @@ func computeRequiredAgents(ctx context.Context, ap *agentPoolInstance) (int32, e
 		listOpts.PageNumber = workspaceList.NextPage
 	}
 
-	if ap.instance.Spec.AgentDeploymentAutoscaling.TargetWorkspaces == nil {
-		return int32(len(workspaceNames)), nil
-	}
-
-	for _, t := range *ap.instance.Spec.AgentDeploymentAutoscaling.TargetWorkspaces {
-		switch {
-		case t.Name != "":
-			if _, ok := workspaceNames[t.Name]; ok {
-				required++
-				delete(workspaceNames, t.Name)
-			}
-		case t.ID != "":
-			if _, ok := workspaceIDs[t.ID]; ok {
-				required++
-			}
-		case t.WildcardName != "":
-			for w := range workspaceNames {
-				if ok := matchWildcardName(t.WildcardName, w); ok {
-					required++
-					delete(workspaceNames, w)
-				}
-			}
-		}
-	}
-
-	return int32(required), nil
+	return required, nil
 }
 
 func computeDesiredReplicas(requiredAgents, minReplicas, maxReplicas int32) int32 {
@@ -182,25 +204,103 @@ func computeDesiredReplicas(requiredAgents, minReplicas, maxReplicas int32) int3
 	return requiredAgents
 }
 
-func getAgentDeploymentNamespacedName(ap *agentPoolInstance) types.NamespacedName {
-	return types.NamespacedName{
-		Namespace: ap.instance.Namespace,
-		Name:      AgentPoolDeploymentName(&ap.instance),
+// agentStatuses returns the status of the agent pool agents by agent name.
+// The agent name matches the pod name since it is set via the `TFC_AGENT_NAME` environment variable.
+// An agent may register multiple times with the same name, i.e. when the container restarts.
+// In this case, the `busy` status takes precedence over others.
+func agentStatuses(ctx context.Context, ap *agentPoolInstance) (map[string]string, error) {
+	agents, err := listAgents(ctx, ap)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := map[string]string{}
+	for _, a := range agents {
+		if statuses[a.Name] == agentStatusBusy {
+			continue
+		}
+		if a.Status == agentStatusBusy || a.Status == agentStatusIdle {
+			statuses[a.Name] = a.Status
+			continue
+		}
+		if _, ok := statuses[a.Name]; !ok {
+			statuses[a.Name] = a.Status
+		}
+	}
+
+	return statuses, nil
+}
+
+// markAgentPods sets the pod deletion cost annotation and the busy label on the agent pods based on the agent status.
+// Pods with idle agents get a lower deletion cost than pods with busy agents,
+// so the ReplicaSet controller removes idle agents first when the deployment scales down.
+// It returns the number of pods with busy agents.
+func (r *AgentPoolReconciler) markAgentPods(ctx context.Context, ap *agentPoolInstance, matchLabels map[string]string) (int32, error) {
+	statuses, err := agentStatuses(ctx, ap)
+	if err != nil {
+		return 0, err
 	}
+
+	return r.markAgentPodsByStatus(ctx, ap, matchLabels, statuses)
 }
 
-func (r *AgentPoolReconciler) getAgentDeploymentReplicas(ctx context.Context, ap *agentPoolInstance) (int32, error) {
+// markAgentPodsByStatus sets the pod deletion cost annotation and the busy label on the agent pods based on the agent statuses by pod name.
+// It returns the number of pods with busy agents.
+func (r *AgentPoolReconciler) markAgentPodsByStatus(ctx context.Context, ap *agentPoolInstance, matchLabels map[string]string, statuses map[string]string) (int32, error) {
+	pods := &corev1.PodList{}
+	err := r.Client.List(ctx, pods, client.InNamespace(ap.instance.Namespace), client.MatchingLabels(matchLabels))
+	if err != nil {
+		return 0, err
+	}
+
+	var busy int32 = 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		// Agents that have not registered yet or have exited do not get a deletion cost.
+		// The ReplicaSet controller prefers not ready pods anyway.
+		var cost string
+		switch statuses[pod.Name] {
+		case agentStatusBusy:
+			busy++
+			cost = busyAgentPodDeletionCost
+		case agentStatusIdle:
+			cost = idleAgentPodDeletionCost
+		}
+		patch := client.MergeFrom(pod.DeepCopy())
+		changed := setAgentPodBusyLabel(pod, statuses[pod.Name] == agentStatusBusy)
+		if cost != "" && pod.Annotations[podDeletionCostAnnotation] != cost {
+			if pod.Annotations == nil {
+				pod.Annotations = map[string]string{}
+			}
+			pod.Annotations[podDeletionCostAnnotation] = cost
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		if err := r.Client.Patch(ctx, pod, patch); err != nil {
+			return 0, err
+		}
+	}
+
+	return busy, nil
+}
+
+func (r *AgentPoolReconciler) getAgentDeploymentReplicas(ctx context.Context, ap *agentPoolInstance, name string) (int32, error) {
 	deployment := appsv1.Deployment{}
-	err := r.Client.Get(ctx, getAgentDeploymentNamespacedName(ap), &deployment)
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: ap.instance.Namespace, Name: name}, &deployment)
 	if err != nil {
 		return 0, err
 	}
 	return *deployment.Spec.Replicas, nil
 }
 
-func (r *AgentPoolReconciler) scaleAgentDeployment(ctx context.Context, ap *agentPoolInstance, target *int32) error {
+func (r *AgentPoolReconciler) scaleAgentDeployment(ctx context.Context, ap *agentPoolInstance, name string, target *int32) error {
 	deployment := appsv1.Deployment{}
-	err := r.Client.Get(ctx, getAgentDeploymentNamespacedName(ap), &deployment)
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: ap.instance.Namespace, Name: name}, &deployment)
 	if err != nil {
 		return err
 	}
@@ -210,15 +310,14 @@ func (r *AgentPoolReconciler) scaleAgentDeployment(ctx context.Context, ap *agen
 
 // cooldownSecondsRemaining returns the remaining seconds in the Cool Down stage.
 // A negative value indicates expired Cool Down.
-func (a *agentPoolInstance) cooldownSecondsRemaining(currentReplicas, desiredReplicas int32) int {
-	status := a.instance.Status.AgentDeploymentAutoscalingStatus
+func (a *agentPoolInstance) cooldownSecondsRemaining(autoscaling *appv1alpha2.AgentDeploymentAutoscaling, status *appv1alpha2.AgentDeploymentAutoscalingStatus, currentReplicas, desiredReplicas int32) int {
 	if status == nil || status.LastScalingEvent == nil {
 		return -1
 	}
 
-	cooldownPeriodSeconds := int(*a.instance.Spec.AgentDeploymentAutoscaling.CooldownPeriodSeconds)
+	cooldownPeriodSeconds := int(*autoscaling.CooldownPeriodSeconds)
 
-	cooldownPeriod := a.instance.Spec.AgentDeploymentAutoscaling.CooldownPeriod
+	cooldownPeriod := autoscaling.CooldownPeriod
 	if cooldownPeriod != nil {
 		if v := cooldownPeriod.ScaleDownSeconds; v != nil {
 			cooldownPeriodSeconds = int(*v)
@@ -239,6 +338,49 @@ func (a *agentPoolInstance) cooldownSecondsRemaining(currentReplicas, desiredRep
 	return cooldownPeriodSeconds - lastScalingEventSeconds
 }
 
+// requiredAgents returns the number of agents needed to process pending runs of the target workspaces.
+// If the target workspaces are not set, all workspaces of the agent pool are targeted.
+func (r *AgentPoolReconciler) requiredAgents(ctx context.Context, ap *agentPoolInstance, targetWorkspaces *[]appv1alpha2.TargetWorkspace) (int32, error) {
+	required, err := r.requiredAgentsByTargets(ctx, ap, []*[]appv1alpha2.TargetWorkspace{targetWorkspaces})
+	if err != nil {
+		return 0, err
+	}
+	return required[0], nil
+}
+
+// requiredAgentsByTargets returns the number of agents needed to process pending runs per target workspaces.
+// Each pending run is assigned to the first target workspaces that match it, target workspaces that are not set match all workspaces.
+// It picks the algorithm based on the HCP Terraform or TFE version.
+func (r *AgentPoolReconciler) requiredAgentsByTargets(ctx context.Context, ap *agentPoolInstance, targetLists []*[]appv1alpha2.TargetWorkspace) ([]int32, error) {
+	targets := make([]*targetWorkspaces, len(targetLists))
+	for i, tw := range targetLists {
+		t, err := r.newTargetWorkspaces(ctx, ap, tw)
+		if err != nil {
+			return nil, err
+		}
+		targets[i] = t
+	}
+	if ap.tfClient.Client.IsCloud() {
+		return pendingRunsByTargets(ctx, ap, targets)
+	}
+	tfeVersion := ap.tfClient.Client.RemoteTFEVersion()
+	runsEndpoint, err := useRunsEndpoint(tfeVersion)
+	if err != nil {
+		// If the TFE version parsing fails, do not return the error here and proceed further.
+		// In this case, a legacy algorithm will be taken.
+		ap.log.Error(err, "Reconcile Agent Autoscaling", "msg", "Failed to parse TFE version")
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AutoscaleAgentPool", "Failed to parse TFE version: %v", err.Error())
+	}
+	// In TFE version v202409-1, a new Runs API endpoint was introduced.
+	// It now allows retrieving a list of runs for the organization.
+	if runsEndpoint {
+		ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("Proceeding with the new algorithm based on the detected TFE version %s", tfeVersion))
+		return pendingRunsByTargets(ctx, ap, targets)
+	}
+	ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("Proceeding with the legacy algorithm based to the detected TFE version %s", tfeVersion))
+	return computeRequiredAgents(ctx, ap, targets)
+}
+
 func (r *AgentPoolReconciler) reconcileAgentAutoscaling(ctx context.Context, ap *agentPoolInstance) error {
 	if ap.instance.Spec.AgentDeploymentAutoscaling == nil {
 		return nil
@@ -246,27 +388,7 @@ func (r *AgentPoolReconciler) reconcileAgentAutoscaling(ctx context.Context, ap
 
 	ap.log.Info("Reconcile Agent Autoscaling", "msg", "new reconciliation event")
 
-	requiredAgents, err := func() (int32, error) {
-		if ap.tfClient.Client.IsCloud() {
-			return pendingRuns(ctx, ap)
-		}
-		tfeVersion := ap.tfClient.Client.RemoteTFEVersion()
-		runsEndpoint, err := useRunsEndpoint(tfeVersion)
-		if err != nil {
-			// If the TFE version parsing fails, do not return the error here and proceed further.
-			// In this case, a legacy algorithm will be taken.
-			ap.log.Error(err, "Reconcile Agent Autoscaling", "msg", "Failed to parse TFE version")
-			r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AutoscaleAgentPool", "Failed to parse TFE version: %v", err.Error())
-		}
-		// In TFE version v202409-1, a new Runs API endpoint was introduced.
-		// It now allows retrieving a list of runs for the organization.
-		if runsEndpoint {
-			ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("Proceeding with the new algorithm based on the detected TFE version %s", tfeVersion))
-			return pendingRuns(ctx, ap)
-		}
-		ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("Proceeding with the legacy algorithm based to the detected TFE version %s", tfeVersion))
-		return computeRequiredAgents(ctx, ap)
-	}()
+	requiredAgents, err := r.requiredAgents(ctx, ap, ap.instance.Spec.AgentDeploymentAutoscaling.TargetWorkspaces)
 	if err != nil {
 		ap.log.Error(err, "Reconcile Agent Autoscaling", "msg", "Failed to get agents needed")
 		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AutoscaleAgentPool", "Failed to get agents needed: %v", err.Error())
@@ -274,46 +396,98 @@ func (r *AgentPoolReconciler) reconcileAgentAutoscaling(ctx context.Context, ap
 	}
 	ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("%d agents are required", requiredAgents))
 
-	currentReplicas, err := r.getAgentDeploymentReplicas(ctx, ap)
+	status, err := r.autoscaleAgentDeployment(ctx, ap, AgentPoolDeploymentName(&ap.instance), agentPodMatchLabels(&ap.instance),
+		ap.instance.Spec.AgentDeploymentAutoscaling, ap.instance.Status.AgentDeploymentAutoscalingStatus, requiredAgents)
+	if err != nil {
+		return err
+	}
+	ap.instance.Status.AgentDeploymentAutoscalingStatus = status
+
+	return nil
+}
+
+// autoscaleAgentDeployment scales the agent deployment to meet the number of required agents within the autoscaling bounds.
+// It returns the new autoscaling status of the deployment.
+func (r *AgentPoolReconciler) autoscaleAgentDeployment(ctx context.Context, ap *agentPoolInstance, name string, matchLabels map[string]string,
+	autoscaling *appv1alpha2.AgentDeploymentAutoscaling, status *appv1alpha2.AgentDeploymentAutoscalingStatus, requiredAgents int32) (*appv1alpha2.AgentDeploymentAutoscalingStatus, error) {
+	currentReplicas, err := r.getAgentDeploymentReplicas(ctx, ap, name)
 	if err != nil {
 		ap.log.Error(err, "Reconcile Agent Autoscaling", "msg", "Failed to get current replicas")
 		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AutoscaleAgentPool", "Failed to get current replicas: %v", err.Error())
-		return err
+		return status, err
 	}
 	ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("%d agent replicas are running", currentReplicas))
 
-	minReplicas := *ap.instance.Spec.AgentDeploymentAutoscaling.MinReplicas
-	maxReplicas := *ap.instance.Spec.AgentDeploymentAutoscaling.MaxReplicas
+	minReplicas, maxReplicas, activeSchedule, err := autoscalingReplicaBounds(autoscaling, time.Now())
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agent Autoscaling", "msg", "Failed to evaluate autoscaling schedules")
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AutoscaleAgentPool", "Failed to evaluate autoscaling schedules: %v", err.Error())
+		return status, err
+	}
+	if status == nil || status.ActiveSchedule != activeSchedule {
+		if activeSchedule != "" {
+			r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "AutoscaleAgentPool", "Autoscaling schedule %s of agent deployment %s is active, replicas are within %d and %d", activeSchedule, name, minReplicas, maxReplicas)
+		} else if status != nil {
+			r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "AutoscaleAgentPool", "No autoscaling schedule of agent deployment %s is active, replicas are within %d and %d", name, minReplicas, maxReplicas)
+		}
+	}
+	ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("replicas are within %d and %d, active schedule: %q", minReplicas, maxReplicas, activeSchedule))
+	// withReplicaBounds returns a copy of the status with the replica bounds in effect.
+	withReplicaBounds := func(s *appv1alpha2.AgentDeploymentAutoscalingStatus) *appv1alpha2.AgentDeploymentAutoscalingStatus {
+		s = s.DeepCopy()
+		s.ActiveSchedule = activeSchedule
+		s.MinReplicas = &minReplicas
+		s.MaxReplicas = &maxReplicas
+		return s
+	}
+
 	desiredReplicas := computeDesiredReplicas(requiredAgents, minReplicas, maxReplicas)
 
+	// Never scale down below the number of busy agents.
+	if desiredReplicas < currentReplicas {
+		busyAgents, err := r.markAgentPods(ctx, ap, matchLabels)
+		if err != nil {
+			ap.log.Error(err, "Reconcile Agent Autoscaling", "msg", "Failed to get busy agents")
+			r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AutoscaleAgentPool", "Failed to get busy agents: %v", err.Error())
+			return status, err
+		}
+		ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("%d agents are busy", busyAgents))
+		if busyAgents > desiredReplicas {
+			desiredReplicas = min(busyAgents, currentReplicas)
+			ap.log.Info("Reconcile Agent Autoscaling", "msg", fmt.Sprintf("limit scaling down to %d replicas to keep busy agents", desiredReplicas))
+		}
+	}
+
 	if desiredReplicas != currentReplicas {
-		if ap.cooldownSecondsRemaining(currentReplicas, desiredReplicas) > 0 {
+		// Scaling up to the minimum number of replicas in effect ignores the cooldown period,
+		// so that agents are ready when a schedule window starts.
+		if currentReplicas >= minReplicas && ap.cooldownSecondsRemaining(autoscaling, status, currentReplicas, desiredReplicas) > 0 {
 			ap.log.Info("Reconcile Agent Autoscaling", "msg", "autoscaler is within the cooldown period, skipping")
-			return nil
+			return withReplicaBounds(status), nil
 		}
 
-		scalingEvent := fmt.Sprintf("Scaling agent deployment from %v to %v replicas", currentReplicas, desiredReplicas)
+		scalingEvent := fmt.Sprintf("Scaling agent deployment %s from %v to %v replicas", name, currentReplicas, desiredReplicas)
 		ap.log.Info("Reconcile Agent Autoscaling", "msg", strings.ToLower(scalingEvent))
 		r.Recorder.Event(&ap.instance, corev1.EventTypeNormal, "AutoscaleAgentPool", scalingEvent)
-		err := r.scaleAgentDeployment(ctx, ap, &desiredReplicas)
+		err := r.scaleAgentDeployment(ctx, ap, name, &desiredReplicas)
 		if err != nil {
 			ap.log.Error(err, "Reconcile Agent Autoscaling", "msg", "Failed to scale agent deployment")
 			r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AutoscaleAgentPool", "Failed to scale agent deployment: %v", err.Error())
-			return err
+			return status, err
 		}
-		ap.instance.Status.AgentDeploymentAutoscalingStatus = &appv1alpha2.AgentDeploymentAutoscalingStatus{
+		return withReplicaBounds(&appv1alpha2.AgentDeploymentAutoscalingStatus{
 			DesiredReplicas: &desiredReplicas,
 			LastScalingEvent: &metav1.Time{
 				Time: time.Now(),
 			},
-		}
+		}), nil
 	}
 
-	if ap.instance.Status.AgentDeploymentAutoscalingStatus == nil {
-		ap.instance.Status.AgentDeploymentAutoscalingStatus = &appv1alpha2.AgentDeploymentAutoscalingStatus{
+	if status == nil {
+		return withReplicaBounds(&appv1alpha2.AgentDeploymentAutoscalingStatus{
 			DesiredReplicas: &desiredReplicas,
-		}
+		}), nil
 	}
 
-	return nil
+	return withReplicaBounds(status), nil
 }
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// scheduleWindowActive checks if the window of the schedule contains the given time.
+// The window starts at every time that matches the cron expression in the schedule time zone and lasts for the schedule duration.
+func scheduleWindowActive(schedule *appv1alpha2.AgentDeploymentAutoscalingSchedule, now time.Time) (bool, error) {
+	loc, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return false, err
+	}
+	s, err := cron.ParseStandard(schedule.Schedule)
+	if err != nil {
+		return false, err
+	}
+	// The window is active if it has started within the last duration.
+	start := s.Next(now.Add(-schedule.Duration.Duration).In(loc))
+	return !start.After(now), nil
+}
+
+// autoscalingReplicaBounds returns the minimum and maximum number of replicas in effect at the given time,
+// and the name of the active schedule. The first active schedule in the list overrides the autoscaling bounds.
+func autoscalingReplicaBounds(autoscaling *appv1alpha2.AgentDeploymentAutoscaling, now time.Time) (int32, int32, string, error) {
+	minReplicas := *autoscaling.MinReplicas
+	maxReplicas := *autoscaling.MaxReplicas
+
+	for i := range autoscaling.Schedules {
+		s := &autoscaling.Schedules[i]
+		active, err := scheduleWindowActive(s, now)
+		if err != nil {
+			return minReplicas, maxReplicas, "", err
+		}
+		if !active {
+			continue
+		}
+		if s.MinReplicas != nil {
+			minReplicas = *s.MinReplicas
+		}
+		if s.MaxReplicas != nil {
+			maxReplicas = *s.MaxReplicas
+		}
+		return minReplicas, maxReplicas, s.Name, nil
+	}
+
+	return minReplicas, maxReplicas, "", nil
+}
+
+// initialReplicas returns the number of replicas of a new autoscaled agent deployment,
+// which is the minimum number of replicas in effect.
+func initialReplicas(autoscaling *appv1alpha2.AgentDeploymentAutoscaling) *int32 {
+	minReplicas, _, _, err := autoscalingReplicaBounds(autoscaling, time.Now())
+	if err != nil {
+		return autoscaling.MinReplicas
+	}
+	return &minReplicas
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	tfc "github.com/hashicorp/go-tfe"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// targetWorkspaces filters workspaces that the agent autoscaler targets.
+// A workspace is targeted if it matches any of the matchers.
+// A nil value targets all workspaces.
+type targetWorkspaces struct {
+	matchers []func(ws *tfc.Workspace) bool
+}
+
+// match returns true if the workspace is targeted.
+func (t *targetWorkspaces) match(ws *tfc.Workspace) bool {
+	if t == nil {
+		return true
+	}
+	if ws == nil {
+		return false
+	}
+	for _, m := range t.matchers {
+		if m(ws) {
+			return true
+		}
+	}
+	return false
+}
+
+// getTargetProjectID returns the project ID of the target project.
+func getTargetProjectID(ctx context.Context, ap *agentPoolInstance, project *appv1alpha2.WorkspaceProject) (string, error) {
+	if project.ID != "" {
+		return project.ID, nil
+	}
+
+	listOpts := &tfc.ProjectListOptions{
+		Name: project.Name,
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		projectIDs, err := ap.tfClient.Client.Projects.List(ctx, ap.instance.Spec.Organization, listOpts)
+		if err != nil {
+			return "", err
+		}
+		for _, p := range projectIDs.Items {
+			if p.Name == project.Name {
+				return p.ID, nil
+			}
+		}
+		if projectIDs.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = projectIDs.NextPage
+	}
+
+	return "", fmt.Errorf("project ID not found for project name %q", project.Name)
+}
+
+// getSelectedWorkspaceIDs returns IDs of the workspaces managed by the Workspace objects that match the label selector.
+func (r *AgentPoolReconciler) getSelectedWorkspaceIDs(ctx context.Context, ap *agentPoolInstance, selector *metav1.LabelSelector) (map[string]struct{}, error) {
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	workspaces := &appv1alpha2.WorkspaceList{}
+	err = r.Client.List(ctx, workspaces, client.InNamespace(ap.instance.Namespace), client.MatchingLabelsSelector{Selector: s})
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]struct{}, len(workspaces.Items))
+	for _, w := range workspaces.Items {
+		if w.Status.WorkspaceID != "" {
+			ids[w.Status.WorkspaceID] = struct{}{}
+		}
+	}
+	return ids, nil
+}
+
+// newTargetWorkspaces returns the target workspaces filter.
+// It returns nil if the target workspaces are not set.
+func (r *AgentPoolReconciler) newTargetWorkspaces(ctx context.Context, ap *agentPoolInstance, targets *[]appv1alpha2.TargetWorkspace) (*targetWorkspaces, error) {
+	if targets == nil {
+		return nil, nil
+	}
+
+	t := &targetWorkspaces{}
+	for _, tw := range *targets {
+		switch {
+		case tw.ID != "":
+			id := tw.ID
+			t.matchers = append(t.matchers, func(ws *tfc.Workspace) bool {
+				return ws.ID == id
+			})
+		case tw.Name != "":
+			name := tw.Name
+			t.matchers = append(t.matchers, func(ws *tfc.Workspace) bool {
+				return ws.Name == name
+			})
+		case tw.WildcardName != "":
+			wildcard := tw.WildcardName
+			t.matchers = append(t.matchers, func(ws *tfc.Workspace) bool {
+				return matchWildcardName(wildcard, ws.Name)
+			})
+		case tw.Project != nil:
+			projectID, err := getTargetProjectID(ctx, ap, tw.Project)
+			if err != nil {
+				return nil, err
+			}
+			t.matchers = append(t.matchers, func(ws *tfc.Workspace) bool {
+				return ws.Project != nil && ws.Project.ID == projectID
+			})
+		case len(tw.Tags) > 0:
+			tags := tw.Tags
+			t.matchers = append(t.matchers, func(ws *tfc.Workspace) bool {
+				for _, tag := range tags {
+					if !slices.Contains(ws.TagNames, string(tag)) {
+						return false
+					}
+				}
+				return true
+			})
+		case tw.WorkspaceSelector != nil:
+			ids, err := r.getSelectedWorkspaceIDs(ctx, ap, tw.WorkspaceSelector)
+			if err != nil {
+				return nil, err
+			}
+			t.matchers = append(t.matchers, func(ws *tfc.Workspace) bool {
+				_, ok := ids[ws.ID]
+				return ok
+			})
+		}
+	}
+
+	return t, nil
+}
@@ -7,14 +7,17 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	tfc "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/go-tfe/mocks"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
 )
 
 func TestPendingRuns(t *testing.T) {
@@ -149,7 +152,7 @@ func TestPendingRuns(t *testing.T) {
 				log: logr.Logger{},
 			}
 
-			count, err := pendingRuns(context.Background(), ap)
+			count, err := pendingRuns(context.Background(), ap, nil)
 			if tt.expectError {
 				assert.Error(t, err)
 			} else {
@@ -159,3 +162,298 @@ func TestPendingRuns(t *testing.T) {
 		})
 	}
 }
+
+func TestAgentStatuses(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockAgents       []*tfc.Agent
+		mockErr          error
+		expectedStatuses map[string]string
+		expectError      bool
+	}{
+		{
+			name:        "returns error from client",
+			mockErr:     errors.New("api error"),
+			expectError: true,
+		},
+		{
+			name: "maps agent statuses by name",
+			mockAgents: []*tfc.Agent{
+				{ID: "agent1", Name: "pod1", Status: "idle"},
+				{ID: "agent2", Name: "pod2", Status: "busy"},
+			},
+			expectedStatuses: map[string]string{
+				"pod1": "idle",
+				"pod2": "busy",
+			},
+		},
+		{
+			name: "busy status takes precedence",
+			mockAgents: []*tfc.Agent{
+				{ID: "agent1", Name: "pod1", Status: "busy"},
+				{ID: "agent2", Name: "pod1", Status: "exited"},
+				{ID: "agent3", Name: "pod1", Status: "idle"},
+			},
+			expectedStatuses: map[string]string{
+				"pod1": "busy",
+			},
+		},
+		{
+			name: "idle status takes precedence over exited",
+			mockAgents: []*tfc.Agent{
+				{ID: "agent1", Name: "pod1", Status: "exited"},
+				{ID: "agent2", Name: "pod1", Status: "idle"},
+			},
+			expectedStatuses: map[string]string{
+				"pod1": "idle",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAgents := mocks.NewMockAgents(ctrl)
+			mockAgents.EXPECT().
+				List(gomock.Any(), "apool-test", gomock.Any()).
+				Return(&tfc.AgentList{Items: tt.mockAgents, Pagination: &tfc.Pagination{NextPage: 0}}, tt.mockErr)
+
+			ap := &agentPoolInstance{
+				tfClient: HCPTerraformClient{Client: &tfc.Client{Agents: mockAgents}},
+				instance: appv1alpha2.AgentPool{
+					Status: appv1alpha2.AgentPoolStatus{
+						AgentPoolID: "apool-test",
+					},
+				},
+				log: logr.Logger{},
+			}
+
+			statuses, err := agentStatuses(context.Background(), ap)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatuses, statuses)
+			}
+		})
+	}
+}
+
+func TestTargetWorkspacesMatch(t *testing.T) {
+	targets := &[]appv1alpha2.TargetWorkspace{
+		{ID: "ws-id"},
+		{Name: "name"},
+		{WildcardName: "wildcard-*"},
+		{Tags: []appv1alpha2.Tag{"this", "self"}},
+	}
+
+	tests := []struct {
+		name      string
+		workspace *tfc.Workspace
+		expected  bool
+	}{
+		{
+			name:      "matches by ID",
+			workspace: &tfc.Workspace{ID: "ws-id", Name: "other"},
+			expected:  true,
+		},
+		{
+			name:      "matches by name",
+			workspace: &tfc.Workspace{ID: "ws-other", Name: "name"},
+			expected:  true,
+		},
+		{
+			name:      "matches by wildcard name",
+			workspace: &tfc.Workspace{ID: "ws-other", Name: "wildcard-name"},
+			expected:  true,
+		},
+		{
+			name:      "matches by all tags",
+			workspace: &tfc.Workspace{ID: "ws-other", Name: "other", TagNames: []string{"self", "this", "other"}},
+			expected:  true,
+		},
+		{
+			name:      "does not match by some tags",
+			workspace: &tfc.Workspace{ID: "ws-other", Name: "other", TagNames: []string{"this"}},
+			expected:  false,
+		},
+		{
+			name:      "does not match",
+			workspace: &tfc.Workspace{ID: "ws-other", Name: "other"},
+			expected:  false,
+		},
+		{
+			name:      "does not match nil workspace",
+			workspace: nil,
+			expected:  false,
+		},
+	}
+
+	r := &AgentPoolReconciler{}
+	ap := &agentPoolInstance{log: logr.Logger{}}
+	tw, err := r.newTargetWorkspaces(context.Background(), ap, targets)
+	assert.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tw.match(tt.workspace))
+		})
+	}
+
+	t.Run("nil targets match all workspaces", func(t *testing.T) {
+		var all *targetWorkspaces
+		assert.True(t, all.match(&tfc.Workspace{ID: "ws-other"}))
+	})
+}
+
+func TestPendingRunsTargetWorkspaces(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRuns := mocks.NewMockRuns(ctrl)
+	mockRuns.EXPECT().
+		ListForOrganization(gomock.Any(), "test-org", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, opts *tfc.RunListForOrganizationOptions) (*tfc.OrganizationRunList, error) {
+			assert.Equal(t, []tfc.RunIncludeOpt{tfc.RunWorkspace}, opts.Include)
+			return &tfc.OrganizationRunList{
+				Items: []*tfc.Run{
+					{ID: "run1", Status: tfc.RunPlanning, Workspace: &tfc.Workspace{ID: "ws1", Name: "target"}},
+					{ID: "run2", Status: tfc.RunPlanning, Workspace: &tfc.Workspace{ID: "ws2", Name: "other"}},
+					{ID: "run3", PlanOnly: true, Status: tfc.RunPlanning, Workspace: &tfc.Workspace{ID: "ws1", Name: "target"}},
+				},
+				PaginationNextPrev: &tfc.PaginationNextPrev{NextPage: 0},
+			}, nil
+		})
+
+	ap := &agentPoolInstance{
+		tfClient: HCPTerraformClient{Client: &tfc.Client{Runs: mockRuns}},
+		instance: appv1alpha2.AgentPool{
+			Spec: appv1alpha2.AgentPoolSpec{
+				Name:         "test-pool",
+				Organization: "test-org",
+			},
+		},
+		log: logr.Logger{},
+	}
+
+	r := &AgentPoolReconciler{}
+	targets, err := r.newTargetWorkspaces(context.Background(), ap, &[]appv1alpha2.TargetWorkspace{{Name: "target"}})
+	assert.NoError(t, err)
+
+	count, err := pendingRuns(context.Background(), ap, targets)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), count)
+}
+
+func TestPendingRunsByTargets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRuns := mocks.NewMockRuns(ctrl)
+	mockRuns.EXPECT().
+		ListForOrganization(gomock.Any(), "test-org", gomock.Any()).
+		Return(&tfc.OrganizationRunList{
+			Items: []*tfc.Run{
+				{ID: "run1", Status: tfc.RunPlanning, Workspace: &tfc.Workspace{ID: "ws1", Name: "large-1"}},
+				{ID: "run2", Status: tfc.RunPlanning, Workspace: &tfc.Workspace{ID: "ws2", Name: "large-2"}},
+				{ID: "run3", Status: tfc.RunPlanning, Workspace: &tfc.Workspace{ID: "ws3", Name: "small-1"}},
+				{ID: "run4", PlanOnly: true, Status: tfc.RunPlanning, Workspace: &tfc.Workspace{ID: "ws1", Name: "large-1"}},
+			},
+			PaginationNextPrev: &tfc.PaginationNextPrev{NextPage: 0},
+		}, nil)
+
+	ap := &agentPoolInstance{
+		tfClient: HCPTerraformClient{Client: &tfc.Client{Runs: mockRuns}},
+		instance: appv1alpha2.AgentPool{
+			Spec: appv1alpha2.AgentPoolSpec{
+				Name:         "test-pool",
+				Organization: "test-org",
+			},
+		},
+		log: logr.Logger{},
+	}
+
+	r := &AgentPoolReconciler{}
+	large, err := r.newTargetWorkspaces(context.Background(), ap, &[]appv1alpha2.TargetWorkspace{{WildcardName: "large-*"}})
+	assert.NoError(t, err)
+
+	// The second target matches all workspaces, runs of the first target must not be counted twice.
+	count, err := pendingRunsByTargets(context.Background(), ap, []*targetWorkspaces{large, nil})
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{3, 1}, count)
+}
+
+func TestAutoscalingReplicaBounds(t *testing.T) {
+	autoscaling := &appv1alpha2.AgentDeploymentAutoscaling{
+		MinReplicas: pointer.PointerOf(int32(0)),
+		MaxReplicas: pointer.PointerOf(int32(5)),
+		Schedules: []appv1alpha2.AgentDeploymentAutoscalingSchedule{
+			{
+				Name:        "business-hours",
+				Schedule:    "0 8 * * 1-5",
+				Duration:    metav1.Duration{Duration: 10 * time.Hour},
+				TimeZone:    "Europe/Amsterdam",
+				MinReplicas: pointer.PointerOf(int32(3)),
+			},
+			{
+				Name:        "weekdays",
+				Schedule:    "0 0 * * 1-5",
+				Duration:    metav1.Duration{Duration: 24 * time.Hour},
+				MaxReplicas: pointer.PointerOf(int32(10)),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		now            time.Time
+		expectedMin    int32
+		expectedMax    int32
+		expectedActive string
+	}{
+		{
+			// Monday 09:00 in Amsterdam (CEST).
+			name:           "first active schedule wins",
+			now:            time.Date(2025, time.June, 2, 7, 0, 0, 0, time.UTC),
+			expectedMin:    3,
+			expectedMax:    5,
+			expectedActive: "business-hours",
+		},
+		{
+			// Monday 07:30 in Amsterdam (CEST).
+			name:           "before the window start",
+			now:            time.Date(2025, time.June, 2, 5, 30, 0, 0, time.UTC),
+			expectedMin:    0,
+			expectedMax:    10,
+			expectedActive: "weekdays",
+		},
+		{
+			// Monday 18:00 in Amsterdam (CEST), the window ends.
+			name:           "at the window end",
+			now:            time.Date(2025, time.June, 2, 16, 0, 0, 0, time.UTC),
+			expectedMin:    0,
+			expectedMax:    10,
+			expectedActive: "weekdays",
+		},
+		{
+			// Saturday.
+			name:           "no active schedule",
+			now:            time.Date(2025, time.June, 7, 10, 0, 0, 0, time.UTC),
+			expectedMin:    0,
+			expectedMax:    5,
+			expectedActive: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minReplicas, maxReplicas, active, err := autoscalingReplicaBounds(autoscaling, tt.now)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedMin, minReplicas)
+			assert.Equal(t, tt.expectedMax, maxReplicas)
+			assert.Equal(t, tt.expectedActive, active)
+		})
+	}
+}
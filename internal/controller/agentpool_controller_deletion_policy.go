@@ -10,6 +10,7 @@ import (
 
 	tfc "github.com/hashicorp/go-tfe"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
@@ -50,7 +51,7 @@ func (r *AgentPoolReconciler) deleteAgentPool(ctx context.Context, ap *agentPool
 			if *ap.instance.Status.AgentDeploymentAutoscalingStatus.DesiredReplicas > 0 {
 				ap.log.Info("Reconcile Agent Pool", "msg", fmt.Sprintf("scale agents from %d to 0", *ap.instance.Status.AgentDeploymentAutoscalingStatus.DesiredReplicas))
 				var n int32 = 0
-				if err := r.scaleAgentDeployment(ctx, ap, &n); err != nil {
+				if err := r.scaleAgentDeployment(ctx, ap, AgentPoolDeploymentName(&ap.instance), &n); err != nil {
 					ap.log.Error(err, "Reconcile Agent Pool", "msg", "failed to scale agents")
 					return err
 				}
@@ -63,6 +64,32 @@ func (r *AgentPoolReconciler) deleteAgentPool(ctx context.Context, ap *agentPool
 				ap.log.Info("Reconcile Agent Pool", "msg", "successfully scaled agents to 0")
 			}
 		}
+		// Downscale agent deployment classes
+		for i, c := range ap.instance.Status.AgentDeploymentClasses {
+			if c.DesiredReplicas == nil || *c.DesiredReplicas == 0 {
+				continue
+			}
+			ap.log.Info("Reconcile Agent Pool", "msg", fmt.Sprintf("scale agents of class %s from %d to 0", c.Name, *c.DesiredReplicas))
+			var n int32 = 0
+			if err := r.scaleAgentDeployment(ctx, ap, c.DeploymentName, &n); err != nil && !kerrors.IsNotFound(err) {
+				ap.log.Error(err, "Reconcile Agent Pool", "msg", fmt.Sprintf("failed to scale agents of class %s", c.Name))
+				return err
+			}
+			ap.instance.Status.AgentDeploymentClasses[i].DesiredReplicas = &n
+			ap.instance.Status.AgentDeploymentClasses[i].LastScalingEvent = &metav1.Time{
+				Time: time.Now(),
+			}
+		}
+		// Remove agent Jobs
+		if ap.instance.Status.AgentJobsStatus != nil {
+			ap.log.Info("Reconcile Agent Pool", "msg", "remove agent jobs")
+			if err := r.deleteAgentJobs(ctx, ap); err != nil {
+				ap.log.Error(err, "Reconcile Agent Pool", "msg", "failed to remove agent jobs")
+				return err
+			}
+			ap.instance.Status.AgentJobsStatus = nil
+			ap.log.Info("Reconcile Agent Pool", "msg", "successfully removed agent jobs")
+		}
 		// Remove tokens
 		if len(ap.instance.Status.AgentTokens) > 0 {
 			ap.log.Info("Reconcile Agent Pool", "msg", "remove tokens")
@@ -72,6 +99,14 @@ func (r *AgentPoolReconciler) deleteAgentPool(ctx context.Context, ap *agentPool
 				tid = append(tid, token.ID)
 			}
 			for _, id := range tid {
+				// Revoke the token replaced during the last rotation too.
+				if t := ap.getTokenStatus(id); t != nil && t.PreviousID != "" {
+					err := ap.tfClient.Client.AgentTokens.Delete(ctx, t.PreviousID)
+					if err != nil && err != tfc.ErrResourceNotFound {
+						ap.log.Error(err, "Reconcile Agent Pool", "msg", fmt.Sprintf("failed to remove token %s", t.PreviousID))
+						return err
+					}
+				}
 				err := ap.tfClient.Client.AgentTokens.Delete(ctx, id)
 				if err != nil && err != tfc.ErrResourceNotFound {
 					ap.log.Error(err, "Reconcile Agent Pool", "msg", fmt.Sprintf("failed to remove token %s", id))
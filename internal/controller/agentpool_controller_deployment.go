@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"maps"
 	"net/url"
+	"strconv"
 
 	tfc "github.com/hashicorp/go-tfe"
 	appsv1 "k8s.io/api/apps/v1"
@@ -24,10 +25,12 @@ import (
 )
 
 const (
-	poolNameLabel             = "agentpool.app.terraform.io/pool-name"
-	poolIDLabel               = "agentpool.app.terraform.io/pool-id"
-	DefaultAgentImage         = "hashicorp/tfc-agent"
-	DefaultAgentContainerName = "tfc-agent"
+	poolNameLabel = "agentpool.app.terraform.io/pool-name"
+	poolIDLabel   = "agentpool.app.terraform.io/pool-id"
+	// agentTokenRotatedAtAnnotation is the pod template annotation that restarts agent pods when their agent token is rotated.
+	agentTokenRotatedAtAnnotation = "agentpool.app.terraform.io/token-rotated-at"
+	DefaultAgentImage             = "hashicorp/tfc-agent"
+	DefaultAgentContainerName     = "tfc-agent"
 )
 
 func (r *AgentPoolReconciler) reconcileAgentDeployment(ctx context.Context, ap *agentPoolInstance) error {
@@ -72,7 +75,11 @@ func (r *AgentPoolReconciler) createDeployment(ctx context.Context, ap *agentPoo
 	}
 	// if autoscaler is enabled, set the replicas to the min
 	if a := ap.instance.Spec.AgentDeploymentAutoscaling; a != nil {
-		d.Spec.Replicas = a.MinReplicas
+		d.Spec.Replicas = initialReplicas(a)
+	}
+	// if external autoscaler is enabled, set the initial replicas
+	if ap.instance.Spec.ExternalAutoscaling != nil {
+		d.Spec.Replicas = ap.instance.Spec.AgentDeployment.Replicas
 	}
 	ap.log.Info("Reconcile Agent Deployment", "msg", fmt.Sprintf("creating a new Kubernetes Deployment %q", d.Name))
 	err = r.Client.Create(ctx, d, &client.CreateOptions{FieldManager: "hcp-terraform-operator"})
@@ -103,8 +110,16 @@ func (r *AgentPoolReconciler) updateDeployment(ctx context.Context, ap *agentPoo
 		nd.Spec.Replicas = d.Spec.Replicas
 	}
 
-	// TODO:
-	// - Add logic to update the deployment only when it has changed
+	if !agentDeploymentNeedsUpdate(nd, d) {
+		ap.log.Info("Reconcile Agent Deployment", "msg", "agent deployment is up to date")
+		return nil
+	}
+	// The agent pool spec has not changed since the last reconciliation, thus the deployment was modified outside of the operator.
+	if ap.instance.Generation == ap.instance.Status.ObservedGeneration && agentDeploymentModified(nd, d) {
+		ap.log.Info("Reconcile Agent Deployment", "msg", fmt.Sprintf("agent deployment %q has drifted, restoring it", d.Name))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AgentDeploymentDrift", "Agent deployment %s was modified outside of the operator, restoring it", d.Name)
+	}
+
 	uerr := r.Client.Update(ctx, nd, &client.UpdateOptions{FieldManager: "hcp-terraform-operator"})
 	if uerr != nil {
 		ap.log.Error(uerr, "Reconcile Agent Deployment", "msg", "Failed to update agent deployment")
@@ -135,7 +150,6 @@ var agentTerminationGracePeriod int64 = 900 // 15 minutes
 
 func agentPoolDeployment(ap *agentPoolInstance) *appsv1.Deployment {
 	r := pointer.PointerOf(int32(1)) // default to one replica if not otherwise configured
-	matchLabels := agentPodMatchLabels(&ap.instance)
 
 	if ap.instance.Spec.AgentDeployment.Replicas != nil {
 		r = ap.instance.Spec.AgentDeployment.Replicas
@@ -144,6 +158,16 @@ func agentPoolDeployment(ap *agentPoolInstance) *appsv1.Deployment {
 	if ap.instance.Spec.AgentDeploymentAutoscaling != nil {
 		r = nil
 	}
+	// don't set the replica count if scaling is delegated to an external autoscaler,
+	// the replicas of the agent deployment are used only as the initial replica count
+	if ap.instance.Spec.ExternalAutoscaling != nil {
+		r = nil
+	}
+	return newAgentDeployment(ap, AgentPoolDeploymentName(&ap.instance), ap.instance.Spec.AgentDeployment, agentPodMatchLabels(&ap.instance), r)
+}
+
+// newAgentDeployment returns the agent Deployment with the given name, pod template settings, selector, and replicas.
+func newAgentDeployment(ap *agentPoolInstance, name string, agentDeployment *appv1alpha2.AgentDeployment, matchLabels map[string]string, r *int32) *appsv1.Deployment {
 	var s corev1.PodSpec = corev1.PodSpec{
 		Containers: []corev1.Container{ // default tfc-agent container if none configured by user
 			{
@@ -152,9 +176,11 @@ func agentPoolDeployment(ap *agentPoolInstance) *appsv1.Deployment {
 			},
 		},
 	}
-	if ap.instance.Spec.AgentDeployment.Spec != nil {
-		s = *ap.instance.Spec.AgentDeployment.Spec
+	if agentDeployment.Spec != nil {
+		s = *agentDeployment.Spec.DeepCopy()
 	}
+	applyAgentConfig(agentDeployment.Config, &s)
+	applyAgentTopologySpread(agentDeployment.TopologySpread, matchLabels, &s)
 	if s.TerminationGracePeriodSeconds == nil {
 		// set a more sensible termination grace period to allow
 		// agents still active to complete their run
@@ -162,7 +188,7 @@ func agentPoolDeployment(ap *agentPoolInstance) *appsv1.Deployment {
 	}
 	d := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      AgentPoolDeploymentName(&ap.instance),
+			Name:      name,
 			Namespace: ap.instance.Namespace,
 			Annotations: map[string]string{
 				poolNameLabel: ap.instance.Name,
@@ -184,18 +210,23 @@ func agentPoolDeployment(ap *agentPoolInstance) *appsv1.Deployment {
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels:      agentPodTemplateLabels(&ap.instance, matchLabels),
-					Annotations: agentPodTemplateAnnotations(&ap.instance),
+					Labels:      agentPodTemplateLabels(agentDeployment, matchLabels),
+					Annotations: agentPodTemplateAnnotations(agentDeployment),
 				},
 				Spec: s,
 			},
 		},
 	}
 	decorateDeployment(ap, d)
+	// Restart agent pods when the agent token they use is rotated.
+	if t := ap.instance.Status.AgentTokens; len(t) > 0 && t[0].RotatedAt != nil {
+		d.Spec.Template.Annotations[agentTokenRotatedAtAnnotation] = strconv.FormatInt(*t[0].RotatedAt, 10)
+	}
 	return d
 }
 
-func decorateDeployment(ap *agentPoolInstance, d *appsv1.Deployment) {
+// agentEnvVars returns environment variables that configure the agent to join the agent pool.
+func agentEnvVars(ap *agentPoolInstance) []corev1.EnvVar {
 	envs := []corev1.EnvVar{
 		{
 			Name: "TFC_AGENT_TOKEN",
@@ -227,6 +258,11 @@ func decorateDeployment(ap *agentPoolInstance, d *appsv1.Deployment) {
 			Value: bURL.String(),
 		})
 	}
+	return envs
+}
+
+func decorateDeployment(ap *agentPoolInstance, d *appsv1.Deployment) {
+	envs := agentEnvVars(ap)
 	// Inject agent specific environment vars to each container in the Deployment.
 	for ci := range d.Spec.Template.Spec.Containers {
 		d.Spec.Template.Spec.Containers[ci].Env = append(d.Spec.Template.Spec.Containers[ci].Env, envs...)
@@ -243,20 +279,20 @@ func agentPodMatchLabels(ap *appv1alpha2.AgentPool) map[string]string {
 	}
 }
 
-func agentPodTemplateLabels(ap *appv1alpha2.AgentPool, matchLabels map[string]string) map[string]string {
+func agentPodTemplateLabels(agentDeployment *appv1alpha2.AgentDeployment, matchLabels map[string]string) map[string]string {
 	labels := map[string]string{}
-	if len(ap.Spec.AgentDeployment.Labels) > 0 {
-		maps.Copy(labels, ap.Spec.AgentDeployment.Labels)
+	if len(agentDeployment.Labels) > 0 {
+		maps.Copy(labels, agentDeployment.Labels)
 	}
 	maps.Copy(labels, matchLabels)
 
 	return labels
 }
 
-func agentPodTemplateAnnotations(ap *appv1alpha2.AgentPool) map[string]string {
+func agentPodTemplateAnnotations(agentDeployment *appv1alpha2.AgentDeployment) map[string]string {
 	annotations := map[string]string{}
-	if len(ap.Spec.AgentDeployment.Annotations) > 0 {
-		for key, value := range ap.Spec.AgentDeployment.Annotations {
+	if len(agentDeployment.Annotations) > 0 {
+		for key, value := range agentDeployment.Annotations {
 			annotations[key] = value
 		}
 	}
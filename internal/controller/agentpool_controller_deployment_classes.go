@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+const (
+	agentClassLabel = "agentpool.app.terraform.io/class"
+)
+
+func AgentPoolClassDeploymentName(ap *appv1alpha2.AgentPool, class string) string {
+	return fmt.Sprintf("agents-of-%s-%s", ap.Name, class)
+}
+
+func agentClassPodMatchLabels(ap *appv1alpha2.AgentPool, class string) map[string]string {
+	return map[string]string{
+		poolNameLabel:   ap.Name,
+		agentClassLabel: class,
+	}
+}
+
+// getClassStatus returns the status of the agent deployment class.
+func (a *agentPoolInstance) getClassStatus(name string) *appv1alpha2.AgentDeploymentClassStatus {
+	for i := range a.instance.Status.AgentDeploymentClasses {
+		if a.instance.Status.AgentDeploymentClasses[i].Name == name {
+			return &a.instance.Status.AgentDeploymentClasses[i]
+		}
+	}
+	return nil
+}
+
+// deleteRemovedClassDeployments deletes Deployments of the agent deployment classes that are no longer in the spec.
+func (r *AgentPoolReconciler) deleteRemovedClassDeployments(ctx context.Context, ap *agentPoolInstance) error {
+	classes := make(map[string]struct{}, len(ap.instance.Spec.AgentDeploymentClasses))
+	for _, c := range ap.instance.Spec.AgentDeploymentClasses {
+		classes[c.Name] = struct{}{}
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	err := r.Client.List(ctx, deployments,
+		client.InNamespace(ap.instance.Namespace),
+		client.MatchingLabels{poolNameLabel: ap.instance.Name},
+		client.HasLabels{agentClassLabel},
+	)
+	if err != nil {
+		return err
+	}
+	for i := range deployments.Items {
+		d := &deployments.Items[i]
+		if _, ok := classes[d.Labels[agentClassLabel]]; ok {
+			continue
+		}
+		if err := r.deleteDeployment(ctx, ap, d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileClassDeployment creates or updates the Deployment of the agent deployment class.
+func (r *AgentPoolReconciler) reconcileClassDeployment(ctx context.Context, ap *agentPoolInstance, class *appv1alpha2.AgentDeploymentClass) error {
+	name := AgentPoolClassDeploymentName(&ap.instance, class.Name)
+	matchLabels := agentClassPodMatchLabels(&ap.instance, class.Name)
+
+	replicas := pointer.PointerOf(int32(1)) // default to one replica if not otherwise configured
+	if class.Replicas != nil {
+		replicas = class.Replicas
+	}
+	if class.Autoscaling != nil {
+		replicas = initialReplicas(class.Autoscaling)
+		if s := ap.getClassStatus(class.Name); s != nil && s.DesiredReplicas != nil {
+			replicas = s.DesiredReplicas
+		}
+	}
+
+	nd := newAgentDeployment(ap, name, &class.AgentDeployment, matchLabels, replicas)
+	nd.Labels = matchLabels
+	if err := controllerutil.SetControllerReference(&ap.instance, nd, r.Scheme); err != nil {
+		return err
+	}
+
+	d := &appsv1.Deployment{}
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: ap.instance.Namespace, Name: name}, d)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+		ap.log.Info("Reconcile Agent Deployment Classes", "msg", fmt.Sprintf("creating a new Kubernetes Deployment %q", name))
+		if err := r.Client.Create(ctx, nd, &client.CreateOptions{FieldManager: "hcp-terraform-operator"}); err != nil {
+			r.Recorder.Event(&ap.instance, corev1.EventTypeWarning, "Deployment creation failed", err.Error())
+			return err
+		}
+		ap.log.Info("Reconcile Agent Deployment Classes", "msg", fmt.Sprintf("successfully created a new Kubernetes Deployment %q", name))
+		return nil
+	}
+
+	if !agentDeploymentNeedsUpdate(nd, d) {
+		return nil
+	}
+	if ap.instance.Generation == ap.instance.Status.ObservedGeneration && agentDeploymentModified(nd, d) {
+		ap.log.Info("Reconcile Agent Deployment Classes", "msg", fmt.Sprintf("agent deployment %q has drifted, restoring it", name))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AgentDeploymentDrift", "Agent deployment %s was modified outside of the operator, restoring it", name)
+	}
+
+	if err := r.Client.Update(ctx, nd, &client.UpdateOptions{FieldManager: "hcp-terraform-operator"}); err != nil {
+		r.Recorder.Event(&ap.instance, corev1.EventTypeWarning, "Deployment update failed", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// reconcileAgentDeploymentClasses creates, updates, and autoscales Deployments of the agent deployment classes.
+// Pending runs are distributed across autoscaled classes, each run is assigned to the first class which target workspaces match it.
+func (r *AgentPoolReconciler) reconcileAgentDeploymentClasses(ctx context.Context, ap *agentPoolInstance) error {
+	ap.log.Info("Reconcile Agent Deployment Classes", "msg", "new reconciliation event")
+
+	if err := r.deleteRemovedClassDeployments(ctx, ap); err != nil {
+		ap.log.Error(err, "Reconcile Agent Deployment Classes", "msg", "failed to delete deployments of removed classes")
+		return err
+	}
+
+	classes := ap.instance.Spec.AgentDeploymentClasses
+	if len(classes) == 0 {
+		ap.instance.Status.AgentDeploymentClasses = nil
+		return nil
+	}
+
+	if len(ap.instance.Status.AgentTokens) < 1 {
+		return fmt.Errorf("not enough tokens available")
+	}
+
+	for i := range classes {
+		if err := r.reconcileClassDeployment(ctx, ap, &classes[i]); err != nil {
+			ap.log.Error(err, "Reconcile Agent Deployment Classes", "msg", fmt.Sprintf("failed to reconcile deployment of class %q", classes[i].Name))
+			return err
+		}
+	}
+
+	// Distribute pending runs across autoscaled classes
+	autoscaled := []int{}
+	targetLists := []*[]appv1alpha2.TargetWorkspace{}
+	for i, c := range classes {
+		if c.Autoscaling != nil {
+			autoscaled = append(autoscaled, i)
+			targetLists = append(targetLists, c.Autoscaling.TargetWorkspaces)
+		}
+	}
+	requiredAgents := make([]int32, len(targetLists))
+	if len(targetLists) > 0 {
+		var err error
+		requiredAgents, err = r.requiredAgentsByTargets(ctx, ap, targetLists)
+		if err != nil {
+			ap.log.Error(err, "Reconcile Agent Deployment Classes", "msg", "Failed to get agents needed")
+			r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AutoscaleAgentPool", "Failed to get agents needed: %v", err.Error())
+			return err
+		}
+	}
+
+	statuses := make([]appv1alpha2.AgentDeploymentClassStatus, len(classes))
+	for i, c := range classes {
+		statuses[i] = appv1alpha2.AgentDeploymentClassStatus{
+			Name:           c.Name,
+			DeploymentName: AgentPoolClassDeploymentName(&ap.instance, c.Name),
+		}
+		if s := ap.getClassStatus(c.Name); s != nil {
+			statuses[i].AgentDeploymentAutoscalingStatus = s.AgentDeploymentAutoscalingStatus
+		}
+		if c.Autoscaling == nil {
+			replicas := pointer.PointerOf(int32(1))
+			if c.Replicas != nil {
+				replicas = c.Replicas
+			}
+			statuses[i].AgentDeploymentAutoscalingStatus = appv1alpha2.AgentDeploymentAutoscalingStatus{
+				DesiredReplicas: replicas,
+			}
+		}
+	}
+
+	var errs error
+	for j, i := range autoscaled {
+		c := classes[i]
+		ap.log.Info("Reconcile Agent Deployment Classes", "msg", fmt.Sprintf("%d agents are required for class %q", requiredAgents[j], c.Name))
+		var status *appv1alpha2.AgentDeploymentAutoscalingStatus
+		if statuses[i].DesiredReplicas != nil {
+			status = &statuses[i].AgentDeploymentAutoscalingStatus
+		}
+		ns, err := r.autoscaleAgentDeployment(ctx, ap, statuses[i].DeploymentName, agentClassPodMatchLabels(&ap.instance, c.Name), c.Autoscaling, status, requiredAgents[j])
+		if err != nil {
+			// Proceed with other classes and report the error once all classes are processed.
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if ns != nil {
+			statuses[i].AgentDeploymentAutoscalingStatus = *ns
+		}
+	}
+
+	ap.instance.Status.AgentDeploymentClasses = statuses
+
+	return errs
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+const (
+	agentDeploymentRolloutProgressing = "Progressing"
+	agentDeploymentRolloutComplete    = "Complete"
+	agentDeploymentRolloutFailed      = "Failed"
+
+	crashLoopBackOffReason = "CrashLoopBackOff"
+)
+
+// agentDeploymentDrifted reports whether the Deployment no longer matches the rendered one.
+// Fields that the API server defaults and that are not set in the rendered Deployment are ignored.
+func agentDeploymentDrifted(nd, d *appsv1.Deployment) bool {
+	return !equality.Semantic.DeepDerivative(nd.Labels, d.Labels) ||
+		!equality.Semantic.DeepDerivative(nd.Annotations, d.Annotations) ||
+		!equality.Semantic.DeepEqual(nd.Spec.Selector, d.Spec.Selector) ||
+		!equality.Semantic.DeepDerivative(nd.Spec.Strategy, d.Spec.Strategy) ||
+		!equality.Semantic.DeepDerivative(nd.Spec.Template, d.Spec.Template)
+}
+
+// agentDeploymentModified reports whether the Deployment was modified outside of the operator.
+// Unlike agentDeploymentDrifted, it ignores the restart of agent pods caused by an agent token rotation.
+func agentDeploymentModified(nd, d *appsv1.Deployment) bool {
+	c := nd.DeepCopy()
+	if v, ok := d.Spec.Template.Annotations[agentTokenRotatedAtAnnotation]; ok {
+		if c.Spec.Template.Annotations == nil {
+			c.Spec.Template.Annotations = map[string]string{}
+		}
+		c.Spec.Template.Annotations[agentTokenRotatedAtAnnotation] = v
+	} else {
+		delete(c.Spec.Template.Annotations, agentTokenRotatedAtAnnotation)
+	}
+	return agentDeploymentDrifted(c, d)
+}
+
+// agentDeploymentNeedsUpdate reports whether the Deployment has to be updated to match the rendered one.
+func agentDeploymentNeedsUpdate(nd, d *appsv1.Deployment) bool {
+	if nd.Spec.Replicas != nil && (d.Spec.Replicas == nil || *nd.Spec.Replicas != *d.Spec.Replicas) {
+		return true
+	}
+	return agentDeploymentDrifted(nd, d)
+}
+
+// agentDeploymentRollout returns the rollout state of the Deployment.
+// It follows the same logic as `kubectl rollout status`.
+func agentDeploymentRollout(d *appsv1.Deployment) string {
+	if d.Generation > d.Status.ObservedGeneration {
+		return agentDeploymentRolloutProgressing
+	}
+	for _, c := range d.Status.Conditions {
+		if c.Type == appsv1.DeploymentProgressing && c.Reason == "ProgressDeadlineExceeded" {
+			return agentDeploymentRolloutFailed
+		}
+	}
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	if d.Status.UpdatedReplicas < replicas ||
+		d.Status.Replicas > d.Status.UpdatedReplicas ||
+		d.Status.AvailableReplicas < d.Status.UpdatedReplicas {
+		return agentDeploymentRolloutProgressing
+	}
+	return agentDeploymentRolloutComplete
+}
+
+// crashLoopingContainer returns the status of the first pod container that is in the CrashLoopBackOff state.
+func crashLoopingContainer(pod *corev1.Pod) *corev1.ContainerStatus {
+	for _, s := range slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses) {
+		if s.State.Waiting != nil && s.State.Waiting.Reason == crashLoopBackOffReason {
+			return &s
+		}
+	}
+	return nil
+}
+
+// reconcileAgentDeploymentStatus records the rollout state and crash-looping pods of the agent deployment in the status.
+// It emits events when the rollout completes or fails, and when an agent pod starts crash-looping.
+func (r *AgentPoolReconciler) reconcileAgentDeploymentStatus(ctx context.Context, ap *agentPoolInstance) error {
+	ap.log.Info("Reconcile Agent Deployment Status", "msg", "new reconciliation event")
+
+	if ap.instance.Spec.AgentDeployment == nil {
+		ap.instance.Status.AgentDeployment = nil
+		return nil
+	}
+
+	d := &appsv1.Deployment{}
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: ap.instance.Namespace, Name: AgentPoolDeploymentName(&ap.instance)}, d)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			ap.instance.Status.AgentDeployment = nil
+			return nil
+		}
+		return err
+	}
+
+	pods := &corev1.PodList{}
+	err = r.Client.List(ctx, pods, client.InNamespace(ap.instance.Namespace), client.MatchingLabels(agentPodMatchLabels(&ap.instance)))
+	if err != nil {
+		return err
+	}
+
+	old := ap.instance.Status.AgentDeployment
+	if old == nil {
+		old = &appv1alpha2.AgentDeploymentStatus{}
+	}
+
+	status := &appv1alpha2.AgentDeploymentStatus{
+		Replicas:          d.Status.Replicas,
+		UpdatedReplicas:   d.Status.UpdatedReplicas,
+		ReadyReplicas:     d.Status.ReadyReplicas,
+		AvailableReplicas: d.Status.AvailableReplicas,
+		Rollout:           agentDeploymentRollout(d),
+		LastUpdateTime:    old.LastUpdateTime,
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		c := crashLoopingContainer(pod)
+		if c == nil {
+			continue
+		}
+		status.CrashLoopingPods = append(status.CrashLoopingPods, pod.Name)
+		if slices.Contains(old.CrashLoopingPods, pod.Name) {
+			continue
+		}
+		reason := c.State.Waiting.Message
+		if t := c.LastTerminationState.Terminated; t != nil {
+			reason = fmt.Sprintf("exit code %d: %s", t.ExitCode, t.Reason)
+		}
+		ap.log.Info("Reconcile Agent Deployment Status", "msg", fmt.Sprintf("agent pod %s container %s is crash-looping: %s", pod.Name, c.Name, reason))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AgentPodCrashLoop", "Agent pod %s container %s is crash-looping: %s", pod.Name, c.Name, reason)
+	}
+	slices.Sort(status.CrashLoopingPods)
+
+	if status.Rollout != old.Rollout {
+		switch status.Rollout {
+		case agentDeploymentRolloutComplete:
+			r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "AgentDeploymentRollout", "Agent deployment %s rollout is complete", d.Name)
+		case agentDeploymentRolloutFailed:
+			r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "AgentDeploymentRollout", "Agent deployment %s rollout exceeded its progress deadline", d.Name)
+		}
+	}
+
+	// Bump the update time only when the status has changed to avoid unnecessary status updates.
+	if !agentDeploymentStatusEqual(status, old) || status.LastUpdateTime == nil {
+		status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+	}
+	ap.instance.Status.AgentDeployment = status
+
+	return nil
+}
+
+// agentDeploymentStatusEqual reports whether the statuses are equal, regardless of their update time.
+func agentDeploymentStatusEqual(a, b *appv1alpha2.AgentDeploymentStatus) bool {
+	x, y := *a, *b
+	x.LastUpdateTime, y.LastUpdateTime = nil, nil
+	return equality.Semantic.DeepEqual(x, y)
+}
+
+// agentPodToAgentPool maps an agent pod to the AgentPool object that manages it.
+func agentPodToAgentPool(_ context.Context, o client.Object) []reconcile.Request {
+	name, ok := o.GetLabels()[poolNameLabel]
+	if !ok || name == "" {
+		return nil
+	}
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Namespace: o.GetNamespace(),
+				Name:      name,
+			},
+		},
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+func TestAgentDeploymentRollout(t *testing.T) {
+	cases := map[string]struct {
+		deployment *appsv1.Deployment
+		expected   string
+	}{
+		"Complete": {
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.PointerOf(int32(2))},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+			expected: agentDeploymentRolloutComplete,
+		},
+		"NotObserved": {
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 3},
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.PointerOf(int32(2))},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+			expected: agentDeploymentRolloutProgressing,
+		},
+		"OldReplicas": {
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.PointerOf(int32(2))},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 1, AvailableReplicas: 2},
+			},
+			expected: agentDeploymentRolloutProgressing,
+		},
+		"Unavailable": {
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.PointerOf(int32(2))},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 1},
+			},
+			expected: agentDeploymentRolloutProgressing,
+		},
+		"ProgressDeadlineExceeded": {
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.PointerOf(int32(2))},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 2,
+					Replicas:           2,
+					UpdatedReplicas:    1,
+					Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+					},
+				},
+			},
+			expected: agentDeploymentRolloutFailed,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.expected, agentDeploymentRollout(c.deployment))
+		})
+	}
+}
+
+func TestCrashLoopingContainer(t *testing.T) {
+	crashLooping := corev1.ContainerStatus{
+		Name:  DefaultAgentContainerName,
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: crashLoopBackOffReason}},
+	}
+	running := corev1.ContainerStatus{
+		Name:  DefaultAgentContainerName,
+		State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+	}
+
+	assert.Nil(t, crashLoopingContainer(&corev1.Pod{}))
+	assert.Nil(t, crashLoopingContainer(&corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{running}}}))
+	assert.Equal(t, DefaultAgentContainerName, crashLoopingContainer(&corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{crashLooping}}}).Name)
+	assert.Equal(t, DefaultAgentContainerName, crashLoopingContainer(&corev1.Pod{Status: corev1.PodStatus{InitContainerStatuses: []corev1.ContainerStatus{crashLooping}}}).Name)
+}
+
+func TestAgentDeploymentNeedsUpdate(t *testing.T) {
+	rendered := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{poolNameLabel: "this"},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: pointer.PointerOf(int32(2)),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{poolNameLabel: "this"}},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: DefaultAgentContainerName, Image: DefaultAgentImage}},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("ServerDefaults", func(t *testing.T) {
+		d := rendered()
+		d.Spec.Template.Spec.Containers[0].ImagePullPolicy = corev1.PullAlways
+		d.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyAlways
+		d.Annotations["deployment.kubernetes.io/revision"] = "1"
+		assert.False(t, agentDeploymentNeedsUpdate(rendered(), d))
+	})
+
+	t.Run("Replicas", func(t *testing.T) {
+		d := rendered()
+		d.Spec.Replicas = pointer.PointerOf(int32(5))
+		assert.True(t, agentDeploymentNeedsUpdate(rendered(), d))
+		assert.False(t, agentDeploymentDrifted(rendered(), d))
+
+		nd := rendered()
+		nd.Spec.Replicas = nil
+		assert.False(t, agentDeploymentNeedsUpdate(nd, d))
+	})
+
+	t.Run("AgentTokenRotation", func(t *testing.T) {
+		nd := rendered()
+		nd.Spec.Template.Annotations = map[string]string{agentTokenRotatedAtAnnotation: "1700000000"}
+		assert.True(t, agentDeploymentNeedsUpdate(nd, rendered()))
+		assert.False(t, agentDeploymentModified(nd, rendered()))
+	})
+
+	t.Run("TemplateDrift", func(t *testing.T) {
+		d := rendered()
+		d.Spec.Template.Spec.Containers[0].Image = "hashicorp/tfc-agent:1.0.0"
+		assert.True(t, agentDeploymentNeedsUpdate(rendered(), d))
+		assert.True(t, agentDeploymentDrifted(rendered(), d))
+	})
+}
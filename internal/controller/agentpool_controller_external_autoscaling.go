@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// agentPoolMetricLabels returns the labels that identify the agent pool metrics of the AgentPool object.
+func agentPoolMetricLabels(ap *appv1alpha2.AgentPool) prometheus.Labels {
+	return prometheus.Labels{
+		"namespace": ap.Namespace,
+		"name":      ap.Name,
+	}
+}
+
+// deleteAgentPoolMetrics removes the agent pool metrics of the AgentPool object.
+func deleteAgentPoolMetrics(ap *appv1alpha2.AgentPool) {
+	MetricAgentPoolPendingRuns.DeletePartialMatch(agentPoolMetricLabels(ap))
+}
+
+// reconcileAgentExternalAutoscaling publishes the number of pending runs of the target workspaces
+// for an external autoscaler, such as KEDA or HorizontalPodAutoscaler. The operator does not scale the agent deployment itself.
+func (r *AgentPoolReconciler) reconcileAgentExternalAutoscaling(ctx context.Context, ap *agentPoolInstance) error {
+	if ap.instance.Spec.ExternalAutoscaling == nil {
+		if ap.instance.Status.ExternalAutoscaling != nil {
+			ap.log.Info("Reconcile Agent External Autoscaling", "msg", "external autoscaling is not configured, removing status and metrics")
+			ap.instance.Status.ExternalAutoscaling = nil
+			deleteAgentPoolMetrics(&ap.instance)
+		}
+		return nil
+	}
+
+	ap.log.Info("Reconcile Agent External Autoscaling", "msg", "new reconciliation event")
+
+	pendingRuns, err := r.requiredAgents(ctx, ap, ap.instance.Spec.ExternalAutoscaling.TargetWorkspaces)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agent External Autoscaling", "msg", "Failed to get pending runs")
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentExternalAutoscaling", "Failed to get pending runs: %v", err.Error())
+		return err
+	}
+	ap.log.Info("Reconcile Agent External Autoscaling", "msg", fmt.Sprintf("%d runs are pending", pendingRuns))
+
+	ap.instance.Status.ExternalAutoscaling = &appv1alpha2.AgentExternalAutoscalingStatus{
+		PendingRuns: pendingRuns,
+		LastUpdateTime: &metav1.Time{
+			Time: time.Now(),
+		},
+	}
+
+	// The agent pool ID and name might change, remove stale series before setting the new value.
+	deleteAgentPoolMetrics(&ap.instance)
+	MetricAgentPoolPendingRuns.WithLabelValues(
+		ap.instance.Namespace,
+		ap.instance.Name,
+		ap.instance.Status.AgentPoolID,
+		ap.instance.Spec.Name,
+	).Set(float64(pendingRuns))
+
+	return nil
+}
@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+const (
+	agentJobLabel = "agentpool.app.terraform.io/agent-job"
+	// defaultMaxAgentJobs is the maximum number of agent Jobs when `spec.agentJobs.maxJobs` is not set.
+	defaultMaxAgentJobs int32 = 1
+)
+
+// agentJobLabels returns labels that are used to select agent Jobs of the agent pool.
+func agentJobLabels(ap *appv1alpha2.AgentPool) map[string]string {
+	return map[string]string{
+		poolNameLabel: ap.Name,
+		agentJobLabel: "true",
+	}
+}
+
+// jobFinished returns true if the Job has completed or failed.
+func jobFinished(job *batchv1.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *AgentPoolReconciler) listAgentJobs(ctx context.Context, ap *agentPoolInstance) ([]batchv1.Job, error) {
+	jobs := &batchv1.JobList{}
+	err := r.Client.List(ctx, jobs, client.InNamespace(ap.instance.Namespace), client.MatchingLabels(agentJobLabels(&ap.instance)))
+	if err != nil {
+		return nil, err
+	}
+	return jobs.Items, nil
+}
+
+// activeAgentJobs returns the number of agent Jobs that are not finished yet.
+func activeAgentJobs(jobs []batchv1.Job) int32 {
+	var n int32 = 0
+	for i := range jobs {
+		if !jobFinished(&jobs[i]) {
+			n++
+		}
+	}
+	return n
+}
+
+// desiredAgentJobs returns the number of agent Jobs needed to process the required agents within the `minJobs` and `maxJobs` bounds.
+// If `maxJobs` is not set, it is not lower than `minJobs` and defaults to `defaultMaxAgentJobs`.
+func desiredAgentJobs(spec *appv1alpha2.AgentJobs, requiredAgents int32) int32 {
+	var minJobs int32 = 0
+	if spec.MinJobs != nil {
+		minJobs = *spec.MinJobs
+	}
+	maxJobs := max(minJobs, defaultMaxAgentJobs)
+	if spec.MaxJobs != nil {
+		maxJobs = *spec.MaxJobs
+	}
+	return computeDesiredReplicas(requiredAgents, minJobs, maxJobs)
+}
+
+func (r *AgentPoolReconciler) deleteAgentJobs(ctx context.Context, ap *agentPoolInstance) error {
+	return r.Client.DeleteAllOf(ctx, &batchv1.Job{},
+		client.InNamespace(ap.instance.Namespace),
+		client.MatchingLabels(agentJobLabels(&ap.instance)),
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+	)
+}
+
+func agentPoolJob(ap *agentPoolInstance) *batchv1.Job {
+	spec := ap.instance.Spec.AgentJobs
+	labels := agentJobLabels(&ap.instance)
+
+	var s corev1.PodSpec = corev1.PodSpec{
+		Containers: []corev1.Container{ // default tfc-agent container if none configured by user
+			{
+				Name:  DefaultAgentContainerName,
+				Image: DefaultAgentImage,
+			},
+		},
+	}
+	if spec.Spec != nil {
+		s = *spec.Spec.DeepCopy()
+	}
+	if s.TerminationGracePeriodSeconds == nil {
+		// set a more sensible termination grace period to allow
+		// agents still active to complete their run
+		s.TerminationGracePeriodSeconds = &agentTerminationGracePeriod
+	}
+	// The agent exits once the run is completed, the pod must not be restarted.
+	s.RestartPolicy = corev1.RestartPolicyNever
+
+	podLabels := map[string]string{}
+	maps.Copy(podLabels, spec.Labels)
+	maps.Copy(podLabels, labels)
+
+	podAnnotations := map[string]string{}
+	maps.Copy(podAnnotations, spec.Annotations)
+
+	envs := append(agentEnvVars(ap), corev1.EnvVar{
+		Name:  "TFC_AGENT_SINGLE",
+		Value: "true",
+	})
+	// Inject agent specific environment vars to each container in the Job.
+	for ci := range s.Containers {
+		s.Containers[ci].Env = append(s.Containers[ci].Env, envs...)
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-", AgentPoolDeploymentName(&ap.instance)),
+			Namespace:    ap.instance.Namespace,
+			Labels:       labels,
+			Annotations: map[string]string{
+				poolNameLabel: ap.instance.Name,
+				poolIDLabel:   ap.instance.Status.AgentPoolID,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			// The run fails in HCP Terraform if the agent fails, there is no reason to retry the pod.
+			BackoffLimit:            pointer.PointerOf(int32(0)),
+			TTLSecondsAfterFinished: spec.TTLSecondsAfterFinished,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: podAnnotations,
+				},
+				Spec: s,
+			},
+		},
+	}
+}
+
+func (r *AgentPoolReconciler) createAgentJob(ctx context.Context, ap *agentPoolInstance) (*batchv1.Job, error) {
+	j := agentPoolJob(ap)
+	if err := controllerutil.SetControllerReference(&ap.instance, j, r.Scheme); err != nil {
+		return nil, err
+	}
+	if err := r.Client.Create(ctx, j, &client.CreateOptions{FieldManager: "hcp-terraform-operator"}); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (r *AgentPoolReconciler) reconcileAgentJobs(ctx context.Context, ap *agentPoolInstance) error {
+	if ap.instance.Spec.AgentJobs == nil {
+		// Clean up agent Jobs if they were previously configured
+		if ap.instance.Status.AgentJobsStatus != nil {
+			ap.log.Info("Reconcile Agent Jobs", "msg", "agent jobs are not configured, deleting existing jobs")
+			if err := r.deleteAgentJobs(ctx, ap); err != nil {
+				return err
+			}
+			ap.instance.Status.AgentJobsStatus = nil
+		}
+		return nil
+	}
+
+	ap.log.Info("Reconcile Agent Jobs", "msg", "new reconciliation event")
+
+	if len(ap.instance.Status.AgentTokens) < 1 {
+		return fmt.Errorf("not enough tokens available")
+	}
+
+	jobs, err := r.listAgentJobs(ctx, ap)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agent Jobs", "msg", "Failed to list agent jobs")
+		return err
+	}
+	activeJobs := activeAgentJobs(jobs)
+	ap.log.Info("Reconcile Agent Jobs", "msg", fmt.Sprintf("%d agent jobs are running", activeJobs))
+
+	requiredAgents, err := r.requiredAgents(ctx, ap, nil)
+	if err != nil {
+		ap.log.Error(err, "Reconcile Agent Jobs", "msg", "Failed to get agents needed")
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentJobs", "Failed to get agents needed: %v", err.Error())
+		return err
+	}
+	ap.log.Info("Reconcile Agent Jobs", "msg", fmt.Sprintf("%d agents are required", requiredAgents))
+
+	desiredJobs := desiredAgentJobs(ap.instance.Spec.AgentJobs, requiredAgents)
+
+	if ap.instance.Status.AgentJobsStatus == nil {
+		ap.instance.Status.AgentJobsStatus = &appv1alpha2.AgentJobsStatus{}
+	}
+	status := ap.instance.Status.AgentJobsStatus
+
+	// Running Jobs are never deleted, each of them exits once it completes a run.
+	if n := desiredJobs - activeJobs; n > 0 {
+		ap.log.Info("Reconcile Agent Jobs", "msg", fmt.Sprintf("launching %d agent jobs", n))
+		for range n {
+			j, err := r.createAgentJob(ctx, ap)
+			if err != nil {
+				ap.log.Error(err, "Reconcile Agent Jobs", "msg", "Failed to create agent job")
+				r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentJobs", "Failed to create agent job: %v", err.Error())
+				status.ActiveJobs = activeJobs
+				status.DesiredJobs = desiredJobs
+				return err
+			}
+			activeJobs++
+			status.LastJobCreated = &metav1.Time{Time: time.Now()}
+			ap.log.Info("Reconcile Agent Jobs", "msg", fmt.Sprintf("successfully created agent job %q", j.Name))
+		}
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "ReconcileAgentJobs", "Launched %d agent jobs", n)
+	}
+
+	status.ActiveJobs = activeJobs
+	status.DesiredJobs = desiredJobs
+
+	return nil
+}
@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-tfe/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+// newTestTFClient returns an HCP Terraform client that is connected to a test server that only answers the ping request.
+func newTestTFClient(t *testing.T) *tfc.Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("TFP-AppName", "HCP Terraform")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := tfc.NewClient(&tfc.Config{Address: srv.URL, Token: "token"})
+	require.NoError(t, err)
+
+	return c
+}
+
+func newTestAgentPoolWithJobs(agentJobs *appv1alpha2.AgentJobs) appv1alpha2.AgentPool {
+	return appv1alpha2.AgentPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "this",
+			Namespace: "default",
+			UID:       "agent-pool-uid",
+		},
+		Spec: appv1alpha2.AgentPoolSpec{
+			Name:         "this",
+			Organization: "kubernetes-operator",
+			AgentJobs:    agentJobs,
+		},
+		Status: appv1alpha2.AgentPoolStatus{
+			AgentPoolID: "apool-this",
+			AgentTokens: []*appv1alpha2.AgentAPIToken{{Name: "token", ID: "at-this"}},
+		},
+	}
+}
+
+func newTestAgentJob(name string, conditions ...batchv1.JobCondition) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				poolNameLabel: "this",
+				agentJobLabel: "true",
+			},
+		},
+		Status: batchv1.JobStatus{Conditions: conditions},
+	}
+}
+
+func TestDesiredAgentJobs(t *testing.T) {
+	cases := map[string]struct {
+		spec           *appv1alpha2.AgentJobs
+		requiredAgents int32
+		want           int32
+	}{
+		"NoPendingRuns": {
+			spec:           &appv1alpha2.AgentJobs{MaxJobs: pointer.PointerOf(int32(5))},
+			requiredAgents: 0,
+			want:           0,
+		},
+		"WithinBounds": {
+			spec:           &appv1alpha2.AgentJobs{MaxJobs: pointer.PointerOf(int32(5)), MinJobs: pointer.PointerOf(int32(1))},
+			requiredAgents: 3,
+			want:           3,
+		},
+		"MinJobs": {
+			spec:           &appv1alpha2.AgentJobs{MaxJobs: pointer.PointerOf(int32(5)), MinJobs: pointer.PointerOf(int32(2))},
+			requiredAgents: 1,
+			want:           2,
+		},
+		"MaxJobs": {
+			spec:           &appv1alpha2.AgentJobs{MaxJobs: pointer.PointerOf(int32(5))},
+			requiredAgents: 10,
+			want:           5,
+		},
+		"NoMaxJobs": {
+			spec:           &appv1alpha2.AgentJobs{},
+			requiredAgents: 10,
+			want:           defaultMaxAgentJobs,
+		},
+		"NoMaxJobsWithMinJobs": {
+			spec:           &appv1alpha2.AgentJobs{MinJobs: pointer.PointerOf(int32(3))},
+			requiredAgents: 10,
+			want:           3,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.want, desiredAgentJobs(c.spec, c.requiredAgents))
+		})
+	}
+}
+
+func TestAgentPoolJob(t *testing.T) {
+	tfClient := newTestTFClient(t)
+
+	cases := map[string]struct {
+		spec           *appv1alpha2.AgentJobs
+		wantContainers []string
+		wantTTL        *int32
+	}{
+		"DefaultContainer": {
+			spec:           &appv1alpha2.AgentJobs{MaxJobs: pointer.PointerOf(int32(1))},
+			wantContainers: []string{DefaultAgentContainerName},
+		},
+		"CustomSpec": {
+			spec: &appv1alpha2.AgentJobs{
+				MaxJobs:                 pointer.PointerOf(int32(1)),
+				TTLSecondsAfterFinished: pointer.PointerOf(int32(600)),
+				Labels:                  map[string]string{"team": "platform"},
+				Annotations:             map[string]string{"owner": "platform"},
+				Spec: &corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "agent", Image: "hashicorp/tfc-agent:latest"},
+						{Name: "sidecar", Image: "busybox"},
+					},
+				},
+			},
+			wantContainers: []string{"agent", "sidecar"},
+			wantTTL:        pointer.PointerOf(int32(600)),
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			ap := &agentPoolInstance{
+				instance: newTestAgentPoolWithJobs(c.spec),
+				tfClient: HCPTerraformClient{Client: tfClient},
+				log:      logr.Discard(),
+			}
+
+			j := agentPoolJob(ap)
+
+			assert.Equal(t, "agents-of-this-", j.GenerateName)
+			assert.Equal(t, agentJobLabels(&ap.instance), j.Labels)
+			assert.Equal(t, "apool-this", j.Annotations[poolIDLabel])
+			assert.Equal(t, int32(0), *j.Spec.BackoffLimit)
+			assert.Equal(t, c.wantTTL, j.Spec.TTLSecondsAfterFinished)
+
+			pod := j.Spec.Template
+			assert.Equal(t, corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+			assert.Equal(t, agentTerminationGracePeriod, *pod.Spec.TerminationGracePeriodSeconds)
+			for k, v := range agentJobLabels(&ap.instance) {
+				assert.Equal(t, v, pod.Labels[k])
+			}
+			for k, v := range c.spec.Labels {
+				assert.Equal(t, v, pod.Labels[k])
+			}
+			assert.Len(t, pod.Annotations, len(c.spec.Annotations))
+			for k, v := range c.spec.Annotations {
+				assert.Equal(t, v, pod.Annotations[k])
+			}
+
+			var containers []string
+			for _, container := range pod.Spec.Containers {
+				containers = append(containers, container.Name)
+				assert.Contains(t, container.Env, corev1.EnvVar{Name: "TFC_AGENT_SINGLE", Value: "true"}, "container %s", container.Name)
+				assert.Contains(t, container.Env, corev1.EnvVar{Name: "TFC_AGENT_AUTO_UPDATE", Value: "disabled"}, "container %s", container.Name)
+			}
+			assert.Equal(t, c.wantContainers, containers)
+		})
+	}
+}
+
+func TestReconcileAgentJobs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, appv1alpha2.AddToScheme(scheme))
+
+	tfClient := newTestTFClient(t)
+	finished := batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}
+
+	cases := map[string]struct {
+		spec        *appv1alpha2.AgentJobs
+		status      *appv1alpha2.AgentJobsStatus
+		jobs        []client.Object
+		pendingRuns int
+		wantJobs    int
+		wantStatus  *appv1alpha2.AgentJobsStatus
+	}{
+		"LaunchJobsForPendingRuns": {
+			spec:        &appv1alpha2.AgentJobs{MaxJobs: pointer.PointerOf(int32(5))},
+			pendingRuns: 3,
+			wantJobs:    3,
+			wantStatus:  &appv1alpha2.AgentJobsStatus{ActiveJobs: 3, DesiredJobs: 3},
+		},
+		"LaunchUpToMaxJobs": {
+			spec:        &appv1alpha2.AgentJobs{MaxJobs: pointer.PointerOf(int32(2))},
+			pendingRuns: 4,
+			wantJobs:    2,
+			wantStatus:  &appv1alpha2.AgentJobsStatus{ActiveJobs: 2, DesiredJobs: 2},
+		},
+		"KeepMinJobs": {
+			spec:        &appv1alpha2.AgentJobs{MaxJobs: pointer.PointerOf(int32(5)), MinJobs: pointer.PointerOf(int32(2))},
+			pendingRuns: 0,
+			wantJobs:    2,
+			wantStatus:  &appv1alpha2.AgentJobsStatus{ActiveJobs: 2, DesiredJobs: 2},
+		},
+		"NoMaxJobs": {
+			spec:        &appv1alpha2.AgentJobs{},
+			pendingRuns: 3,
+			wantJobs:    int(defaultMaxAgentJobs),
+			wantStatus:  &appv1alpha2.AgentJobsStatus{ActiveJobs: defaultMaxAgentJobs, DesiredJobs: defaultMaxAgentJobs},
+		},
+		"CountOnlyActiveJobs": {
+			spec:        &appv1alpha2.AgentJobs{MaxJobs: pointer.PointerOf(int32(5))},
+			jobs:        []client.Object{newTestAgentJob("active"), newTestAgentJob("finished", finished)},
+			pendingRuns: 2,
+			wantJobs:    3,
+			wantStatus:  &appv1alpha2.AgentJobsStatus{ActiveJobs: 2, DesiredJobs: 2},
+		},
+		"DoNotDeleteRunningJobs": {
+			spec:        &appv1alpha2.AgentJobs{MaxJobs: pointer.PointerOf(int32(5))},
+			jobs:        []client.Object{newTestAgentJob("first"), newTestAgentJob("second")},
+			pendingRuns: 0,
+			wantJobs:    2,
+			wantStatus:  &appv1alpha2.AgentJobsStatus{ActiveJobs: 2, DesiredJobs: 0},
+		},
+		"CleanUpJobs": {
+			spec:       nil,
+			status:     &appv1alpha2.AgentJobsStatus{ActiveJobs: 2, DesiredJobs: 2},
+			jobs:       []client.Object{newTestAgentJob("first"), newTestAgentJob("second", finished)},
+			wantJobs:   0,
+			wantStatus: nil,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			ctx := context.Background()
+
+			mockCtrl := gomock.NewController(t)
+			mockRuns := mocks.NewMockRuns(mockCtrl)
+			runs := make([]*tfc.Run, c.pendingRuns)
+			for i := range runs {
+				runs[i] = &tfc.Run{ID: fmt.Sprintf("run-%d", i), PlanOnly: true, Status: tfc.RunPending, Workspace: &tfc.Workspace{ID: "ws-this"}}
+			}
+			mockRuns.EXPECT().
+				ListForOrganization(gomock.Any(), "kubernetes-operator", gomock.Any()).
+				Return(&tfc.OrganizationRunList{Items: runs, PaginationNextPrev: &tfc.PaginationNextPrev{}}, nil).
+				AnyTimes()
+			tfClient.Runs = mockRuns
+
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(c.jobs...).Build()
+			r := &AgentPoolReconciler{
+				Client:   k8sClient,
+				Recorder: record.NewFakeRecorder(10),
+				Scheme:   scheme,
+			}
+			ap := &agentPoolInstance{
+				instance: newTestAgentPoolWithJobs(c.spec),
+				tfClient: HCPTerraformClient{Client: tfClient},
+				log:      logr.Discard(),
+			}
+			ap.instance.Status.AgentJobsStatus = c.status
+
+			require.NoError(t, r.reconcileAgentJobs(ctx, ap))
+
+			jobs := &batchv1.JobList{}
+			require.NoError(t, k8sClient.List(ctx, jobs, client.MatchingLabels(agentJobLabels(&ap.instance))))
+			assert.Len(t, jobs.Items, c.wantJobs)
+
+			status := ap.instance.Status.AgentJobsStatus
+			if c.wantStatus == nil {
+				assert.Nil(t, status)
+				return
+			}
+			require.NotNil(t, status)
+			assert.Equal(t, c.wantStatus.ActiveJobs, status.ActiveJobs)
+			assert.Equal(t, c.wantStatus.DesiredJobs, status.DesiredJobs)
+		})
+	}
+}
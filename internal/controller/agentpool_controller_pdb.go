@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+const (
+	defaultAgentTopologyKey = "kubernetes.io/hostname"
+	// agentBusyLabel marks pods with busy agents.
+	// They are covered by a dedicated PodDisruptionBudget that does not allow evictions.
+	agentBusyLabel = "agentpool.app.terraform.io/agent-busy"
+)
+
+// applyAgentTopologySpread adds a topology spread constraint that selects the agent pods of the deployment,
+// unless the pod spec already has topology spread constraints.
+func applyAgentTopologySpread(t *appv1alpha2.AgentTopologySpread, matchLabels map[string]string, s *corev1.PodSpec) {
+	if t == nil || len(s.TopologySpreadConstraints) > 0 {
+		return
+	}
+	c := corev1.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       defaultAgentTopologyKey,
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: matchLabels,
+		},
+		// Spread pods of the same revision only, so that rolling updates do not skew the spread.
+		MatchLabelKeys: []string{"pod-template-hash"},
+	}
+	if t.MaxSkew > 0 {
+		c.MaxSkew = t.MaxSkew
+	}
+	if t.TopologyKey != "" {
+		c.TopologyKey = t.TopologyKey
+	}
+	if t.WhenUnsatisfiable != "" {
+		c.WhenUnsatisfiable = t.WhenUnsatisfiable
+	}
+	s.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{c}
+}
+
+// agentPDBMinAvailable returns the minimum number of available pods with agents that are not busy.
+// It is the number of replicas minus the number of busy agents and the maximum number of unavailable pods, but not lower than 0.
+func agentPDBMinAvailable(replicas, maxUnavailable, busyAgents int32) int32 {
+	return max(replicas-busyAgents-maxUnavailable, 0)
+}
+
+// agentBusyPDBName returns the name of the PodDisruptionBudget that covers pods with busy agents.
+func agentBusyPDBName(name string) string {
+	return fmt.Sprintf("%s-busy", name)
+}
+
+// setAgentPodBusyLabel adds the busy label to the pod if its agent is busy and removes it otherwise.
+// It returns true if the pod labels have changed.
+func setAgentPodBusyLabel(pod *corev1.Pod, busy bool) bool {
+	_, ok := pod.Labels[agentBusyLabel]
+	if busy == ok {
+		return false
+	}
+	if !busy {
+		delete(pod.Labels, agentBusyLabel)
+		return true
+	}
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels[agentBusyLabel] = "true"
+	return true
+}
+
+// agentStatusesByPod returns the status of the agents recorded in the status by pod name.
+// An agent may register multiple times from the same pod, in this case, the `busy` status takes precedence over others.
+func agentStatusesByPod(agents *appv1alpha2.AgentsStatus) map[string]string {
+	statuses := map[string]string{}
+	if agents == nil {
+		return statuses
+	}
+	for _, a := range agents.Agents {
+		if a.PodName == "" || statuses[a.PodName] == agentStatusBusy {
+			continue
+		}
+		statuses[a.PodName] = a.Status
+	}
+
+	return statuses
+}
+
+// agentPDBs returns the PodDisruptionBudget specs of the agent deployment by name.
+// Pods with busy agents are labeled and covered by a dedicated PodDisruptionBudget with `maxUnavailable` set to 0,
+// the other pods are covered by a PodDisruptionBudget that allows `maxUnavailable` of them to be evicted.
+// The selectors do not overlap since the eviction API rejects pods that match multiple PodDisruptionBudgets.
+func agentPDBs(name string, matchLabels map[string]string, minAvailable int32) map[string]policyv1.PodDisruptionBudgetSpec {
+	busyMatchLabels := map[string]string{agentBusyLabel: "true"}
+	maps.Copy(busyMatchLabels, matchLabels)
+
+	return map[string]policyv1.PodDisruptionBudgetSpec{
+		name: {
+			MinAvailable: pointer.PointerOf(intstr.FromInt32(minAvailable)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: matchLabels,
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      agentBusyLabel,
+						Operator: metav1.LabelSelectorOpDoesNotExist,
+					},
+				},
+			},
+			// Do not block node drains because of agent pods that are not ready.
+			UnhealthyPodEvictionPolicy: pointer.PointerOf(policyv1.AlwaysAllow),
+		},
+		agentBusyPDBName(name): {
+			MaxUnavailable: pointer.PointerOf(intstr.FromInt32(0)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: busyMatchLabels,
+			},
+		},
+	}
+}
+
+// reconcileAgentPDB creates or updates the PodDisruptionBudgets of the agent deployment.
+// It labels pods with busy agents based on the agents recorded in the status.
+func (r *AgentPoolReconciler) reconcileAgentPDB(ctx context.Context, ap *agentPoolInstance, name string, pdb *appv1alpha2.AgentPodDisruptionBudget, matchLabels map[string]string) error {
+	replicas, err := r.getAgentDeploymentReplicas(ctx, ap, name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			ap.log.Info("Reconcile Agent PodDisruptionBudgets", "msg", fmt.Sprintf("agent deployment %s does not exist yet, skipping", name))
+			return nil
+		}
+		return err
+	}
+
+	busyAgents, err := r.markAgentPodsByStatus(ctx, ap, matchLabels, agentStatusesByPod(ap.instance.Status.Agents))
+	if err != nil {
+		return err
+	}
+
+	var maxUnavailable int32 = 1
+	if pdb.MaxUnavailable != nil {
+		maxUnavailable = *pdb.MaxUnavailable
+	}
+	minAvailable := agentPDBMinAvailable(replicas, maxUnavailable, busyAgents)
+	ap.log.Info("Reconcile Agent PodDisruptionBudgets", "msg", fmt.Sprintf("%d agents are busy, %d agents without a run must be available", busyAgents, minAvailable))
+
+	var errs []error
+	for n, spec := range agentPDBs(name, matchLabels, minAvailable) {
+		errs = append(errs, r.applyAgentPDB(ctx, ap, n, spec))
+	}
+
+	return errors.Join(errs...)
+}
+
+// applyAgentPDB creates or updates the PodDisruptionBudget of the agent pool.
+func (r *AgentPoolReconciler) applyAgentPDB(ctx context.Context, ap *agentPoolInstance, name string, spec policyv1.PodDisruptionBudgetSpec) error {
+	np := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ap.instance.Namespace,
+			Labels: map[string]string{
+				poolNameLabel: ap.instance.Name,
+			},
+		},
+		Spec: spec,
+	}
+	if err := controllerutil.SetControllerReference(&ap.instance, np, r.Scheme); err != nil {
+		return err
+	}
+
+	p := &policyv1.PodDisruptionBudget{}
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: ap.instance.Namespace, Name: name}, p)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+		ap.log.Info("Reconcile Agent PodDisruptionBudgets", "msg", fmt.Sprintf("creating PodDisruptionBudget %s", name))
+		return r.Client.Create(ctx, np, &client.CreateOptions{FieldManager: "hcp-terraform-operator"})
+	}
+
+	if equality.Semantic.DeepEqual(p.Spec, np.Spec) {
+		return nil
+	}
+	ap.log.Info("Reconcile Agent PodDisruptionBudgets", "msg", fmt.Sprintf("updating PodDisruptionBudget %s", name))
+	p.Labels = np.Labels
+	p.Spec = np.Spec
+	return r.Client.Update(ctx, p, &client.UpdateOptions{FieldManager: "hcp-terraform-operator"})
+}
+
+// deleteStaleAgentPDBs deletes PodDisruptionBudgets of the agent pool that are no longer configured.
+func (r *AgentPoolReconciler) deleteStaleAgentPDBs(ctx context.Context, ap *agentPoolInstance, keep map[string]struct{}) error {
+	pdbs := &policyv1.PodDisruptionBudgetList{}
+	err := r.Client.List(ctx, pdbs, client.InNamespace(ap.instance.Namespace), client.MatchingLabels{poolNameLabel: ap.instance.Name})
+	if err != nil {
+		return err
+	}
+	for i := range pdbs.Items {
+		p := &pdbs.Items[i]
+		if _, ok := keep[p.Name]; ok {
+			continue
+		}
+		if !metav1.IsControlledBy(p, &ap.instance) {
+			continue
+		}
+		ap.log.Info("Reconcile Agent PodDisruptionBudgets", "msg", fmt.Sprintf("deleting PodDisruptionBudget %s", p.Name))
+		if err := r.Client.Delete(ctx, p); err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileAgentPDBs reconciles PodDisruptionBudgets of the agent deployment and agent deployment classes.
+func (r *AgentPoolReconciler) reconcileAgentPDBs(ctx context.Context, ap *agentPoolInstance) error {
+	ap.log.Info("Reconcile Agent PodDisruptionBudgets", "msg", "new reconciliation event")
+
+	var errs []error
+	keep := map[string]struct{}{}
+
+	if ad := ap.instance.Spec.AgentDeployment; ad != nil && ad.PodDisruptionBudget != nil {
+		name := AgentPoolDeploymentName(&ap.instance)
+		keep[name] = struct{}{}
+		keep[agentBusyPDBName(name)] = struct{}{}
+		errs = append(errs, r.reconcileAgentPDB(ctx, ap, name, ad.PodDisruptionBudget, agentPodMatchLabels(&ap.instance)))
+	}
+
+	for _, c := range ap.instance.Spec.AgentDeploymentClasses {
+		if c.PodDisruptionBudget == nil {
+			continue
+		}
+		name := AgentPoolClassDeploymentName(&ap.instance, c.Name)
+		keep[name] = struct{}{}
+		keep[agentBusyPDBName(name)] = struct{}{}
+		errs = append(errs, r.reconcileAgentPDB(ctx, ap, name, c.PodDisruptionBudget, agentClassPodMatchLabels(&ap.instance, c.Name)))
+	}
+
+	errs = append(errs, r.deleteStaleAgentPDBs(ctx, ap, keep))
+
+	return errors.Join(errs...)
+}
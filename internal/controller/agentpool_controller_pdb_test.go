@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestAgentPDBMinAvailable(t *testing.T) {
+	cases := map[string]struct {
+		replicas       int32
+		maxUnavailable int32
+		busy           int32
+		expected       int32
+	}{
+		"Default":          {replicas: 3, maxUnavailable: 1, busy: 0, expected: 2},
+		"BusyAgents":       {replicas: 4, maxUnavailable: 1, busy: 2, expected: 1},
+		"AllAgentsBusy":    {replicas: 3, maxUnavailable: 1, busy: 3, expected: 0},
+		"MaxUnavailable":   {replicas: 3, maxUnavailable: 5, busy: 0, expected: 0},
+		"NoReplicas":       {replicas: 0, maxUnavailable: 1, busy: 0, expected: 0},
+		"BusyOverReplicas": {replicas: 2, maxUnavailable: 0, busy: 4, expected: 0},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.expected, agentPDBMinAvailable(c.replicas, c.maxUnavailable, c.busy))
+		})
+	}
+}
+
+func TestSetAgentPodBusyLabel(t *testing.T) {
+	cases := map[string]struct {
+		labels   map[string]string
+		busy     bool
+		expected map[string]string
+		changed  bool
+	}{
+		"MarkBusy":         {labels: map[string]string{"app": "agents"}, busy: true, expected: map[string]string{"app": "agents", agentBusyLabel: "true"}, changed: true},
+		"MarkBusyNoLabels": {labels: nil, busy: true, expected: map[string]string{agentBusyLabel: "true"}, changed: true},
+		"StillBusy":        {labels: map[string]string{agentBusyLabel: "true"}, busy: true, expected: map[string]string{agentBusyLabel: "true"}, changed: false},
+		"UnmarkBusy":       {labels: map[string]string{"app": "agents", agentBusyLabel: "true"}, busy: false, expected: map[string]string{"app": "agents"}, changed: true},
+		"StillIdle":        {labels: map[string]string{"app": "agents"}, busy: false, expected: map[string]string{"app": "agents"}, changed: false},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: c.labels}}
+			assert.Equal(t, c.changed, setAgentPodBusyLabel(pod, c.busy))
+			assert.Equal(t, c.expected, pod.Labels)
+		})
+	}
+}
+
+func TestAgentStatusesByPod(t *testing.T) {
+	assert.Empty(t, agentStatusesByPod(nil))
+
+	statuses := agentStatusesByPod(&appv1alpha2.AgentsStatus{
+		Agents: []appv1alpha2.AgentStatus{
+			{Name: "agent-1", Status: agentStatusBusy, PodName: "agent-1"},
+			{Name: "agent-1", Status: "errored", PodName: "agent-1"},
+			{Name: "agent-2", Status: "errored", PodName: "agent-2"},
+			{Name: "agent-2", Status: agentStatusBusy, PodName: "agent-2"},
+			{Name: "agent-3", Status: agentStatusIdle, PodName: "agent-3"},
+			{Name: "external", Status: agentStatusBusy},
+		},
+	})
+	assert.Equal(t, map[string]string{
+		"agent-1": agentStatusBusy,
+		"agent-2": agentStatusBusy,
+		"agent-3": agentStatusIdle,
+	}, statuses)
+}
+
+func TestAgentPDBs(t *testing.T) {
+	matchLabels := map[string]string{"app": "agents"}
+	pdbs := agentPDBs("agents-of-this", matchLabels, 2)
+
+	assert.Len(t, pdbs, 2)
+
+	idle := pdbs["agents-of-this"]
+	assert.Equal(t, int32(2), idle.MinAvailable.IntVal)
+	assert.Nil(t, idle.MaxUnavailable)
+	assert.Equal(t, matchLabels, idle.Selector.MatchLabels)
+	assert.Equal(t, []metav1.LabelSelectorRequirement{{Key: agentBusyLabel, Operator: metav1.LabelSelectorOpDoesNotExist}}, idle.Selector.MatchExpressions)
+
+	busy := pdbs["agents-of-this-busy"]
+	assert.Nil(t, busy.MinAvailable)
+	assert.Equal(t, int32(0), busy.MaxUnavailable.IntVal)
+	assert.Equal(t, map[string]string{"app": "agents", agentBusyLabel: "true"}, busy.Selector.MatchLabels)
+	assert.Empty(t, busy.Selector.MatchExpressions)
+
+	// The busy label must not leak into the selector of the deployment.
+	assert.Equal(t, map[string]string{"app": "agents"}, matchLabels)
+}
+
+func TestApplyAgentTopologySpread(t *testing.T) {
+	matchLabels := map[string]string{"app": "agents"}
+
+	t.Run("Defaults", func(t *testing.T) {
+		s := &corev1.PodSpec{}
+		applyAgentTopologySpread(&appv1alpha2.AgentTopologySpread{}, matchLabels, s)
+		assert.Len(t, s.TopologySpreadConstraints, 1)
+		c := s.TopologySpreadConstraints[0]
+		assert.Equal(t, defaultAgentTopologyKey, c.TopologyKey)
+		assert.Equal(t, int32(1), c.MaxSkew)
+		assert.Equal(t, corev1.ScheduleAnyway, c.WhenUnsatisfiable)
+		assert.Equal(t, matchLabels, c.LabelSelector.MatchLabels)
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		s := &corev1.PodSpec{}
+		applyAgentTopologySpread(&appv1alpha2.AgentTopologySpread{
+			TopologyKey:       "topology.kubernetes.io/zone",
+			MaxSkew:           2,
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+		}, matchLabels, s)
+		c := s.TopologySpreadConstraints[0]
+		assert.Equal(t, "topology.kubernetes.io/zone", c.TopologyKey)
+		assert.Equal(t, int32(2), c.MaxSkew)
+		assert.Equal(t, corev1.DoNotSchedule, c.WhenUnsatisfiable)
+	})
+
+	t.Run("PodSpecConstraints", func(t *testing.T) {
+		existing := []corev1.TopologySpreadConstraint{{TopologyKey: "rack", MaxSkew: 3}}
+		s := &corev1.PodSpec{TopologySpreadConstraints: existing}
+		applyAgentTopologySpread(&appv1alpha2.AgentTopologySpread{}, matchLabels, s)
+		assert.Equal(t, existing, s.TopologySpreadConstraints)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		s := &corev1.PodSpec{}
+		applyAgentTopologySpread(nil, matchLabels, s)
+		assert.Empty(t, s.TopologySpreadConstraints)
+	})
+}
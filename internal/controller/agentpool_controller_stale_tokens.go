@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const agentPoolKind = "AgentPool"
+
+// reconcileStaleAgentTokens publishes the agent token metrics and records the agent tokens that have not been used
+// for the duration configured in `spec.staleAgentTokens` in the status. If revocation is enabled, the stale tokens are revoked
+// and removed from the Kubernetes Secret, they are replaced with new ones on the next reconciliation.
+func (r *AgentPoolReconciler) reconcileStaleAgentTokens(ctx context.Context, ap *agentPoolInstance, s *corev1.Secret) error {
+	list, err := ap.tfClient.Client.AgentTokens.List(ctx, ap.instance.Status.AgentPoolID)
+	if err != nil {
+		return err
+	}
+
+	// The agent pool controller manages all agent tokens in the pool.
+	managed := make(map[string]struct{}, len(list.Items))
+	for _, t := range list.Items {
+		managed[t.ID] = struct{}{}
+	}
+
+	now := time.Now()
+	spec := ap.instance.Spec.StaleAgentTokens
+	if spec == nil {
+		ap.instance.Status.StaleAgentTokens = nil
+		setAgentTokenMetrics(agentPoolKind, &ap.instance, ap.instance.Status.AgentPoolID, list.Items, managed, 0, now)
+		return nil
+	}
+
+	stale := staleAgentTokens(list.Items, managed, spec.UnusedFor.Duration, now)
+	setAgentTokenMetrics(agentPoolKind, &ap.instance, ap.instance.Status.AgentPoolID, list.Items, managed, len(stale), now)
+	ap.instance.Status.StaleAgentTokens = stale
+
+	if !spec.Revoke {
+		return nil
+	}
+
+	// Revoked tokens no longer exist, thus only the remaining ones are reported.
+	remaining := stale[:0]
+	for _, t := range stale {
+		st := ap.getTokenStatus(t.ID)
+		// Tokens replaced during a rotation are revoked by the rotation.
+		if st == nil {
+			remaining = append(remaining, t)
+			continue
+		}
+		ap.log.Info("Reconcile Agent Tokens", "msg", fmt.Sprintf("revoking stale agent token name=%q id=%q", t.Name, t.ID))
+		err := ap.tfClient.Client.AgentTokens.Delete(ctx, t.ID)
+		if err != nil && err != tfc.ErrResourceNotFound {
+			ap.log.Error(err, "Reconcile Agent Tokens", "msg", fmt.Sprintf("failed to revoke stale agent token name=%q id=%q", t.Name, t.ID))
+			return err
+		}
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "RevokeStaleAgentToken", "Revoked agent token %s ID %s that has not been used for %s", t.Name, t.ID, spec.UnusedFor.Duration)
+		deleteSecretKey(s, st.Name)
+		ap.deleteTokenStatus(t.ID)
+	}
+	ap.instance.Status.StaleAgentTokens = remaining
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"time"
 
 	tfc "github.com/hashicorp/go-tfe"
 	corev1 "k8s.io/api/core/v1"
@@ -61,6 +62,64 @@ func (ap *agentPoolInstance) deleteTokenStatus(id string) {
 	})
 }
 
+func (ap *agentPoolInstance) getTokenStatus(id string) *appv1alpha2.AgentAPIToken {
+	for _, t := range ap.instance.Status.AgentTokens {
+		if t.ID == id {
+			return t
+		}
+	}
+	return nil
+}
+
+// rotateAgentTokens replaces agent tokens that are older than the maximum age of the rotation policy,
+// and revokes the replaced tokens once the overlap period has elapsed and the new tokens have been used.
+// The replacement token is stored in the Kubernetes Secret under the same key.
+func (r *AgentPoolReconciler) rotateAgentTokens(ctx context.Context, ap *agentPoolInstance, s *corev1.Secret) error {
+	rotation := ap.instance.Spec.AgentTokenRotation
+	now := time.Now()
+
+	for _, t := range ap.instance.Status.AgentTokens {
+		if t.PreviousID != "" {
+			at, err := ap.tfClient.Client.AgentTokens.Read(ctx, t.ID)
+			if err != nil {
+				ap.log.Error(err, "Reconcile Agent Tokens", "msg", fmt.Sprintf("failed to read agent token name=%q id=%q", t.Name, t.ID))
+				return err
+			}
+			t.LastUsedAt = pointer.PointerOf(at.LastUsedAt.Unix())
+			if !previousAgentTokenRevocable(t, rotation, at.LastUsedAt, now) {
+				continue
+			}
+			ap.log.Info("Reconcile Agent Tokens", "msg", fmt.Sprintf("revoking rotated agent token name=%q id=%q", t.Name, t.PreviousID))
+			err = ap.tfClient.Client.AgentTokens.Delete(ctx, t.PreviousID)
+			if err != nil && err != tfc.ErrResourceNotFound {
+				ap.log.Error(err, "Reconcile Agent Tokens", "msg", fmt.Sprintf("failed to revoke rotated agent token name=%q id=%q", t.Name, t.PreviousID))
+				return err
+			}
+			r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "RotateAgentToken", "Revoked agent token %s ID %s replaced by ID %s", t.Name, t.PreviousID, t.ID)
+			t.PreviousID = ""
+			continue
+		}
+
+		if !agentTokenNeedsRotation(t, rotation, now) {
+			continue
+		}
+		ap.log.Info("Reconcile Agent Tokens", "msg", fmt.Sprintf("rotating agent token name=%q id=%q", t.Name, t.ID))
+		at, err := ap.tfClient.Client.AgentTokens.Create(ctx, ap.instance.Status.AgentPoolID, tfc.AgentTokenCreateOptions{
+			Description: &t.Name,
+		})
+		if err != nil {
+			ap.log.Error(err, "Reconcile Agent Tokens", "msg", fmt.Sprintf("failed to create a replacement for agent token name=%q id=%q", t.Name, t.ID))
+			return err
+		}
+		setSecretKey(s, t.Name, at.Token)
+		rotateAgentTokenStatus(t, at, now)
+		ap.log.Info("Reconcile Agent Tokens", "msg", fmt.Sprintf("successfully rotated agent token name=%q id=%q", t.Name, t.ID))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "RotateAgentToken", "Rotated agent token %s, the replaced token ID %s will be revoked after the overlap period", t.Name, t.PreviousID)
+	}
+
+	return nil
+}
+
 func agentPoolOutputObjectName(name string) string {
 	return fmt.Sprintf("%s-agent-pool", name)
 }
@@ -135,6 +194,30 @@ func (r *AgentPoolReconciler) reconcileAgentTokens(ctx context.Context, ap *agen
 	}
 	s.Labels[labelHasChanged] = metaFalse
 
+	// Use defer to ensure the Secret is always updated, even if token creation, rotation, or deletion fails.
+	// It is registered right after the Secret is retrieved so that tokens created before a failure are never lost.
+	// This reduces the number of (Kubernetes) API calls and preserves the intermediate token state,
+	// minimizing unnecessary updates during retries.
+	defer func() {
+		// Handle unexpected nil Secret, e.g. failed to retrieve it (should not happen here).
+		if s == nil {
+			return
+		}
+		// Do not update if there are no changes.
+		if s.GetLabels()[labelHasChanged] == metaFalse {
+			delete(s.Labels, labelHasChanged)
+			ap.log.Info("Reconcile Agent Tokens", "msg", "no changes detected in Kubernetes Secret")
+			return
+		}
+		delete(s.Labels, labelHasChanged)
+		ap.log.Info("Reconcile Agent Tokens", "msg", fmt.Sprintf("updating Kubernetes Secret %q", s.Name))
+		if err := r.Client.Update(ctx, s); err != nil {
+			ap.log.Error(err, "Reconcile Agent Tokens", "msg", fmt.Sprintf("failed to update Kubernetes Secret %q", s.Name))
+			return
+		}
+		ap.log.Info("Reconcile Agent Tokens", "msg", fmt.Sprintf("successfully updated Kubernetes Secret %q", s.Name))
+	}()
+
 	agentTokens, err := ap.getTokens(ctx)
 	if err != nil {
 		return err
@@ -150,6 +233,10 @@ func (r *AgentPoolReconciler) reconcileAgentTokens(ctx context.Context, ap *agen
 			delete(statusTokens, token.Name)
 			if _, ok := agentTokens[id]; ok {
 				delete(agentTokens, id)
+				// Keep the token replaced during the last rotation until it is revoked.
+				if st := ap.getTokenStatus(id); st != nil && st.PreviousID != "" {
+					delete(agentTokens, st.PreviousID)
+				}
 				continue
 			}
 			deleteSecretKey(s, token.Name)
@@ -168,6 +255,10 @@ func (r *AgentPoolReconciler) reconcileAgentTokens(ctx context.Context, ap *agen
 		ap.deleteTokenStatus(id)
 	}
 
+	if err := r.rotateAgentTokens(ctx, ap, s); err != nil {
+		return err
+	}
+
 	for id, name := range agentTokens {
 		ap.log.Info("Reconcile Agent Tokens", "msg", fmt.Sprintf("removing agent token name=%q id=%q", name, id))
 		err := ap.tfClient.Client.AgentTokens.Delete(ctx, id)
@@ -179,27 +270,10 @@ func (r *AgentPoolReconciler) reconcileAgentTokens(ctx context.Context, ap *agen
 		ap.deleteTokenStatus(id)
 	}
 
-	// Use defer to ensure the Secret is always updated, even if token creation or deletion fails.
-	// This reduces the number of (Kubernetes) API calls and preserves the intermediate token state,
-	// minimizing unnecessary updates during retries.
-	defer func() {
-		// Handle unexpected nil Secret, e.g. failed to retrieve it (should not happen here).
-		if s == nil {
-			return
-		}
-		// Do not update if there are no changes.
-		if s.GetLabels()[labelHasChanged] == metaFalse {
-			delete(s.Labels, labelHasChanged)
-			ap.log.Info("Reconcile Agent Tokens", "msg", "no changes detected in Kubernetes Secret")
-			return
-		}
-		delete(s.Labels, labelHasChanged)
-		ap.log.Info("Reconcile Agent Tokens", "msg", fmt.Sprintf("updating Kubernetes Secret %q", s.Name))
-		if err := r.Client.Update(ctx, s); err != nil {
-			ap.log.Error(err, "Reconcile Agent Tokens", "msg", fmt.Sprintf("failed to update Kubernetes Secret %q", s.Name))
-		}
-		ap.log.Info("Reconcile Agent Tokens", "msg", fmt.Sprintf("successfully updated Kubernetes Secret %q", s.Name))
-	}()
+	if err := r.reconcileStaleAgentTokens(ctx, ap, s); err != nil {
+		ap.log.Error(err, "Reconcile Agent Tokens", "msg", "failed to reconcile stale agent tokens")
+		return err
+	}
 
 	return nil
 }
@@ -7,8 +7,10 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 
 	"github.com/go-logr/logr"
@@ -20,8 +22,10 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
@@ -49,7 +53,8 @@ type agentTokenInstance struct {
 //+kubebuilder:rbac:groups=apt.terraform.io,resources=agenttokens/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=apt.terraform.io,resources=agenttokens/finalizers,verbs=update
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
-//+kubebuilder:rbac:groups="",resources=secrets,verbs=create;list;update;watch;patch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=create;delete;get;list;update;watch;patch
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 func (r *AgentTokenReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	t := agentTokenInstance{}
@@ -154,8 +159,8 @@ func (r *AgentTokenReconciler) getTerraformClient(ctx context.Context, t *agentT
 // SetupWithManager sets up the controller with the Manager.
 func (r *AgentTokenReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&appv1alpha2.AgentToken{}).
-		WithEventFilter(predicate.Or(genericPredicates())).
+		For(&appv1alpha2.AgentToken{}, builder.WithPredicates(predicate.Or(genericPredicates()))).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.namespaceToAgentTokens), builder.WithPredicates(predicate.LabelChangedPredicate{})).
 		Complete(r)
 }
 
@@ -166,9 +171,12 @@ func (r *AgentTokenReconciler) removeFinalizer(ctx context.Context, t *agentToke
 	if err != nil {
 		t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to remove finalizer %s", agentTokenFinalizer))
 		r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "RemoveAgentToken", "Failed to remove finalizer %s", agentTokenFinalizer)
+		return err
 	}
 
-	return err
+	deleteAgentTokenMetrics(agentTokenKind, &t.instance)
+
+	return nil
 }
 
 func (r *AgentTokenReconciler) getAgentPoolIDByName(ctx context.Context, t *agentTokenInstance) (*tfc.AgentPool, error) {
@@ -267,10 +275,6 @@ func (r *AgentTokenReconciler) listAgentTokens(ctx context.Context, t *agentToke
 }
 
 func (r *AgentTokenReconciler) createToken(ctx context.Context, t *agentTokenInstance, name string) error {
-	nn := types.NamespacedName{
-		Namespace: t.instance.Namespace,
-		Name:      t.instance.Spec.SecretName,
-	}
 	t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("creating a new agent token %q", name))
 	at, err := t.tfClient.Client.AgentTokens.Create(ctx, t.instance.Status.AgentPool.ID, tfc.AgentTokenCreateOptions{
 		Description: &name,
@@ -280,39 +284,64 @@ func (r *AgentTokenReconciler) createToken(ctx context.Context, t *agentTokenIns
 		return err
 	}
 	t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("successfully created a new agent token %q %q", name, at.ID))
+	if err := r.storeToken(ctx, t, at); err != nil {
+		return err
+	}
+
+	t.instance.Status.AgentTokens = append(t.instance.Status.AgentTokens, &appv1alpha2.AgentAPIToken{
+		Name:       at.Description,
+		ID:         at.ID,
+		CreatedAt:  pointer.PointerOf(at.CreatedAt.Unix()),
+		LastUsedAt: pointer.PointerOf(at.LastUsedAt.Unix()),
+	})
+
+	return nil
+}
+
+// storeToken creates or updates the key of the agent token in the Kubernetes Secret.
+func (r *AgentTokenReconciler) storeToken(ctx context.Context, t *agentTokenInstance, at *tfc.AgentToken) error {
+	name := at.Description
+	key, err := t.instance.SecretKey(name)
+	if err != nil {
+		t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to render secret key of agent token %q", name))
+		return err
+	}
+	nn := types.NamespacedName{
+		Namespace: t.instance.Namespace,
+		Name:      t.instance.Spec.SecretName,
+	}
 	s := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: t.instance.Namespace,
 			Name:      t.instance.Spec.SecretName,
 		},
 	}
+	secretLabels := agentTokenSecretLabels(&t.instance)
+	annotations := agentTokenSecretAnnotations(&t.instance)
 	_, err = controllerutil.CreateOrPatch(ctx, r.Client, s, func() error {
 		if err := controllerutil.SetControllerReference(&t.instance, s, r.Scheme); err != nil {
 			t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to set controller reference to secret=%q namespace=%q", nn.Name, nn.Namespace))
 			return err
 		}
-		s.Annotations = map[string]string{
-			"app.terraform.io/agent-pool-id":   t.instance.Status.AgentPool.ID,
-			"app.terraform.io/agent-pool-name": t.instance.Status.AgentPool.Name,
-		}
+		s.Labels = mergeAgentTokenSecretMetadata(s.Labels, secretLabels, t.instance.Status.SecretLabels)
+		s.Annotations = mergeAgentTokenSecretMetadata(s.Annotations, annotations, t.instance.Status.SecretAnnotations)
 		if s.Data == nil {
 			s.Data = make(map[string][]byte)
 		}
-		s.Data[at.Description] = []byte(at.Token)
+		s.Data[key] = []byte(at.Token)
 		return nil
 	})
 	if err != nil {
-		t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("unable to create key=%q in secret=%q namespace=%q", name, nn.Name, nn.Namespace))
+		t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("unable to create key=%q in secret=%q namespace=%q", key, nn.Name, nn.Namespace))
 		return err
 	}
-	t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("successfully created key=%q in secret=%q namespace=%q", name, nn.Name, nn.Namespace))
-
-	t.instance.Status.AgentTokens = append(t.instance.Status.AgentTokens, &appv1alpha2.AgentAPIToken{
-		Name:       at.Description,
-		ID:         at.ID,
-		CreatedAt:  pointer.PointerOf(at.CreatedAt.Unix()),
-		LastUsedAt: pointer.PointerOf(at.LastUsedAt.Unix()),
-	})
+	t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("successfully created key=%q in secret=%q namespace=%q", key, nn.Name, nn.Namespace))
+	if t.instance.Status.SecretKeys == nil {
+		t.instance.Status.SecretKeys = make(map[string]string)
+	}
+	t.instance.Status.SecretKeys[name] = key
+	t.instance.Status.SecretLabels = slices.Sorted(maps.Keys(secretLabels))
+	t.instance.Status.SecretAnnotations = slices.Sorted(maps.Keys(annotations))
 
 	return nil
 }
@@ -320,6 +349,14 @@ func (r *AgentTokenReconciler) createToken(ctx context.Context, t *agentTokenIns
 func (r *AgentTokenReconciler) removeToken(ctx context.Context, t *agentTokenInstance, id string) error {
 	for i, token := range t.instance.Status.AgentTokens {
 		if token.ID == id {
+			// Revoke the token replaced during the last rotation too.
+			if token.PreviousID != "" {
+				err := t.tfClient.Client.AgentTokens.Delete(ctx, token.PreviousID)
+				if err != nil && err != tfc.ErrResourceNotFound {
+					t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to remove token %q", token.PreviousID))
+					return err
+				}
+			}
 			err := t.tfClient.Client.AgentTokens.Delete(ctx, id)
 			if err != nil && err != tfc.ErrResourceNotFound {
 				t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to remove token %q", id))
@@ -333,6 +370,7 @@ func (r *AgentTokenReconciler) removeToken(ctx context.Context, t *agentTokenIns
 			t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("remove key=%q from in secret=%q namespace=%q", id, nn.Name, nn.Namespace))
 			if err := r.Client.Get(ctx, nn, s); err != nil {
 				if kerrors.IsNotFound(err) {
+					delete(t.instance.Status.SecretKeys, token.Name)
 					t.instance.Status.AgentTokens = slice.RemoveFromSlice(t.instance.Status.AgentTokens, i)
 					return nil
 				}
@@ -340,13 +378,15 @@ func (r *AgentTokenReconciler) removeToken(ctx context.Context, t *agentTokenIns
 				return err
 			}
 			//
+			key := t.storedSecretKey(token.Name)
 			patch := client.MergeFrom(s.DeepCopy())
-			delete(s.Data, token.Name)
+			delete(s.Data, key)
 			if err := r.Client.Patch(ctx, s, patch); err != nil {
-				t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("unable to remove key=%q in secret=%q namespace=%q", token.Name, nn.Name, nn.Namespace))
+				t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("unable to remove key=%q in secret=%q namespace=%q", key, nn.Name, nn.Namespace))
 				return err
 			}
-			t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("successfully removed key=%q in secret=%q namespace=%q", token.Name, nn.Name, nn.Namespace))
+			t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("successfully removed key=%q in secret=%q namespace=%q", key, nn.Name, nn.Namespace))
+			delete(t.instance.Status.SecretKeys, token.Name)
 			t.instance.Status.AgentTokens = slice.RemoveFromSlice(t.instance.Status.AgentTokens, i)
 			return nil
 		}
@@ -373,6 +413,10 @@ func (r *AgentTokenReconciler) reconcileToken(ctx context.Context, t *agentToken
 	statusTokens := make(map[string]string, len(t.instance.Status.AgentTokens))
 	for _, token := range t.instance.Status.AgentTokens {
 		statusTokens[token.Name] = token.ID
+		// Keep the token replaced during the last rotation until it is revoked.
+		if token.PreviousID != "" {
+			delete(tokens, token.PreviousID)
+		}
 	}
 
 	// Clean up.
@@ -397,6 +441,10 @@ func (r *AgentTokenReconciler) reconcileToken(ctx context.Context, t *agentToken
 		}
 	}
 
+	if err := r.rotateTokens(ctx, t); err != nil {
+		return err
+	}
+
 	switch t.instance.Spec.ManagementPolicy {
 	case appv1alpha2.AgentTokenManagementPolicyMerge:
 		// This remains no-op.
@@ -414,6 +462,14 @@ func (r *AgentTokenReconciler) reconcileToken(ctx context.Context, t *agentToken
 		}
 	}
 
+	if err := r.reconcileStaleTokens(ctx, t); err != nil {
+		return err
+	}
+
+	if err := r.reconcileSecret(ctx, t); err != nil {
+		return err
+	}
+
 	t.instance.Status.ObservedGeneration = t.instance.Generation
 
 	return r.Status().Update(ctx, &t.instance)
@@ -62,6 +62,9 @@ func (r *AgentTokenReconciler) deleteAgentToken(ctx context.Context, t *agentTok
 			}
 			t.log.Info("Reconcile Agent Pool", "msg", "successfully deleted tokens")
 		}
+		if err := r.deleteProjectedSecrets(ctx, t, nil); err != nil {
+			return err
+		}
 	}
 
 	return r.removeFinalizer(ctx, t)
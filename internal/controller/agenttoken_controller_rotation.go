@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+// rotateTokens replaces agent tokens that are older than the maximum age of the rotation policy,
+// and revokes the replaced tokens once the overlap period has elapsed and the new tokens have been used.
+// The replacement token is stored in the Kubernetes Secret under the same key.
+func (r *AgentTokenReconciler) rotateTokens(ctx context.Context, t *agentTokenInstance) error {
+	rotation := t.instance.Spec.Rotation
+	now := time.Now()
+
+	for _, token := range t.instance.Status.AgentTokens {
+		if token.PreviousID != "" {
+			at, err := t.tfClient.Client.AgentTokens.Read(ctx, token.ID)
+			if err != nil {
+				t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to read agent token %q", token.ID))
+				return err
+			}
+			token.LastUsedAt = pointer.PointerOf(at.LastUsedAt.Unix())
+			if !previousAgentTokenRevocable(token, rotation, at.LastUsedAt, now) {
+				continue
+			}
+			t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("revoking rotated agent token %q", token.PreviousID))
+			err = t.tfClient.Client.AgentTokens.Delete(ctx, token.PreviousID)
+			if err != nil && err != tfc.ErrResourceNotFound {
+				t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to revoke rotated agent token %q", token.PreviousID))
+				return err
+			}
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "RotateAgentToken", "Revoked agent token %s ID %s replaced by ID %s", token.Name, token.PreviousID, token.ID)
+			token.PreviousID = ""
+			continue
+		}
+
+		if !agentTokenNeedsRotation(token, rotation, now) {
+			continue
+		}
+		t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("rotating agent token %q %q", token.Name, token.ID))
+		at, err := t.tfClient.Client.AgentTokens.Create(ctx, t.instance.Status.AgentPool.ID, tfc.AgentTokenCreateOptions{
+			Description: &token.Name,
+		})
+		if err != nil {
+			t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to create a replacement for agent token %q %q", token.Name, token.ID))
+			return err
+		}
+		if err := r.storeToken(ctx, t, at); err != nil {
+			// Revoke the replacement token that is not stored anywhere, the rotation is retried in the next reconciliation.
+			if derr := t.tfClient.Client.AgentTokens.Delete(ctx, at.ID); derr != nil && derr != tfc.ErrResourceNotFound {
+				t.log.Error(derr, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to revoke agent token %q", at.ID))
+			}
+			return err
+		}
+		rotateAgentTokenStatus(token, at, now)
+		t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("successfully rotated agent token %q %q", token.Name, token.ID))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "RotateAgentToken", "Rotated agent token %s, the replaced token ID %s will be revoked after the overlap period", token.Name, token.PreviousID)
+	}
+
+	return nil
+}
@@ -0,0 +1,350 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+const (
+	// agentTokenSourceLabel is the label of the Kubernetes Secrets copied to other namespaces.
+	// Its value is the UID of the AgentToken object they are copied from.
+	agentTokenSourceLabel = "agenttoken.app.terraform.io/source-uid"
+	// agentTokenAcceptSecretsLabel is the label that namespaces must carry with the value "true" to receive copies of the Kubernetes Secrets.
+	agentTokenAcceptSecretsLabel = "agenttoken.app.terraform.io/accept-secrets"
+)
+
+// storedSecretKey returns the key of the agent token in the Kubernetes Secret.
+func (t *agentTokenInstance) storedSecretKey(name string) string {
+	if key, ok := t.instance.Status.SecretKeys[name]; ok {
+		return key
+	}
+	return name
+}
+
+// agentTokenSecretLabels returns the labels of the Kubernetes Secret from the Secret template.
+func agentTokenSecretLabels(instance *appv1alpha2.AgentToken) map[string]string {
+	labels := map[string]string{}
+	if st := instance.Spec.SecretTemplate; st != nil {
+		maps.Copy(labels, st.Labels)
+	}
+	return labels
+}
+
+// agentTokenSecretAnnotations returns the annotations of the Kubernetes Secret from the Secret template and the agent pool.
+func agentTokenSecretAnnotations(instance *appv1alpha2.AgentToken) map[string]string {
+	annotations := map[string]string{}
+	if st := instance.Spec.SecretTemplate; st != nil {
+		maps.Copy(annotations, st.Annotations)
+	}
+	if p := instance.Status.AgentPool; p != nil {
+		annotations["app.terraform.io/agent-pool-id"] = p.ID
+		annotations["app.terraform.io/agent-pool-name"] = p.Name
+	}
+	return annotations
+}
+
+// renderAgentTokenSecretData returns the data of the Kubernetes Secret with the agent tokens under the keys rendered from the Secret template,
+// and the keys by agent token name. The values are taken from the current data, where the agent tokens may be stored under their previous keys.
+// It also returns the names of the agent tokens that are not found in the current data.
+func renderAgentTokenSecretData(t *agentTokenInstance, current map[string][]byte) (map[string][]byte, map[string]string, []string, error) {
+	data := map[string][]byte{}
+	keys := map[string]string{}
+	var missing []string
+
+	for _, token := range t.instance.Status.AgentTokens {
+		key, err := t.instance.SecretKey(token.Name)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		var value []byte
+		for _, k := range []string{t.storedSecretKey(token.Name), key, token.Name} {
+			if v, ok := current[k]; ok {
+				value = v
+				break
+			}
+		}
+		if value == nil {
+			missing = append(missing, token.Name)
+			continue
+		}
+		data[key] = value
+		keys[token.Name] = key
+	}
+
+	if st := t.instance.Spec.SecretTemplate; st != nil && st.DotEnvKey != "" {
+		var b strings.Builder
+		for _, key := range slices.Sorted(maps.Keys(data)) {
+			fmt.Fprintf(&b, "%s=%s\n", key, data[key])
+		}
+		data[st.DotEnvKey] = []byte(b.String())
+	}
+
+	return data, keys, missing, nil
+}
+
+// mergeAgentTokenSecretData returns the data of the Kubernetes Secret with the rendered keys set.
+// The keys of the agent tokens recorded in the status that are no longer rendered are removed, all other keys are kept as is.
+func mergeAgentTokenSecretData(current, rendered map[string][]byte, recordedKeys map[string]string) map[string][]byte {
+	data := maps.Clone(current)
+	if data == nil {
+		data = map[string][]byte{}
+	}
+	for _, key := range recordedKeys {
+		if _, ok := rendered[key]; !ok {
+			delete(data, key)
+		}
+	}
+	maps.Copy(data, rendered)
+
+	return data
+}
+
+// mergeAgentTokenSecretMetadata returns the labels or annotations of the Kubernetes Secret with the rendered ones set.
+// The keys recorded in the status that are no longer rendered are removed, all other keys are kept as is.
+func mergeAgentTokenSecretMetadata(current, rendered map[string]string, recordedKeys []string) map[string]string {
+	metadata := maps.Clone(current)
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	for _, key := range recordedKeys {
+		if _, ok := rendered[key]; !ok {
+			delete(metadata, key)
+		}
+	}
+	maps.Copy(metadata, rendered)
+
+	return metadata
+}
+
+// reconcileSecret renders the Kubernetes Secret with the agent tokens from the Secret template,
+// and copies it to the namespaces selected by the namespace selector.
+// Agent tokens that are missing in the Secret, e.g. the Secret was deleted, are removed and created again on the next reconciliation.
+func (r *AgentTokenReconciler) reconcileSecret(ctx context.Context, t *agentTokenInstance) error {
+	nn := types.NamespacedName{
+		Namespace: t.instance.Namespace,
+		Name:      t.instance.Spec.SecretName,
+	}
+
+	s := &corev1.Secret{}
+	if err := r.Client.Get(ctx, nn, s); err != nil {
+		if !kerrors.IsNotFound(err) {
+			t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to get secret=%q namespace=%q", nn.Name, nn.Namespace))
+			return err
+		}
+		s = nil
+	}
+
+	var current map[string][]byte
+	if s != nil {
+		current = s.Data
+	}
+	data, keys, missing, err := renderAgentTokenSecretData(t, current)
+	if err != nil {
+		t.log.Error(err, "Reconcile Agent Token", "msg", "failed to render secret keys")
+		return err
+	}
+
+	for _, name := range missing {
+		t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("agent token %q is missing in secret=%q namespace=%q, replacing it", name, nn.Name, nn.Namespace))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileAgentTokenSecret", "Agent token %s is missing in the Secret %s, it will be replaced", name, nn.Name)
+		for _, token := range t.instance.Status.AgentTokens {
+			if token.Name == name {
+				if err := r.removeToken(ctx, t, token.ID); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+
+	if s == nil {
+		t.instance.Status.SecretKeys = keys
+		t.instance.Status.SecretLabels = nil
+		t.instance.Status.SecretAnnotations = nil
+		return r.projectSecret(ctx, t, nil, nil)
+	}
+
+	// Keys, labels, and annotations that are not managed by the operator, e.g. added by other tools, are kept.
+	secretLabels := agentTokenSecretLabels(&t.instance)
+	annotations := agentTokenSecretAnnotations(&t.instance)
+	mergedData := mergeAgentTokenSecretData(s.Data, data, t.instance.Status.SecretKeys)
+	mergedLabels := mergeAgentTokenSecretMetadata(s.Labels, secretLabels, t.instance.Status.SecretLabels)
+	mergedAnnotations := mergeAgentTokenSecretMetadata(s.Annotations, annotations, t.instance.Status.SecretAnnotations)
+	if !equality.Semantic.DeepEqual(s.Data, mergedData) || !maps.Equal(s.Labels, mergedLabels) || !maps.Equal(s.Annotations, mergedAnnotations) {
+		t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("updating secret=%q namespace=%q", nn.Name, nn.Namespace))
+		patch := client.MergeFrom(s.DeepCopy())
+		s.Data = mergedData
+		s.Labels = mergedLabels
+		s.Annotations = mergedAnnotations
+		if err := r.Client.Patch(ctx, s, patch); err != nil {
+			t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("unable to patch secret=%q namespace=%q", nn.Name, nn.Namespace))
+			return err
+		}
+	}
+	t.instance.Status.SecretKeys = keys
+	t.instance.Status.SecretLabels = slices.Sorted(maps.Keys(secretLabels))
+	t.instance.Status.SecretAnnotations = slices.Sorted(maps.Keys(annotations))
+
+	// Only the rendered agent token keys are copied, other keys of the Secret stay in the namespace of the AgentToken.
+	return r.projectSecret(ctx, t, s, data)
+}
+
+// agentTokenNamespaceSelector returns the selector of namespaces to copy the Kubernetes Secret to.
+// It selects only namespaces that accept the copies.
+func agentTokenNamespaceSelector(instance *appv1alpha2.AgentToken) (labels.Selector, error) {
+	selector, err := metav1.LabelSelectorAsSelector(instance.Spec.NamespaceSelector)
+	if err != nil {
+		return nil, err
+	}
+	accept, err := labels.NewRequirement(agentTokenAcceptSecretsLabel, selection.Equals, []string{"true"})
+	if err != nil {
+		return nil, err
+	}
+
+	return selector.Add(*accept), nil
+}
+
+// selectedNamespaces returns the names of namespaces selected by the namespace selector that accept the copies, except the namespace of the AgentToken.
+func (r *AgentTokenReconciler) selectedNamespaces(ctx context.Context, t *agentTokenInstance) ([]string, error) {
+	if t.instance.Spec.NamespaceSelector == nil {
+		return nil, nil
+	}
+
+	selector, err := agentTokenNamespaceSelector(&t.instance)
+	if err != nil {
+		return nil, err
+	}
+
+	nsl := &corev1.NamespaceList{}
+	if err := r.Client.List(ctx, nsl, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	var namespaces []string
+	for _, ns := range nsl.Items {
+		if ns.Name == t.instance.Namespace || ns.DeletionTimestamp != nil {
+			continue
+		}
+		namespaces = append(namespaces, ns.Name)
+	}
+	slices.Sort(namespaces)
+
+	return namespaces, nil
+}
+
+// projectSecret copies the given data of the Kubernetes Secret to the selected namespaces and removes its copies from namespaces that are no longer selected.
+func (r *AgentTokenReconciler) projectSecret(ctx context.Context, t *agentTokenInstance, s *corev1.Secret, data map[string][]byte) error {
+	var namespaces []string
+	if s != nil {
+		var err error
+		namespaces, err = r.selectedNamespaces(ctx, t)
+		if err != nil {
+			t.log.Error(err, "Reconcile Agent Token", "msg", "failed to list selected namespaces")
+			return err
+		}
+	}
+
+	var errs []error
+	projected := []string{}
+	for _, ns := range namespaces {
+		ps := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.Name,
+				Namespace: ns,
+			},
+		}
+		_, err := controllerutil.CreateOrPatch(ctx, r.Client, ps, func() error {
+			if !ps.CreationTimestamp.IsZero() && ps.Labels[agentTokenSourceLabel] != string(t.instance.UID) {
+				return fmt.Errorf("secret=%q namespace=%q already exists and is not managed by this resource", ps.Name, ps.Namespace)
+			}
+			ps.Labels = agentTokenSecretLabels(&t.instance)
+			ps.Labels[agentTokenSourceLabel] = string(t.instance.UID)
+			ps.Annotations = agentTokenSecretAnnotations(&t.instance)
+			ps.Data = maps.Clone(data)
+			return nil
+		})
+		if err != nil {
+			t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to copy secret=%q to namespace=%q", s.Name, ns))
+			errs = append(errs, err)
+			continue
+		}
+		projected = append(projected, ns)
+	}
+	if len(projected) == 0 {
+		projected = nil
+	}
+	t.instance.Status.ProjectedNamespaces = projected
+
+	errs = append(errs, r.deleteProjectedSecrets(ctx, t, namespaces))
+
+	return errors.Join(errs...)
+}
+
+// deleteProjectedSecrets removes copies of the Kubernetes Secret from all namespaces except the given ones.
+func (r *AgentTokenReconciler) deleteProjectedSecrets(ctx context.Context, t *agentTokenInstance, keep []string) error {
+	sl := &corev1.SecretList{}
+	if err := r.Client.List(ctx, sl, client.MatchingLabels{agentTokenSourceLabel: string(t.instance.UID)}); err != nil {
+		t.log.Error(err, "Reconcile Agent Token", "msg", "failed to list copies of secret")
+		return err
+	}
+
+	for i := range sl.Items {
+		ps := &sl.Items[i]
+		if ps.Namespace == t.instance.Namespace || slices.Contains(keep, ps.Namespace) {
+			continue
+		}
+		t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("removing copy of secret=%q namespace=%q", ps.Name, ps.Namespace))
+		if err := r.Client.Delete(ctx, ps); err != nil && !kerrors.IsNotFound(err) {
+			t.log.Error(err, "Reconcile Agent Token", "msg", fmt.Sprintf("failed to remove copy of secret=%q namespace=%q", ps.Name, ps.Namespace))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// namespaceToAgentTokens maps a namespace to the AgentToken objects that select it or have copied their Secret to it.
+func (r *AgentTokenReconciler) namespaceToAgentTokens(ctx context.Context, o client.Object) []reconcile.Request {
+	atl := &appv1alpha2.AgentTokenList{}
+	if err := r.Client.List(ctx, atl); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, at := range atl.Items {
+		if at.Spec.NamespaceSelector == nil {
+			continue
+		}
+		selector, err := agentTokenNamespaceSelector(&at)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(o.GetLabels())) || slices.Contains(at.Status.ProjectedNamespaces, o.GetName()) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: at.Namespace, Name: at.Name},
+			})
+		}
+	}
+
+	return requests
+}
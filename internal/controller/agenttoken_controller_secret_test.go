@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestRenderAgentTokenSecretData(t *testing.T) {
+	newInstance := func(st *appv1alpha2.AgentTokenSecretTemplate, keys map[string]string) *agentTokenInstance {
+		return &agentTokenInstance{
+			instance: appv1alpha2.AgentToken{
+				Spec: appv1alpha2.AgentTokenSpec{
+					SecretTemplate: st,
+				},
+				Status: appv1alpha2.AgentTokenStatus{
+					AgentPool: &appv1alpha2.AgentPoolRef{ID: "apool-1", Name: "pool"},
+					AgentTokens: []*appv1alpha2.AgentAPIToken{
+						{Name: "alpha", ID: "at-1"},
+						{Name: "beta", ID: "at-2"},
+					},
+					SecretKeys: keys,
+				},
+			},
+		}
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		data, keys, missing, err := renderAgentTokenSecretData(newInstance(nil, nil), map[string][]byte{
+			"alpha": []byte("a"),
+			"beta":  []byte("b"),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]byte{"alpha": []byte("a"), "beta": []byte("b")}, data)
+		assert.Equal(t, map[string]string{"alpha": "alpha", "beta": "beta"}, keys)
+		assert.Empty(t, missing)
+	})
+
+	t.Run("RenameKeys", func(t *testing.T) {
+		st := &appv1alpha2.AgentTokenSecretTemplate{
+			Key: "{{ upper .Name }}_{{ .AgentPoolName }}",
+		}
+		data, keys, missing, err := renderAgentTokenSecretData(newInstance(st, map[string]string{"alpha": "old_alpha"}), map[string][]byte{
+			"old_alpha": []byte("a"),
+			"beta":      []byte("b"),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]byte{"ALPHA_pool": []byte("a"), "BETA_pool": []byte("b")}, data)
+		assert.Equal(t, map[string]string{"alpha": "ALPHA_pool", "beta": "BETA_pool"}, keys)
+		assert.Empty(t, missing)
+	})
+
+	t.Run("DotEnv", func(t *testing.T) {
+		st := &appv1alpha2.AgentTokenSecretTemplate{
+			DotEnvKey: ".env",
+		}
+		data, _, _, err := renderAgentTokenSecretData(newInstance(st, nil), map[string][]byte{
+			"beta":  []byte("b"),
+			"alpha": []byte("a"),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "alpha=a\nbeta=b\n", string(data[".env"]))
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		data, keys, missing, err := renderAgentTokenSecretData(newInstance(nil, nil), map[string][]byte{
+			"alpha": []byte("a"),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]byte{"alpha": []byte("a")}, data)
+		assert.Equal(t, map[string]string{"alpha": "alpha"}, keys)
+		assert.Equal(t, []string{"beta"}, missing)
+	})
+}
+
+func TestMergeAgentTokenSecretData(t *testing.T) {
+	cases := map[string]struct {
+		current      map[string][]byte
+		rendered     map[string][]byte
+		recordedKeys map[string]string
+		expected     map[string][]byte
+	}{
+		"NewSecretData": {
+			current:      nil,
+			rendered:     map[string][]byte{"alpha": []byte("a")},
+			recordedKeys: nil,
+			expected:     map[string][]byte{"alpha": []byte("a")},
+		},
+		"KeepForeignKeys": {
+			current:      map[string][]byte{"alpha": []byte("old"), "ca.crt": []byte("ca")},
+			rendered:     map[string][]byte{"alpha": []byte("a")},
+			recordedKeys: map[string]string{"alpha": "alpha"},
+			expected:     map[string][]byte{"alpha": []byte("a"), "ca.crt": []byte("ca")},
+		},
+		"MoveRecordedKeys": {
+			current:      map[string][]byte{"alpha": []byte("a"), "ca.crt": []byte("ca")},
+			rendered:     map[string][]byte{"ALPHA": []byte("a")},
+			recordedKeys: map[string]string{"alpha": "alpha"},
+			expected:     map[string][]byte{"ALPHA": []byte("a"), "ca.crt": []byte("ca")},
+		},
+		"RemoveDeletedTokens": {
+			current:      map[string][]byte{"alpha": []byte("a"), "beta": []byte("b"), "ca.crt": []byte("ca")},
+			rendered:     map[string][]byte{"alpha": []byte("a")},
+			recordedKeys: map[string]string{"alpha": "alpha", "beta": "beta"},
+			expected:     map[string][]byte{"alpha": []byte("a"), "ca.crt": []byte("ca")},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			var before map[string][]byte
+			if c.current != nil {
+				before = maps.Clone(c.current)
+			}
+			assert.Equal(t, c.expected, mergeAgentTokenSecretData(c.current, c.rendered, c.recordedKeys))
+			assert.Equal(t, before, c.current, "current data must not be modified")
+		})
+	}
+}
+
+func TestMergeAgentTokenSecretMetadata(t *testing.T) {
+	cases := map[string]struct {
+		current      map[string]string
+		rendered     map[string]string
+		recordedKeys []string
+		expected     map[string]string
+	}{
+		"NewSecretMetadata": {
+			current:      nil,
+			rendered:     map[string]string{"team": "platform"},
+			recordedKeys: nil,
+			expected:     map[string]string{"team": "platform"},
+		},
+		"KeepForeignKeys": {
+			current:      map[string]string{"team": "old", "app.kubernetes.io/managed-by": "argocd"},
+			rendered:     map[string]string{"team": "platform"},
+			recordedKeys: []string{"team"},
+			expected:     map[string]string{"team": "platform", "app.kubernetes.io/managed-by": "argocd"},
+		},
+		"RemoveRecordedKeys": {
+			current:      map[string]string{"team": "platform", "env": "prod", "app.kubernetes.io/managed-by": "argocd"},
+			rendered:     map[string]string{"team": "platform"},
+			recordedKeys: []string{"env", "team"},
+			expected:     map[string]string{"team": "platform", "app.kubernetes.io/managed-by": "argocd"},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			var before map[string]string
+			if c.current != nil {
+				before = maps.Clone(c.current)
+			}
+			assert.Equal(t, c.expected, mergeAgentTokenSecretMetadata(c.current, c.rendered, c.recordedKeys))
+			assert.Equal(t, before, c.current, "current metadata must not be modified")
+		})
+	}
+}
+
+func TestAgentTokenNamespaceSelector(t *testing.T) {
+	instance := &appv1alpha2.AgentToken{
+		Spec: appv1alpha2.AgentTokenSpec{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"agent-tokens": "enabled"},
+			},
+		},
+	}
+	selector, err := agentTokenNamespaceSelector(instance)
+	assert.NoError(t, err)
+
+	cases := map[string]struct {
+		labels map[string]string
+		want   bool
+	}{
+		"SelectedAndAccepted": {
+			labels: map[string]string{"agent-tokens": "enabled", agentTokenAcceptSecretsLabel: "true"},
+			want:   true,
+		},
+		"SelectedNotAccepted": {
+			labels: map[string]string{"agent-tokens": "enabled"},
+			want:   false,
+		},
+		"SelectedRejected": {
+			labels: map[string]string{"agent-tokens": "enabled", agentTokenAcceptSecretsLabel: "false"},
+			want:   false,
+		},
+		"AcceptedNotSelected": {
+			labels: map[string]string{agentTokenAcceptSecretsLabel: "true"},
+			want:   false,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.want, selector.Matches(labels.Set(c.labels)))
+		})
+	}
+}
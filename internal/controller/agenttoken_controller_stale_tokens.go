@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const agentTokenKind = "AgentToken"
+
+// reconcileStaleTokens publishes the agent token metrics and records the agent tokens in the agent pool that have not been used
+// for the duration configured in `spec.staleTokens` in the status. If revocation is enabled, the stale tokens managed by this resource
+// are revoked and removed from the Kubernetes Secret, they are replaced with new ones on the next reconciliation.
+// Agent tokens that this resource does not manage are never revoked here, with the `owner` management policy they are removed regardless.
+func (r *AgentTokenReconciler) reconcileStaleTokens(ctx context.Context, t *agentTokenInstance) error {
+	list, err := t.tfClient.Client.AgentTokens.List(ctx, t.instance.Status.AgentPool.ID)
+	if err != nil {
+		return err
+	}
+
+	managed := make(map[string]struct{}, len(t.instance.Status.AgentTokens))
+	current := make(map[string]struct{}, len(t.instance.Status.AgentTokens))
+	for _, token := range t.instance.Status.AgentTokens {
+		managed[token.ID] = struct{}{}
+		current[token.ID] = struct{}{}
+		if token.PreviousID != "" {
+			managed[token.PreviousID] = struct{}{}
+		}
+	}
+
+	now := time.Now()
+	spec := t.instance.Spec.StaleTokens
+	if spec == nil {
+		t.instance.Status.StaleTokens = nil
+		setAgentTokenMetrics(agentTokenKind, &t.instance, t.instance.Status.AgentPool.ID, list.Items, managed, 0, now)
+		return nil
+	}
+
+	stale := staleAgentTokens(list.Items, managed, spec.UnusedFor.Duration, now)
+	setAgentTokenMetrics(agentTokenKind, &t.instance, t.instance.Status.AgentPool.ID, list.Items, managed, len(stale), now)
+	t.instance.Status.StaleTokens = stale
+
+	if !spec.Revoke {
+		return nil
+	}
+
+	// Revoked tokens no longer exist, thus only the remaining ones are reported.
+	remaining := stale[:0]
+	for _, token := range stale {
+		// Tokens replaced during a rotation are revoked by the rotation.
+		if _, ok := current[token.ID]; !ok {
+			remaining = append(remaining, token)
+			continue
+		}
+		t.log.Info("Reconcile Agent Token", "msg", fmt.Sprintf("revoking stale agent token %q %q", token.Name, token.ID))
+		if err := r.removeToken(ctx, t, token.ID); err != nil {
+			return err
+		}
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "RevokeStaleAgentToken", "Revoked agent token %s ID %s that has not been used for %s", token.Name, token.ID, spec.UnusedFor.Duration)
+	}
+	t.instance.Status.StaleTokens = remaining
+
+	return nil
+}
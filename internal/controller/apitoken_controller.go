@@ -0,0 +1,535 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/version"
+)
+
+// APITokenReconciler reconciles a APIToken object
+type APITokenReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+	Scheme   *runtime.Scheme
+}
+
+type apiTokenInstance struct {
+	instance appv1alpha2.APIToken
+
+	log      logr.Logger
+	tfClient HCPTerraformClient
+}
+
+// issuedAPIToken is a team or organization API token returned by HCP Terraform on creation.
+type issuedAPIToken struct {
+	ID        string
+	Token     string
+	CreatedAt time.Time
+	ExpiredAt time.Time
+}
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=apitokens,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.terraform.io,resources=apitokens/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=apitokens/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=create;list;update;watch;patch
+
+func (r *APITokenReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	t := apiTokenInstance{}
+
+	t.log = log.Log.WithValues("apitoken", req.NamespacedName)
+	t.log.Info("API Token Controller", "msg", "new reconciliation event")
+
+	err := r.Client.Get(ctx, req.NamespacedName, &t.instance)
+	if err != nil {
+		// 'Not found' error occurs when an object is removed from the Kubernetes
+		// No actions are required in this case
+		if kerrors.IsNotFound(err) {
+			t.log.Info("API Token Controller", "msg", "the instance was removed no further action is required")
+			return doNotRequeue()
+		}
+		t.log.Error(err, "API Token Controller", "msg", "get instance object")
+		return requeueAfter(requeueInterval)
+	}
+
+	if a, ok := t.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
+		t.log.Info("API Token Controller", "msg", "reconciliation is paused for this resource")
+		return doNotRequeue()
+	}
+
+	t.log.Info("Spec Validation", "msg", "validating instance object spec")
+	if err := t.instance.ValidateSpec(); err != nil {
+		t.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		return doNotRequeue()
+	}
+	t.log.Info("Spec Validation", "msg", "spec is valid")
+
+	if needToAddFinalizer(&t.instance, apiTokenFinalizer) {
+		err := r.addFinalizer(ctx, &t.instance)
+		if err != nil {
+			t.log.Error(err, "API Token Controller", "msg", fmt.Sprintf("failed to add finalizer %s to the object", apiTokenFinalizer))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "AddFinalizer", "Failed to add finalizer %s to the object", apiTokenFinalizer)
+			return requeueOnErr(err)
+		}
+		t.log.Info("API Token Controller", "msg", fmt.Sprintf("successfully added finalizer %s to the object", apiTokenFinalizer))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "AddFinalizer", "Successfully added finalizer %s to the object", apiTokenFinalizer)
+	}
+
+	err = r.getTerraformClient(ctx, &t)
+	if err != nil {
+		t.log.Error(err, "API Token Controller", "msg", "failed to get HCP Terraform client")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		return requeueAfter(requeueInterval)
+	}
+
+	err = r.reconcileAPIToken(ctx, &t)
+	if err != nil {
+		t.log.Error(err, "API Token Controller", "msg", "reconcile API token")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileAPIToken", "Failed to reconcile API token")
+		return requeueAfter(requeueInterval)
+	}
+	t.log.Info("API Token Controller", "msg", "successfully reconcilied API token")
+	r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "ReconcileAPIToken", "Successfully reconcilied API token ID %s", t.instance.Status.ID)
+
+	return requeueAfter(APITokenSyncPeriod)
+}
+
+func (r *APITokenReconciler) addFinalizer(ctx context.Context, instance *appv1alpha2.APIToken) error {
+	controllerutil.AddFinalizer(instance, apiTokenFinalizer)
+
+	return r.Update(ctx, instance)
+}
+
+func (r *APITokenReconciler) getTerraformClient(ctx context.Context, t *apiTokenInstance) error {
+	nn := types.NamespacedName{
+		Namespace: t.instance.Namespace,
+		Name:      t.instance.Spec.Token.SecretKeyRef.Name,
+	}
+	token, err := secretKeyRef(ctx, r.Client, nn, t.instance.Spec.Token.SecretKeyRef.Key)
+	if err != nil {
+		return err
+	}
+
+	httpClient := tfc.DefaultConfig().HTTPClient
+	insecure := false
+
+	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
+		insecure, err = strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if insecure {
+		t.log.Info("Reconcile API Token", "msg", "client configured to skip TLS certificate verifications")
+	}
+
+	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
+
+	config := &tfc.Config{
+		Token:      token,
+		HTTPClient: httpClient,
+		Headers: http.Header{
+			"User-Agent": []string{version.UserAgent},
+		},
+	}
+	t.tfClient.Client, err = tfc.NewClient(config)
+
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *APITokenReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.APIToken{}, builder.WithPredicates(predicate.Or(genericPredicates()))).
+		Owns(&corev1.Secret{}, builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
+		Complete(r)
+}
+
+func (r *APITokenReconciler) removeFinalizer(ctx context.Context, t *apiTokenInstance) error {
+	controllerutil.RemoveFinalizer(&t.instance, apiTokenFinalizer)
+
+	err := r.Update(ctx, &t.instance)
+	if err != nil {
+		t.log.Error(err, "Reconcile API Token", "msg", fmt.Sprintf("failed to remove finalizer %s", apiTokenFinalizer))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "RemoveAPIToken", "Failed to remove finalizer %s", apiTokenFinalizer)
+	}
+
+	return err
+}
+
+func (r *APITokenReconciler) getTeamID(ctx context.Context, t *apiTokenInstance) (string, error) {
+	spec := t.instance.Spec.Team
+
+	if spec.ID != "" {
+		return spec.ID, nil
+	}
+
+	listOpts := &tfc.TeamListOptions{
+		Names: []string{spec.Name},
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		tl, err := t.tfClient.Client.Teams.List(ctx, t.instance.Spec.Organization, listOpts)
+		if err != nil {
+			return "", err
+		}
+		for _, team := range tl.Items {
+			if team.Name == spec.Name {
+				return team.ID, nil
+			}
+		}
+		if tl.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = tl.NextPage
+	}
+
+	return "", fmt.Errorf("team ID not found for team name %q", spec.Name)
+}
+
+// isTeamToken returns true if the object issues team API tokens.
+func isTeamToken(t *apiTokenInstance) bool {
+	return t.instance.Spec.Team != nil
+}
+
+// issueToken creates a new API token with the expiration date based on the rotation settings.
+// Team API tokens get a unique description, so a team can have multiple valid tokens during the overlap period.
+// A new organization API token replaces the previous one right away.
+func (r *APITokenReconciler) issueToken(ctx context.Context, t *apiTokenInstance) (*issuedAPIToken, error) {
+	expiredAt := time.Now().Add(time.Duration(*t.instance.Spec.Rotation.ExpirationPeriodSeconds) * time.Second)
+
+	if isTeamToken(t) {
+		tt, err := t.tfClient.Client.TeamTokens.CreateWithOptions(ctx, t.instance.Status.TeamID, tfc.TeamTokenCreateOptions{
+			ExpiredAt:   &expiredAt,
+			Description: tfc.String(fmt.Sprintf("%s/%s %d", t.instance.Namespace, t.instance.Name, time.Now().Unix())),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &issuedAPIToken{
+			ID:        tt.ID,
+			Token:     tt.Token,
+			CreatedAt: tt.CreatedAt,
+			ExpiredAt: tt.ExpiredAt,
+		}, nil
+	}
+
+	ot, err := t.tfClient.Client.OrganizationTokens.CreateWithOptions(ctx, t.instance.Spec.Organization, tfc.OrganizationTokenCreateOptions{
+		ExpiredAt: &expiredAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &issuedAPIToken{
+		ID:        ot.ID,
+		Token:     ot.Token,
+		CreatedAt: ot.CreatedAt,
+		ExpiredAt: ot.ExpiredAt,
+	}, nil
+}
+
+// revokeToken revokes the API token with the given ID.
+// Organization API tokens are revoked only if the given ID is the current organization token.
+func (r *APITokenReconciler) revokeToken(ctx context.Context, t *apiTokenInstance, id string) error {
+	if isTeamToken(t) {
+		err := t.tfClient.Client.TeamTokens.DeleteByID(ctx, id)
+		if err != nil && err != tfc.ErrResourceNotFound {
+			return err
+		}
+		return nil
+	}
+
+	ot, err := t.tfClient.Client.OrganizationTokens.Read(ctx, t.instance.Spec.Organization)
+	if err != nil {
+		if err == tfc.ErrResourceNotFound {
+			return nil
+		}
+		return err
+	}
+	if ot.ID != id {
+		return nil
+	}
+	err = t.tfClient.Client.OrganizationTokens.Delete(ctx, t.instance.Spec.Organization)
+	if err != nil && err != tfc.ErrResourceNotFound {
+		return err
+	}
+
+	return nil
+}
+
+// tokenExists returns true if the current API token is still valid in HCP Terraform.
+func (r *APITokenReconciler) tokenExists(ctx context.Context, t *apiTokenInstance) (bool, error) {
+	if isTeamToken(t) {
+		_, err := t.tfClient.Client.TeamTokens.ReadByID(ctx, t.instance.Status.ID)
+		if err != nil {
+			if err == tfc.ErrResourceNotFound {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+
+	ot, err := t.tfClient.Client.OrganizationTokens.Read(ctx, t.instance.Spec.Organization)
+	if err != nil {
+		if err == tfc.ErrResourceNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return ot.ID == t.instance.Status.ID, nil
+}
+
+// secretTokenID returns the ID of the API token stored in the Secret.
+// It returns an empty string if the Secret does not exist or does not contain the API token key.
+func (r *APITokenReconciler) secretTokenID(ctx context.Context, t *apiTokenInstance) (string, error) {
+	s := &corev1.Secret{}
+	nn := types.NamespacedName{
+		Namespace: t.instance.Namespace,
+		Name:      t.instance.Spec.SecretName,
+	}
+	if err := r.Client.Get(ctx, nn, s); err != nil {
+		if kerrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return storedAPITokenID(s, t.instance.Spec.SecretKey), nil
+}
+
+// storedAPITokenID returns the ID of the API token stored in the Secret under the given key, or an empty string if there is none.
+func storedAPITokenID(s *corev1.Secret, key string) string {
+	if _, ok := s.Data[key]; !ok {
+		return ""
+	}
+
+	return s.Annotations[apiTokenAnnotationTokenID]
+}
+
+func (r *APITokenReconciler) writeSecret(ctx context.Context, t *apiTokenInstance, token *issuedAPIToken) error {
+	nn := types.NamespacedName{
+		Namespace: t.instance.Namespace,
+		Name:      t.instance.Spec.SecretName,
+	}
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: nn.Namespace,
+			Name:      nn.Name,
+		},
+	}
+	_, err := controllerutil.CreateOrPatch(ctx, r.Client, s, func() error {
+		if err := controllerutil.SetControllerReference(&t.instance, s, r.Scheme); err != nil {
+			t.log.Error(err, "Reconcile API Token", "msg", fmt.Sprintf("failed to set controller reference to secret=%q namespace=%q", nn.Name, nn.Namespace))
+			return err
+		}
+		if s.Annotations == nil {
+			s.Annotations = make(map[string]string)
+		}
+		s.Annotations[apiTokenAnnotationTokenID] = token.ID
+		s.Annotations[apiTokenAnnotationTokenExpiredAt] = token.ExpiredAt.UTC().Format(time.RFC3339)
+		if s.Data == nil {
+			s.Data = make(map[string][]byte)
+		}
+		s.Data[t.instance.Spec.SecretKey] = []byte(token.Token)
+		return nil
+	})
+	if err != nil {
+		t.log.Error(err, "Reconcile API Token", "msg", fmt.Sprintf("unable to write key=%q in secret=%q namespace=%q", t.instance.Spec.SecretKey, nn.Name, nn.Namespace))
+		return err
+	}
+	t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("successfully wrote key=%q in secret=%q namespace=%q", t.instance.Spec.SecretKey, nn.Name, nn.Namespace))
+
+	return nil
+}
+
+// rotateToken issues a new API token, writes it to the Secret, and schedules the revocation of the previous one.
+// The previous team API token stays valid for the overlap period.
+func (r *APITokenReconciler) rotateToken(ctx context.Context, t *apiTokenInstance) error {
+	previousID := t.instance.Status.ID
+
+	// revoke the API token that is still in the overlap period from the previous rotation, otherwise the operator loses track of it
+	if t.instance.Status.PreviousID != "" && t.instance.Status.PreviousID != previousID {
+		if err := r.revokeToken(ctx, t, t.instance.Status.PreviousID); err != nil {
+			t.log.Error(err, "Reconcile API Token", "msg", fmt.Sprintf("failed to revoke the previous API token ID %s", t.instance.Status.PreviousID))
+			return err
+		}
+		t.instance.Status.PreviousID = ""
+		t.instance.Status.PreviousRevokeAt = nil
+	}
+
+	token, err := r.issueToken(ctx, t)
+	if err != nil {
+		t.log.Error(err, "Reconcile API Token", "msg", "failed to issue a new API token")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileAPIToken", "Failed to issue a new API token")
+		return err
+	}
+	t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("successfully issued a new API token ID %s", token.ID))
+
+	// Persist the new API token ID before writing the Secret to avoid losing track of it.
+	now := metav1.Now()
+	t.instance.Status.ID = token.ID
+	t.instance.Status.CreatedAt = &metav1.Time{Time: token.CreatedAt}
+	t.instance.Status.ExpiredAt = &metav1.Time{Time: token.ExpiredAt}
+	if previousID != "" {
+		t.instance.Status.RotatedAt = &now
+		// An organization can have only one organization API token, the previous one is already revoked.
+		if isTeamToken(t) && previousID != token.ID {
+			t.instance.Status.PreviousID = previousID
+			t.instance.Status.PreviousRevokeAt = &metav1.Time{Time: now.Add(time.Duration(*t.instance.Spec.Rotation.OverlapSeconds) * time.Second)}
+		}
+	}
+	if err := r.Status().Update(ctx, &t.instance); err != nil {
+		return err
+	}
+
+	return r.writeSecret(ctx, t, token)
+}
+
+// needToRotate returns true if the current API token expires within the renewal window.
+func needToRotate(t *apiTokenInstance) bool {
+	if t.instance.Status.ExpiredAt == nil {
+		return true
+	}
+	renewAt := t.instance.Status.ExpiredAt.Add(-time.Duration(*t.instance.Spec.Rotation.RenewBeforeSeconds) * time.Second)
+
+	return !time.Now().Before(renewAt)
+}
+
+func (r *APITokenReconciler) reconcileAPIToken(ctx context.Context, t *apiTokenInstance) error {
+	t.log.Info("Reconcile API Token", "msg", "reconciling API token")
+
+	// verify whether the Kubernetes object has been marked as deleted and if so revoke the API tokens
+	if isDeletionCandidate(&t.instance, apiTokenFinalizer) {
+		t.log.Info("Reconcile API Token", "msg", "object marked as deleted, need to revoke API tokens first")
+		r.Recorder.Event(&t.instance, corev1.EventTypeNormal, "ReconcileAPIToken", "Object marked as deleted, need to revoke API tokens first")
+		return r.deleteAPIToken(ctx, t)
+	}
+
+	if isTeamToken(t) {
+		teamID, err := r.getTeamID(ctx, t)
+		if err != nil {
+			t.log.Error(err, "Reconcile API Token", "msg", "failed to get team ID")
+			r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileAPIToken", "Failed to get team ID")
+			return err
+		}
+		t.instance.Status.TeamID = teamID
+	} else {
+		t.instance.Status.TeamID = ""
+	}
+
+	// issue a new API token if the API token ID is unknown(means it was never issued by the controller)
+	if t.instance.Status.ID == "" {
+		t.log.Info("Reconcile API Token", "msg", "status.ID is empty, issuing a new API token")
+		r.Recorder.Event(&t.instance, corev1.EventTypeNormal, "ReconcileAPIToken", "Status.ID is empty, issuing a new API token")
+		if err := r.rotateToken(ctx, t); err != nil {
+			return err
+		}
+		return r.updateStatus(ctx, t)
+	}
+
+	exists, err := r.tokenExists(ctx, t)
+	if err != nil {
+		t.log.Error(err, "Reconcile API Token", "msg", fmt.Sprintf("failed to read API token ID %s", t.instance.Status.ID))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileAPIToken", "Failed to read API token ID %s", t.instance.Status.ID)
+		return err
+	}
+	// HCP Terraform returns the API token value only once, on creation.
+	// If the Secret lost the value, the only way to recover is to issue a new API token.
+	// The Secret does not hold the current API token if writing it failed after the API token was issued.
+	secretTokenID, err := r.secretTokenID(ctx, t)
+	if err != nil {
+		t.log.Error(err, "Reconcile API Token", "msg", fmt.Sprintf("failed to get secret %s", t.instance.Spec.SecretName))
+		return err
+	}
+	hasSecret := secretTokenID == t.instance.Status.ID
+
+	switch {
+	case !exists:
+		t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("API token ID %s not found, issuing a new API token", t.instance.Status.ID))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileAPIToken", "API token ID %s not found, issuing a new API token", t.instance.Status.ID)
+		// there is nothing to revoke, the API token does not exist anymore
+		t.instance.Status.ID = ""
+		if err := r.rotateToken(ctx, t); err != nil {
+			return err
+		}
+	case !hasSecret:
+		t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("secret %s does not contain the API token ID %s, rotating API token", t.instance.Spec.SecretName, t.instance.Status.ID))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "RotateAPIToken", "Secret %s does not contain the API token ID %s, rotating API token", t.instance.Spec.SecretName, t.instance.Status.ID)
+		// The Secret still holds the previous API token, revoke the current one that nobody has received
+		// and keep the previous one valid until the new API token is written and the overlap period is over.
+		if secretTokenID != "" && secretTokenID == t.instance.Status.PreviousID {
+			if err := r.revokeToken(ctx, t, t.instance.Status.ID); err != nil {
+				t.log.Error(err, "Reconcile API Token", "msg", fmt.Sprintf("failed to revoke the unused API token ID %s", t.instance.Status.ID))
+				return err
+			}
+			t.instance.Status.ID = t.instance.Status.PreviousID
+			t.instance.Status.PreviousID = ""
+			t.instance.Status.PreviousRevokeAt = nil
+		}
+		if err := r.rotateToken(ctx, t); err != nil {
+			return err
+		}
+	case needToRotate(t):
+		t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("API token ID %s expires at %s, rotating API token", t.instance.Status.ID, t.instance.Status.ExpiredAt))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "RotateAPIToken", "API token ID %s expires at %s, rotating API token", t.instance.Status.ID, t.instance.Status.ExpiredAt)
+		if err := r.rotateToken(ctx, t); err != nil {
+			return err
+		}
+		t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("successfully rotated API token, new API token ID %s", t.instance.Status.ID))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "RotateAPIToken", "Successfully rotated API token, new API token ID %s", t.instance.Status.ID)
+	default:
+		t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("API token ID %s is valid, no need to rotate", t.instance.Status.ID))
+	}
+
+	// revoke the previous API token once the overlap period is over
+	if t.instance.Status.PreviousID != "" && t.instance.Status.PreviousRevokeAt != nil && !time.Now().Before(t.instance.Status.PreviousRevokeAt.Time) {
+		t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("overlap period is over, revoking the previous API token ID %s", t.instance.Status.PreviousID))
+		if err := r.revokeToken(ctx, t, t.instance.Status.PreviousID); err != nil {
+			t.log.Error(err, "Reconcile API Token", "msg", fmt.Sprintf("failed to revoke the previous API token ID %s", t.instance.Status.PreviousID))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "RevokeAPIToken", "Failed to revoke the previous API token ID %s", t.instance.Status.PreviousID)
+			return err
+		}
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "RevokeAPIToken", "Successfully revoked the previous API token ID %s", t.instance.Status.PreviousID)
+		t.instance.Status.PreviousID = ""
+		t.instance.Status.PreviousRevokeAt = nil
+	}
+
+	return r.updateStatus(ctx, t)
+}
+
+func (r *APITokenReconciler) updateStatus(ctx context.Context, t *apiTokenInstance) error {
+	t.instance.Status.ObservedGeneration = t.instance.Generation
+
+	return r.Status().Update(ctx, &t.instance)
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func (r *APITokenReconciler) deleteAPIToken(ctx context.Context, t *apiTokenInstance) error {
+	t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("deletion policy is %s", t.instance.Spec.DeletionPolicy))
+
+	// The retained API token stays in the Secret, the Secret must not be removed by Kubernetes garbage collection.
+	if t.instance.Spec.DeletionPolicy == appv1alpha2.APITokenDeletionPolicyRetain {
+		if err := r.releaseSecret(ctx, t); err != nil {
+			t.log.Error(err, "Reconcile API Token", "msg", fmt.Sprintf("failed to release secret=%q, retry later", t.instance.Spec.SecretName))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileAPIToken", "Failed to release Secret %s, retry later", t.instance.Spec.SecretName)
+			return err
+		}
+	}
+
+	if t.instance.Status.ID == "" && t.instance.Status.PreviousID == "" {
+		t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("status.ID is empty, remove finalizer %s", apiTokenFinalizer))
+		return r.removeFinalizer(ctx, t)
+	}
+
+	switch t.instance.Spec.DeletionPolicy {
+	case appv1alpha2.APITokenDeletionPolicyRetain:
+		t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("remove finalizer %s", apiTokenFinalizer))
+		return r.removeFinalizer(ctx, t)
+	case appv1alpha2.APITokenDeletionPolicyDestroy:
+		for _, id := range []string{t.instance.Status.PreviousID, t.instance.Status.ID} {
+			if id == "" {
+				continue
+			}
+			if err := r.revokeToken(ctx, t, id); err != nil {
+				t.log.Error(err, "Reconcile API Token", "msg", fmt.Sprintf("failed to revoke API token ID %s, retry later", id))
+				r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileAPIToken", "Failed to revoke API token ID %s, retry later", id)
+				return err
+			}
+			t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("API token ID %s has been revoked", id))
+		}
+
+		t.log.Info("Reconcile API Token", "msg", "API tokens have been revoked, remove finalizer")
+		return r.removeFinalizer(ctx, t)
+	}
+
+	return nil
+}
+
+// releaseSecret removes the owner reference of the object from the Secret, so that the Secret is kept once the object is deleted.
+func (r *APITokenReconciler) releaseSecret(ctx context.Context, t *apiTokenInstance) error {
+	s := &corev1.Secret{}
+	nn := types.NamespacedName{
+		Namespace: t.instance.Namespace,
+		Name:      t.instance.Spec.SecretName,
+	}
+	if err := r.Client.Get(ctx, nn, s); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	owned, err := controllerutil.HasOwnerReference(s.OwnerReferences, &t.instance, r.Scheme)
+	if err != nil || !owned {
+		return err
+	}
+
+	patch := client.MergeFrom(s.DeepCopy())
+	if err := controllerutil.RemoveOwnerReference(&t.instance, s, r.Scheme); err != nil {
+		return err
+	}
+	t.log.Info("Reconcile API Token", "msg", fmt.Sprintf("remove owner reference from secret=%q namespace=%q", nn.Name, nn.Namespace))
+
+	return r.Patch(ctx, s, patch)
+}
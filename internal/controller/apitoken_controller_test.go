@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestStoredAPITokenID(t *testing.T) {
+	cases := map[string]struct {
+		secret   *corev1.Secret
+		expected string
+	}{
+		"HasTokenAndID": {
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{apiTokenAnnotationTokenID: "at-1"}},
+				Data:       map[string][]byte{"token": []byte("secret")},
+			},
+			expected: "at-1",
+		},
+		"HasNoToken": {
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{apiTokenAnnotationTokenID: "at-1"}},
+				Data:       map[string][]byte{"other": []byte("secret")},
+			},
+			expected: "",
+		},
+		"HasNoID": {
+			secret: &corev1.Secret{
+				Data: map[string][]byte{"token": []byte("secret")},
+			},
+			expected: "",
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.expected, storedAPITokenID(c.secret, "token"))
+		})
+	}
+}
+
+func TestReleaseSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, appv1alpha2.AddToScheme(scheme))
+
+	instance := &appv1alpha2.APIToken{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "this", UID: "uid-1"},
+		Spec:       appv1alpha2.APITokenSpec{SecretName: "this"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "this"},
+		Data:       map[string][]byte{"token": []byte("secret")},
+	}
+	require.NoError(t, controllerutil.SetControllerReference(instance, secret, scheme))
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+	r := &APITokenReconciler{Client: k8sClient, Scheme: scheme}
+	a := &apiTokenInstance{instance: *instance, log: logr.Discard()}
+
+	ctx := context.Background()
+	require.NoError(t, r.releaseSecret(ctx, a))
+	got := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), got))
+	assert.Empty(t, got.OwnerReferences)
+	assert.Equal(t, []byte("secret"), got.Data["token"])
+
+	// Nothing to do once the Secret is released or does not exist.
+	require.NoError(t, r.releaseSecret(ctx, a))
+	require.NoError(t, k8sClient.Delete(ctx, got))
+	require.NoError(t, r.releaseSecret(ctx, a))
+}
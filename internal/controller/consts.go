@@ -30,6 +30,14 @@ const (
 	agentTokenFinalizer = "agenttoken.app.terraform.io/finalizer"
 )
 
+// API TOKEN CONTROLLER'S CONSTANTS
+const (
+	apiTokenFinalizer = "apitoken.app.terraform.io/finalizer"
+
+	apiTokenAnnotationTokenID        = "app.terraform.io/token-id"
+	apiTokenAnnotationTokenExpiredAt = "app.terraform.io/token-expired-at"
+)
+
 // MODULE CONTROLLER'S CONSTANTS
 const (
 	requeueConfigurationUploadInterval = 10 * time.Second
@@ -69,16 +77,31 @@ output "{{ $o.Name }}" {
 `
 )
 
+// NO-CODE WORKSPACE CONTROLLER'S CONSTANTS
+const (
+	noCodeWorkspaceFinalizer = "nocodeworkspace.app.terraform.io/finalizer"
+)
+
 // PROJECT CONTROLLER'S CONSTANTS
 const (
 	projectFinalizer = "project.app.terraform.io/finalizer"
 )
 
+// REGISTRY MODULE CONTROLLER'S CONSTANTS
+const (
+	registryModuleFinalizer = "registrymodule.app.terraform.io/finalizer"
+)
+
 // RUNS COLLECTOR CONTROLLER'S CONSTANTS
 const (
 	runsCollectorFinalizer = "runscollector.app.terraform.io/finalizer"
 )
 
+// SSH KEY CONTROLLER'S CONSTANTS
+const (
+	sshKeyFinalizer = "organizationsshkey.app.terraform.io/finalizer"
+)
+
 // WORKSPACE CONTROLLER'S CONSTANTS
 const (
 	workspaceFinalizerAlpha1 = "finalizer.workspace.app.terraform.io"
@@ -88,6 +111,12 @@ const (
 	WorkspaceAnnotationRunType             = "workspace.app.terraform.io/run-type"
 	WorkspaceAnnotationRunTerraformVersion = "workspace.app.terraform.io/run-terraform-version"
 
+	WorkspaceAnnotationRunOverride              = "workspace.app.terraform.io/run-override"
+	WorkspaceAnnotationRunOverrideJustification = "workspace.app.terraform.io/run-override-justification"
+
+	runOverrideResultOverridden = "overridden"
+	runOverrideResultRejected   = "rejected"
+
 	RunTypePlan    = "plan"
 	RunTypeApply   = "apply"
 	RunTypeRefresh = "refresh"
@@ -8,10 +8,15 @@ import (
 )
 
 var (
-	AgentPoolSyncPeriod     time.Duration
-	AgentTokenSyncPeriod    time.Duration
-	ModuleSyncPeriod        time.Duration
-	ProjectSyncPeriod       time.Duration
-	RunsCollectorSyncPeriod time.Duration
-	WorkspaceSyncPeriod     time.Duration
+	AgentPoolSyncPeriod       time.Duration
+	AgentTokenSyncPeriod      time.Duration
+	APITokenSyncPeriod        time.Duration
+	ModuleSyncPeriod          time.Duration
+	NoCodeWorkspaceSyncPeriod time.Duration
+	OrganizationSyncPeriod    time.Duration
+	ProjectSyncPeriod         time.Duration
+	RegistryModuleSyncPeriod  time.Duration
+	RunsCollectorSyncPeriod   time.Duration
+	SSHKeySyncPeriod          time.Duration
+	WorkspaceSyncPeriod       time.Duration
 )
@@ -8,16 +8,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	tfc "github.com/hashicorp/go-tfe"
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
 )
 
 func doNotRequeue() (reconcile.Result, error) {
@@ -122,3 +127,118 @@ func useRunsEndpoint(version string) (bool, error) {
 
 	return false, fmt.Errorf("malformed TFE version %s", version)
 }
+
+// defaultAgentTokenOverlapPeriod is the period during which a replaced agent token remains valid
+// when the rotation policy does not set it.
+const defaultAgentTokenOverlapPeriod = time.Hour
+
+// agentTokenNeedsRotation reports whether the agent token is older than the maximum age of the rotation policy.
+// A token is not rotated again until the token it replaced is revoked.
+func agentTokenNeedsRotation(t *appv1alpha2.AgentAPIToken, r *appv1alpha2.AgentTokenRotation, now time.Time) bool {
+	if r == nil || t.CreatedAt == nil || t.PreviousID != "" {
+		return false
+	}
+
+	return now.Sub(time.Unix(*t.CreatedAt, 0)) >= r.MaxAge.Duration
+}
+
+// previousAgentTokenRevocable reports whether the agent token replaced during the last rotation can be revoked.
+// It is when the overlap period has elapsed and the agent token has been used since the rotation.
+func previousAgentTokenRevocable(t *appv1alpha2.AgentAPIToken, r *appv1alpha2.AgentTokenRotation, lastUsedAt, now time.Time) bool {
+	if t.PreviousID == "" || t.RotatedAt == nil {
+		return false
+	}
+
+	overlap := defaultAgentTokenOverlapPeriod
+	if r != nil && r.OverlapPeriod != nil {
+		overlap = r.OverlapPeriod.Duration
+	}
+	rotatedAt := time.Unix(*t.RotatedAt, 0)
+
+	return now.Sub(rotatedAt) >= overlap && !lastUsedAt.Before(rotatedAt)
+}
+
+// rotateAgentTokenStatus records the replacement agent token in the status of the replaced one.
+func rotateAgentTokenStatus(t *appv1alpha2.AgentAPIToken, at *tfc.AgentToken, now time.Time) {
+	t.PreviousID = t.ID
+	t.ID = at.ID
+	t.CreatedAt = pointer.PointerOf(at.CreatedAt.Unix())
+	t.LastUsedAt = pointer.PointerOf(at.LastUsedAt.Unix())
+	t.RotatedAt = pointer.PointerOf(now.Unix())
+}
+
+// agentTokenLastActivity returns when the agent token was last used, or created if it has never been used.
+func agentTokenLastActivity(t *tfc.AgentToken) time.Time {
+	if t.LastUsedAt.After(t.CreatedAt) {
+		return t.LastUsedAt
+	}
+	return t.CreatedAt
+}
+
+// staleAgentTokens returns the agent tokens that have not been used for the given duration, ordered by ID.
+// The managed set contains IDs of the agent tokens managed by the controller.
+func staleAgentTokens(tokens []*tfc.AgentToken, managed map[string]struct{}, unusedFor time.Duration, now time.Time) []appv1alpha2.StaleAgentToken {
+	var stale []appv1alpha2.StaleAgentToken
+	for _, t := range tokens {
+		if now.Sub(agentTokenLastActivity(t)) < unusedFor {
+			continue
+		}
+		st := appv1alpha2.StaleAgentToken{
+			ID:        t.ID,
+			Name:      t.Description,
+			CreatedAt: pointer.PointerOf(t.CreatedAt.Unix()),
+		}
+		if !t.LastUsedAt.IsZero() {
+			st.LastUsedAt = pointer.PointerOf(t.LastUsedAt.Unix())
+		}
+		_, st.Managed = managed[t.ID]
+		stale = append(stale, st)
+	}
+	slices.SortFunc(stale, func(a, b appv1alpha2.StaleAgentToken) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return stale
+}
+
+// agentTokenMetricLabels returns the labels that identify the agent token metrics of the object of the given kind.
+func agentTokenMetricLabels(kind string, o client.Object) prometheus.Labels {
+	return prometheus.Labels{
+		"kind":      kind,
+		"namespace": o.GetNamespace(),
+		"name":      o.GetName(),
+	}
+}
+
+// deleteAgentTokenMetrics removes the agent token metrics of the object of the given kind.
+func deleteAgentTokenMetrics(kind string, o client.Object) {
+	l := agentTokenMetricLabels(kind, o)
+	MetricAgentTokenAge.DeletePartialMatch(l)
+	MetricAgentTokenLastUsed.DeletePartialMatch(l)
+	MetricAgentTokensStale.DeletePartialMatch(l)
+}
+
+// setAgentTokenMetrics publishes the age and last use of the agent tokens in the agent pool, and the number of stale tokens.
+// The metrics of agent tokens that no longer exist are removed.
+func setAgentTokenMetrics(kind string, o client.Object, poolID string, tokens []*tfc.AgentToken, managed map[string]struct{}, stale int, now time.Time) {
+	deleteAgentTokenMetrics(kind, o)
+
+	for _, t := range tokens {
+		l := agentTokenMetricLabels(kind, o)
+		l["agent_pool_id"] = poolID
+		l["token_id"] = t.ID
+		l["token_name"] = t.Description
+		_, m := managed[t.ID]
+		l["managed"] = strconv.FormatBool(m)
+
+		MetricAgentTokenAge.With(l).Set(now.Sub(t.CreatedAt).Seconds())
+		var lastUsed float64
+		if !t.LastUsedAt.IsZero() {
+			lastUsed = float64(t.LastUsedAt.Unix())
+		}
+		MetricAgentTokenLastUsed.With(l).Set(lastUsed)
+	}
+
+	l := agentTokenMetricLabels(kind, o)
+	l["agent_pool_id"] = poolID
+	MetricAgentTokensStale.With(l).Set(float64(stale))
+}
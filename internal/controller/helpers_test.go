@@ -9,10 +9,15 @@ import (
 	"time"
 
 	tfc "github.com/hashicorp/go-tfe"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
 )
 
 type TestObject struct {
@@ -332,3 +337,93 @@ func TestUseRunsEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestAgentTokenRotation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	rotation := &appv1alpha2.AgentTokenRotation{
+		MaxAge:        metav1.Duration{Duration: 24 * time.Hour},
+		OverlapPeriod: &metav1.Duration{Duration: time.Hour},
+	}
+
+	t.Run("NeedsRotation", func(t *testing.T) {
+		old := &appv1alpha2.AgentAPIToken{ID: "at-new", CreatedAt: pointer.PointerOf(now.Add(-25 * time.Hour).Unix())}
+		fresh := &appv1alpha2.AgentAPIToken{ID: "at-new", CreatedAt: pointer.PointerOf(now.Add(-time.Hour).Unix())}
+		pending := &appv1alpha2.AgentAPIToken{ID: "at-new", PreviousID: "at-old", CreatedAt: pointer.PointerOf(now.Add(-25 * time.Hour).Unix())}
+
+		assert.True(t, agentTokenNeedsRotation(old, rotation, now))
+		assert.False(t, agentTokenNeedsRotation(fresh, rotation, now))
+		assert.False(t, agentTokenNeedsRotation(pending, rotation, now))
+		assert.False(t, agentTokenNeedsRotation(old, nil, now))
+	})
+
+	t.Run("PreviousRevocable", func(t *testing.T) {
+		rotatedAt := now.Add(-2 * time.Hour)
+		token := &appv1alpha2.AgentAPIToken{ID: "at-new", PreviousID: "at-old", RotatedAt: pointer.PointerOf(rotatedAt.Unix())}
+		recent := &appv1alpha2.AgentAPIToken{ID: "at-new", PreviousID: "at-old", RotatedAt: pointer.PointerOf(now.Add(-time.Minute).Unix())}
+
+		assert.True(t, previousAgentTokenRevocable(token, rotation, now.Add(-time.Minute), now))
+		assert.False(t, previousAgentTokenRevocable(token, rotation, time.Time{}, now), "new token has not been used")
+		assert.False(t, previousAgentTokenRevocable(recent, rotation, now, now), "overlap period has not elapsed")
+		assert.True(t, previousAgentTokenRevocable(token, nil, now, now), "default overlap period has elapsed")
+		assert.False(t, previousAgentTokenRevocable(&appv1alpha2.AgentAPIToken{ID: "at-new"}, rotation, now, now))
+	})
+
+	t.Run("RotateStatus", func(t *testing.T) {
+		token := &appv1alpha2.AgentAPIToken{Name: "this", ID: "at-old"}
+		rotateAgentTokenStatus(token, &tfc.AgentToken{ID: "at-new", CreatedAt: now}, now)
+		assert.Equal(t, "at-new", token.ID)
+		assert.Equal(t, "at-old", token.PreviousID)
+		assert.Equal(t, now.Unix(), *token.RotatedAt)
+		assert.Equal(t, now.Unix(), *token.CreatedAt)
+	})
+}
+
+func TestStaleAgentTokens(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	unusedFor := 24 * time.Hour
+	tokens := []*tfc.AgentToken{
+		{ID: "at-used", Description: "used", CreatedAt: now.Add(-48 * time.Hour), LastUsedAt: now.Add(-time.Minute)},
+		{ID: "at-unused", Description: "unused", CreatedAt: now.Add(-48 * time.Hour), LastUsedAt: now.Add(-25 * time.Hour)},
+		{ID: "at-never", Description: "never", CreatedAt: now.Add(-48 * time.Hour)},
+		{ID: "at-new", Description: "new", CreatedAt: now.Add(-time.Hour)},
+	}
+	managed := map[string]struct{}{"at-unused": {}}
+
+	stale := staleAgentTokens(tokens, managed, unusedFor, now)
+
+	assert.Equal(t, []appv1alpha2.StaleAgentToken{
+		{ID: "at-never", Name: "never", CreatedAt: pointer.PointerOf(now.Add(-48 * time.Hour).Unix()), Managed: false},
+		{ID: "at-unused", Name: "unused", CreatedAt: pointer.PointerOf(now.Add(-48 * time.Hour).Unix()), LastUsedAt: pointer.PointerOf(now.Add(-25 * time.Hour).Unix()), Managed: true},
+	}, stale)
+}
+
+func TestSetAgentTokenMetrics(t *testing.T) {
+	now := time.Now()
+	o := &TestObject{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "this"}}
+	tokens := []*tfc.AgentToken{
+		{ID: "at-this", Description: "this", CreatedAt: now.Add(-time.Hour), LastUsedAt: now.Add(-time.Minute)},
+		{ID: "at-that", Description: "that", CreatedAt: now.Add(-2 * time.Hour)},
+	}
+	managed := map[string]struct{}{"at-this": {}}
+
+	setAgentTokenMetrics("AgentToken", o, "apool-this", tokens, managed, 1, now)
+	defer deleteAgentTokenMetrics("AgentToken", o)
+
+	l := prometheus.Labels{"kind": "AgentToken", "namespace": "default", "name": "this", "agent_pool_id": "apool-this"}
+	assert.Equal(t, float64(1), testutil.ToFloat64(MetricAgentTokensStale.With(l)))
+
+	l["token_id"], l["token_name"], l["managed"] = "at-this", "this", "true"
+	assert.Equal(t, time.Hour.Seconds(), testutil.ToFloat64(MetricAgentTokenAge.With(l)))
+	assert.Equal(t, float64(now.Add(-time.Minute).Unix()), testutil.ToFloat64(MetricAgentTokenLastUsed.With(l)))
+
+	l["token_id"], l["token_name"], l["managed"] = "at-that", "that", "false"
+	assert.Equal(t, float64(0), testutil.ToFloat64(MetricAgentTokenLastUsed.With(l)))
+
+	// Removed tokens are no longer reported.
+	setAgentTokenMetrics("AgentToken", o, "apool-this", tokens[:1], managed, 0, now)
+	assert.Equal(t, 1, testutil.CollectAndCount(MetricAgentTokenAge))
+}
@@ -15,9 +15,6 @@ var (
 			Name: "hcp_tf_runs",
 			Help: "HCP Terraform - Pending runs by statuses",
 		},
-		// TODO:
-		// - Add a status label to indicate whether the metric is up or down
-		//   (for example, when an endpoint is unreachable or the CR is suspended/paused).
 		[]string{
 			"run_status",
 			"agent_pool_id",
@@ -29,21 +26,239 @@ var (
 			Name: "hcp_tf_runs_total",
 			Help: "HCP Terraform - Total number of pending Runs by statuses",
 		},
-		// TODO:
-		// - Add a status label to indicate whether the metric is up or down
-		//   (for example, when an endpoint is unreachable or the CR is suspended/paused).
 		[]string{
 			"agent_pool_id",
 			"agent_pool_name",
 		},
 	)
+	MetricOrganizationRuns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_organization_runs",
+			Help: "HCP Terraform - Pending runs of the organization by statuses",
+		},
+		[]string{
+			"organization",
+			"run_status",
+		},
+	)
+	MetricOrganizationRunsTotal = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_organization_runs_total",
+			Help: "HCP Terraform - Total number of pending runs of the organization",
+		},
+		[]string{
+			"organization",
+		},
+	)
+	MetricRunsBreakdown = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_runs_breakdown",
+			Help: "HCP Terraform - Pending runs by statuses, workspaces, and projects",
+		},
+		[]string{
+			"organization",
+			"agent_pool_id",
+			"agent_pool_name",
+			"run_status",
+			"workspace_id",
+			"workspace_name",
+			"project_id",
+			"project_name",
+		},
+	)
+	MetricRunQueueDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "hcp_tf_run_queue_duration_seconds",
+			Help:    "HCP Terraform - Time completed runs spent in the queue before the plan or apply started",
+			Buckets: []float64{5, 15, 30, 60, 120, 300, 600, 1800, 3600},
+		},
+		[]string{
+			"organization",
+			"agent_pool_id",
+			"agent_pool_name",
+			"phase",
+		},
+	)
+	MetricRunPhaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "hcp_tf_run_phase_duration_seconds",
+			Help:    "HCP Terraform - Duration of the plan or apply of completed runs",
+			Buckets: []float64{10, 30, 60, 120, 300, 600, 1200, 1800, 3600, 7200},
+		},
+		[]string{
+			"organization",
+			"agent_pool_id",
+			"agent_pool_name",
+			"phase",
+		},
+	)
 	// TODO:
 	// - Add a metric to track associated Workspaces.
 )
 
+// Runs Collector Metrics
+var (
+	MetricRunsCollectorUp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_runs_collector_up",
+			Help: "HCP Terraform - Whether the last collection of runs succeeded, 0 if it failed or the collector is paused",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+	MetricRunsCollectorPaused = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_runs_collector_paused",
+			Help: "HCP Terraform - Whether the reconciliation of the collector is paused",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+	MetricRunsCollectorLastSuccess = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_runs_collector_last_success_timestamp_seconds",
+			Help: "HCP Terraform - Unix timestamp of the last successful collection of runs",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+	MetricRunsCollectorScrapeDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "hcp_tf_runs_collector_scrape_duration_seconds",
+			Help:    "HCP Terraform - Duration of the collection of runs",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+	MetricRunsCollectorScrapeErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hcp_tf_runs_collector_scrape_errors_total",
+			Help: "HCP Terraform - Total number of failed collections of runs",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+)
+
+// Workspace Metrics
+var (
+	MetricWorkspaceMonthlyCost = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_workspace_monthly_cost",
+			Help: "HCP Terraform - Monthly cost estimate of the current run of the workspace in USD by cost type: prior, proposed, delta",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"workspace_id",
+			"cost",
+		},
+	)
+	MetricWorkspaceBudgetExceeded = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_workspace_budget_exceeded",
+			Help: "HCP Terraform - Whether the cost estimate of the current run of the workspace exceeds the budget, 1 if it does, 0 otherwise",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"workspace_id",
+		},
+	)
+)
+
+// Agent Pool Metrics
+var (
+	MetricAgentPoolPendingRuns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_agent_pool_pending_runs",
+			Help: "HCP Terraform - Number of pending runs of the target workspaces of the externally autoscaled agent pool",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"agent_pool_id",
+			"agent_pool_name",
+		},
+	)
+)
+
+// Agent Token Metrics
+var (
+	MetricAgentTokenAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_agent_token_age_seconds",
+			Help: "HCP Terraform - Age of the agent token in seconds",
+		},
+		[]string{
+			"kind",
+			"namespace",
+			"name",
+			"agent_pool_id",
+			"token_id",
+			"token_name",
+			"managed",
+		},
+	)
+	MetricAgentTokenLastUsed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_agent_token_last_used_timestamp_seconds",
+			Help: "HCP Terraform - Unix timestamp of when the agent token was last used, 0 if it has never been used",
+		},
+		[]string{
+			"kind",
+			"namespace",
+			"name",
+			"agent_pool_id",
+			"token_id",
+			"token_name",
+			"managed",
+		},
+	)
+	MetricAgentTokensStale = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_agent_tokens_stale",
+			Help: "HCP Terraform - Number of agent tokens that have not been used for the configured duration",
+		},
+		[]string{
+			"kind",
+			"namespace",
+			"name",
+			"agent_pool_id",
+		},
+	)
+)
+
 func RegisterMetrics() {
 	metrics.Registry.MustRegister(
 		MetricRuns,
 		MetricRunsTotal,
+		MetricOrganizationRuns,
+		MetricOrganizationRunsTotal,
+		MetricRunsBreakdown,
+		MetricRunQueueDuration,
+		MetricRunPhaseDuration,
+		MetricRunsCollectorUp,
+		MetricRunsCollectorPaused,
+		MetricRunsCollectorLastSuccess,
+		MetricRunsCollectorScrapeDuration,
+		MetricRunsCollectorScrapeErrors,
+		MetricWorkspaceMonthlyCost,
+		MetricWorkspaceBudgetExceeded,
+		MetricAgentPoolPendingRuns,
+		MetricAgentTokenAge,
+		MetricAgentTokenLastUsed,
+		MetricAgentTokensStale,
 	)
 }
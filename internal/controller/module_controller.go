@@ -165,6 +165,7 @@ func (r *ModuleReconciler) updateStatusCV(ctx context.Context, instance *appv1al
 }
 
 func (r *ModuleReconciler) updateStatusRun(ctx context.Context, instance *appv1alpha2.Module, workspace *tfc.Workspace, run *tfc.Run) error {
+	e := r.runEvent(instance, workspace, run)
 	instance.Status.WorkspaceID = workspace.ID
 	instance.Status.ObservedGeneration = instance.Generation
 	instance.Status.Run = &appv1alpha2.RunStatus{
@@ -173,7 +174,12 @@ func (r *ModuleReconciler) updateStatusRun(ctx context.Context, instance *appv1a
 		ConfigurationVersion: run.ConfigurationVersion.ID,
 	}
 
-	return r.Status().Update(ctx, instance)
+	if err := r.Status().Update(ctx, instance); err != nil {
+		return err
+	}
+	r.publishRunEvent(instance, e)
+
+	return nil
 }
 
 func (r *ModuleReconciler) updateStatusOutputs(ctx context.Context, instance *appv1alpha2.Module, workspace *tfc.Workspace) error {
@@ -184,6 +190,7 @@ func (r *ModuleReconciler) updateStatusOutputs(ctx context.Context, instance *ap
 }
 
 func (r *ModuleReconciler) updateStatusDestroy(ctx context.Context, instance *appv1alpha2.Module, run *tfc.Run) error {
+	e := r.runEvent(instance, nil, run)
 	instance.Status.DestroyRunID = run.ID
 	instance.Status.ObservedGeneration = instance.Generation
 	instance.Status.Run = &appv1alpha2.RunStatus{
@@ -192,7 +199,12 @@ func (r *ModuleReconciler) updateStatusDestroy(ctx context.Context, instance *ap
 		ConfigurationVersion: run.ConfigurationVersion.ID,
 	}
 
-	return r.Status().Update(ctx, instance)
+	if err := r.Status().Update(ctx, instance); err != nil {
+		return err
+	}
+	r.publishRunEvent(instance, e)
+
+	return nil
 }
 
 func (r *ModuleReconciler) getTerraformClient(ctx context.Context, m *moduleInstance) error {
@@ -421,7 +433,7 @@ func (r *ModuleReconciler) reconcileModule(ctx context.Context, m *moduleInstanc
 	// checks if a new version of the Run is finished
 	if waitRunToComplete(m.instance.Status.Run) {
 		m.log.Info("Reconcile Run", "msg", "check the run status")
-		run, err := m.tfClient.Client.Runs.Read(ctx, m.instance.Status.Run.ID)
+		run, err := readRun(ctx, m.tfClient.Client, m.instance.Status.Run.ID)
 		if err != nil {
 			m.log.Error(err, "Reconcile Run", "msg", "failed to get run status")
 			return err
@@ -442,5 +454,46 @@ func (r *ModuleReconciler) reconcileModule(ctx context.Context, m *moduleInstanc
 	m.log.Info("Reconcile Module Outputs", "msg", "successfully reconcilied outputs")
 	r.Recorder.Event(&m.instance, corev1.EventTypeNormal, "ReconcileModuleOutputs", "Successfully reconcilied outputs")
 
+	r.reconcileRunHistory(ctx, m, workspace)
+
 	return r.updateStatusOutputs(ctx, &m.instance, workspace)
 }
+
+// reconcileRunHistory records the most recent runs of the module workspace.
+// Failures are logged and do not block the reconciliation, the previously recorded history is kept.
+func (r *ModuleReconciler) reconcileRunHistory(ctx context.Context, m *moduleInstance, workspace *tfc.Workspace) {
+	limit := runHistoryLimit(m.instance.Spec.RunHistoryLimit)
+	history, err := listRunHistory(ctx, m.tfClient.Client, workspace.ID, limit, m.instance.Status.RunHistory)
+	if err != nil {
+		m.log.Error(err, "Reconcile Run", "msg", "failed to get the run history")
+		return
+	}
+	m.instance.Status.RunHistory = history
+	if history != nil {
+		m.log.Info("Reconcile Run", "msg", fmt.Sprintf("successfully got the run history of %d runs", len(history.Runs)))
+	}
+}
+
+// runEvent returns the transition of the run status, if the run is the same as the one previously recorded in the status.
+// It must be called before the run status is updated.
+func (r *ModuleReconciler) runEvent(instance *appv1alpha2.Module, workspace *tfc.Workspace, run *tfc.Run) *runEvent {
+	previousStatus := ""
+	if instance.Status.Run != nil && instance.Status.Run.ID == run.ID {
+		previousStatus = instance.Status.Run.Status
+	}
+	ws := runEventWorkspace{
+		organization: instance.Spec.Organization,
+		id:           instance.Status.WorkspaceID,
+	}
+	if workspace != nil {
+		ws.id = workspace.ID
+		ws.name = workspace.Name
+	}
+	return newRunEvent("Module", instance, ws, run, previousStatus)
+}
+
+// publishRunEvent publishes the run event once the status is updated.
+func (r *ModuleReconciler) publishRunEvent(instance *appv1alpha2.Module, e *runEvent) {
+	l := log.Log.WithValues("module", types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name})
+	publishRunEvents(r.Recorder, l, instance, e)
+}
@@ -69,7 +69,7 @@ func (r *ModuleReconciler) destroyModule(ctx context.Context, m *moduleInstance)
 		if ws.CurrentRun != nil {
 			m.log.Info("Delete Module", "msg", "get current run")
 			// Have to read the individual run here, since the one associated with workspace doesn't contain the necessary info
-			cr, err := m.tfClient.Client.Runs.Read(ctx, ws.CurrentRun.ID)
+			cr, err := readRun(ctx, m.tfClient.Client, ws.CurrentRun.ID)
 			if err != nil {
 				m.log.Info("Delete Module", "msg", fmt.Sprintf("failed to get current run: %s", ws.CurrentRun.ID))
 				return err
@@ -103,7 +103,7 @@ func (r *ModuleReconciler) destroyModule(ctx context.Context, m *moduleInstance)
 
 	if waitRunToComplete(m.instance.Status.Run) {
 		m.log.Info("Delete Module", "msg", "get destroy run status")
-		run, err := m.tfClient.Client.Runs.Read(ctx, m.instance.Status.Run.ID)
+		run, err := readRun(ctx, m.tfClient.Client, m.instance.Status.Run.ID)
 		if err != nil {
 			m.log.Error(err, "Delete Module", "msg", "failed to get destroy run status")
 			return err
@@ -112,7 +112,12 @@ func (r *ModuleReconciler) destroyModule(ctx context.Context, m *moduleInstance)
 
 		if _, ok := runStatusComplete[run.Status]; ok {
 			m.log.Info("Delete Module", "msg", "destroy run finished")
-			return r.removeFinalizer(ctx, m)
+			e := r.runEvent(&m.instance, nil, run)
+			if err := r.removeFinalizer(ctx, m); err != nil {
+				return err
+			}
+			r.publishRunEvent(&m.instance, e)
+			return nil
 		}
 
 		return r.updateStatusDestroy(ctx, &m.instance, run)
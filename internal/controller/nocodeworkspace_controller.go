@@ -0,0 +1,748 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/version"
+)
+
+// NoCodeWorkspaceReconciler reconciles a NoCodeWorkspace object
+type NoCodeWorkspaceReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+	Scheme   *runtime.Scheme
+}
+
+type noCodeWorkspaceInstance struct {
+	instance appv1alpha2.NoCodeWorkspace
+
+	log      logr.Logger
+	tfClient HCPTerraformClient
+}
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=nocodeworkspaces,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.terraform.io,resources=nocodeworkspaces/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=nocodeworkspaces/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=create;list;update;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=create;list;update;watch
+
+func (r *NoCodeWorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	w := noCodeWorkspaceInstance{}
+
+	w.log = log.Log.WithValues("nocodeworkspace", req.NamespacedName)
+	w.log.Info("No-Code Workspace Controller", "msg", "new reconciliation event")
+
+	err := r.Client.Get(ctx, req.NamespacedName, &w.instance)
+	if err != nil {
+		// 'Not found' error occurs when an object is removed from the Kubernetes
+		// No actions are required in this case
+		if kerrors.IsNotFound(err) {
+			w.log.Info("No-Code Workspace Controller", "msg", "the instance was removed no further action is required")
+			return doNotRequeue()
+		}
+		w.log.Error(err, "No-Code Workspace Controller", "msg", "get instance object")
+		return requeueAfter(requeueInterval)
+	}
+
+	if a, ok := w.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
+		w.log.Info("No-Code Workspace Controller", "msg", "reconciliation is paused for this resource")
+		return doNotRequeue()
+	}
+
+	w.log.Info("Spec Validation", "msg", "validating instance object spec")
+	if err := w.instance.ValidateSpec(); err != nil {
+		w.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		return doNotRequeue()
+	}
+	w.log.Info("Spec Validation", "msg", "spec is valid")
+
+	if needToAddFinalizer(&w.instance, noCodeWorkspaceFinalizer) {
+		err := r.addFinalizer(ctx, &w.instance)
+		if err != nil {
+			w.log.Error(err, "No-Code Workspace Controller", "msg", fmt.Sprintf("failed to add finalizer %s to the object", noCodeWorkspaceFinalizer))
+			r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "AddFinalizer", "Failed to add finalizer %s to the object", noCodeWorkspaceFinalizer)
+			return requeueOnErr(err)
+		}
+		w.log.Info("No-Code Workspace Controller", "msg", fmt.Sprintf("successfully added finalizer %s to the object", noCodeWorkspaceFinalizer))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "AddFinalizer", "Successfully added finalizer %s to the object", noCodeWorkspaceFinalizer)
+	}
+
+	err = r.getTerraformClient(ctx, &w)
+	if err != nil {
+		w.log.Error(err, "No-Code Workspace Controller", "msg", "failed to get HCP Terraform client")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		return requeueAfter(requeueInterval)
+	}
+
+	err = r.reconcileNoCodeWorkspace(ctx, &w)
+	if err != nil {
+		w.log.Error(err, "No-Code Workspace Controller", "msg", "reconcile no-code workspace")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileNoCodeWorkspace", "Failed to reconcile no-code workspace")
+		return requeueAfter(requeueInterval)
+	}
+	w.log.Info("No-Code Workspace Controller", "msg", "successfully reconcilied no-code workspace")
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileNoCodeWorkspace", "Successfully reconcilied no-code workspace ID %s", w.instance.Status.WorkspaceID)
+
+	if w.instance.Status.Run != nil && !w.instance.Status.Run.RunCompleted() {
+		return requeueAfter(requeueInterval)
+	}
+
+	return requeueAfter(NoCodeWorkspaceSyncPeriod)
+}
+
+func (r *NoCodeWorkspaceReconciler) addFinalizer(ctx context.Context, instance *appv1alpha2.NoCodeWorkspace) error {
+	controllerutil.AddFinalizer(instance, noCodeWorkspaceFinalizer)
+
+	return r.Update(ctx, instance)
+}
+
+func (r *NoCodeWorkspaceReconciler) getTerraformClient(ctx context.Context, w *noCodeWorkspaceInstance) error {
+	nn := types.NamespacedName{
+		Namespace: w.instance.Namespace,
+		Name:      w.instance.Spec.Token.SecretKeyRef.Name,
+	}
+	token, err := secretKeyRef(ctx, r.Client, nn, w.instance.Spec.Token.SecretKeyRef.Key)
+	if err != nil {
+		return err
+	}
+
+	httpClient := tfc.DefaultConfig().HTTPClient
+	insecure := false
+
+	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
+		insecure, err = strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if insecure {
+		w.log.Info("Reconcile No-Code Workspace", "msg", "client configured to skip TLS certificate verifications")
+	}
+
+	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
+
+	config := &tfc.Config{
+		Token:      token,
+		HTTPClient: httpClient,
+		Headers: http.Header{
+			"User-Agent": []string{version.UserAgent},
+		},
+	}
+	w.tfClient.Client, err = tfc.NewClient(config)
+
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NoCodeWorkspaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.NoCodeWorkspace{}).
+		WithEventFilter(predicate.Or(genericPredicates())).
+		Complete(r)
+}
+
+func (r *NoCodeWorkspaceReconciler) removeFinalizer(ctx context.Context, w *noCodeWorkspaceInstance) error {
+	controllerutil.RemoveFinalizer(&w.instance, noCodeWorkspaceFinalizer)
+
+	err := r.Update(ctx, &w.instance)
+	if err != nil {
+		w.log.Error(err, "Reconcile No-Code Workspace", "msg", fmt.Sprintf("failed to remove finalizer %s", noCodeWorkspaceFinalizer))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "RemoveNoCodeWorkspace", "Failed to remove finalizer %s", noCodeWorkspaceFinalizer)
+	}
+
+	return err
+}
+
+// getNoCodeModuleID returns the no-code module ID either from the spec or from the registry module.
+func (r *NoCodeWorkspaceReconciler) getNoCodeModuleID(ctx context.Context, w *noCodeWorkspaceInstance) (string, error) {
+	spec := w.instance.Spec.Module
+
+	if spec.ID != "" {
+		w.log.Info("Reconcile No-Code Module", "msg", "getting no-code module ID from the spec.Module.ID")
+		return spec.ID, nil
+	}
+
+	w.log.Info("Reconcile No-Code Module", "msg", "getting no-code module ID from the registry module")
+	rm, err := w.tfClient.Client.RegistryModules.Read(ctx, tfc.RegistryModuleID{
+		Organization: w.instance.Spec.Organization,
+		Name:         spec.RegistryModule.Name,
+		Provider:     spec.RegistryModule.Provider,
+		Namespace:    w.instance.Spec.Organization,
+		RegistryName: tfc.PrivateRegistry,
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(rm.RegistryNoCodeModule) == 0 {
+		return "", fmt.Errorf("registry module %s/%s does not have no-code provisioning enabled", spec.RegistryModule.Name, spec.RegistryModule.Provider)
+	}
+
+	return rm.RegistryNoCodeModule[0].ID, nil
+}
+
+// getNoCodeModule returns the no-code module.
+// The version pin of the no-code module is shared by all workspaces provisioned from it, thus it is never changed.
+// An error is returned if the version from the spec differs from the version pin.
+func (r *NoCodeWorkspaceReconciler) getNoCodeModule(ctx context.Context, w *noCodeWorkspaceInstance) (*tfc.RegistryNoCodeModule, error) {
+	id, err := r.getNoCodeModuleID(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	nc, err := w.tfClient.Client.RegistryNoCodeModules.Read(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if w.instance.Spec.Version != "" && w.instance.Spec.Version != nc.VersionPin {
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileNoCodeModule", "Version %s differs from the version pin %s of the no-code module ID %s", w.instance.Spec.Version, nc.VersionPin, nc.ID)
+		return nil, fmt.Errorf("version %s differs from the version pin %s of the no-code module ID %s", w.instance.Spec.Version, nc.VersionPin, nc.ID)
+	}
+
+	return nc, nil
+}
+
+func (r *NoCodeWorkspaceReconciler) getProjectIDByName(ctx context.Context, w *noCodeWorkspaceInstance) (string, error) {
+	projectName := w.instance.Spec.Project.Name
+
+	listOpts := &tfc.ProjectListOptions{
+		Name: projectName,
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		projectIDs, err := w.tfClient.Client.Projects.List(ctx, w.instance.Spec.Organization, listOpts)
+		if err != nil {
+			return "", err
+		}
+		for _, p := range projectIDs.Items {
+			if p.Name == projectName {
+				return p.ID, nil
+			}
+		}
+		if projectIDs.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = projectIDs.NextPage
+	}
+
+	return "", fmt.Errorf("project ID not found for project name %q", projectName)
+}
+
+func (r *NoCodeWorkspaceReconciler) getProjectID(ctx context.Context, w *noCodeWorkspaceInstance) (string, error) {
+	specProject := w.instance.Spec.Project
+
+	if specProject == nil {
+		return "", nil
+	}
+
+	if specProject.Name != "" {
+		w.log.Info("Reconcile Project", "msg", "getting project ID by name")
+		return r.getProjectIDByName(ctx, w)
+	}
+
+	w.log.Info("Reconcile Project", "msg", "getting project ID from the spec.Project.ID")
+	return specProject.ID, nil
+}
+
+func (r *NoCodeWorkspaceReconciler) getAgentPoolIDByName(ctx context.Context, w *noCodeWorkspaceInstance) (string, error) {
+	agentPoolName := w.instance.Spec.AgentPool.Name
+
+	listOpts := &tfc.AgentPoolListOptions{
+		Query: agentPoolName,
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		agentPoolIDs, err := w.tfClient.Client.AgentPools.List(ctx, w.instance.Spec.Organization, listOpts)
+		if err != nil {
+			return "", err
+		}
+		for _, a := range agentPoolIDs.Items {
+			if a.Name == agentPoolName {
+				return a.ID, nil
+			}
+		}
+		if agentPoolIDs.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = agentPoolIDs.NextPage
+	}
+
+	return "", fmt.Errorf("agent pool ID not found for agent pool name %q", agentPoolName)
+}
+
+func (r *NoCodeWorkspaceReconciler) getAgentPoolID(ctx context.Context, w *noCodeWorkspaceInstance) (string, error) {
+	specAgentPool := w.instance.Spec.AgentPool
+
+	if specAgentPool == nil {
+		return "", fmt.Errorf("'spec.agentPool' is not set")
+	}
+
+	if specAgentPool.Name != "" {
+		w.log.Info("Reconcile Agent Pool", "msg", "getting agent pool ID by name")
+		return r.getAgentPoolIDByName(ctx, w)
+	}
+
+	w.log.Info("Reconcile Agent Pool", "msg", "getting agent pool ID from the spec.AgentPool.ID")
+	return specAgentPool.ID, nil
+}
+
+// getVariables returns the Terraform variables from the spec with their values resolved.
+// It also validates the variables against the variable options of the no-code module version.
+func (r *NoCodeWorkspaceReconciler) getVariables(ctx context.Context, w *noCodeWorkspaceInstance, noCodeModuleID, version string) ([]*tfc.Variable, error) {
+	moduleVariables := make(map[string]*tfc.RegistryModuleVariable)
+	if version != "" {
+		mv, err := w.tfClient.Client.RegistryNoCodeModules.ReadVariables(ctx, noCodeModuleID, version, nil)
+		if err != nil {
+			w.log.Error(err, "Reconcile Variables", "msg", fmt.Sprintf("failed to get variables of the no-code module ID %s version %s", noCodeModuleID, version))
+			return nil, err
+		}
+		for _, v := range mv.Items {
+			moduleVariables[v.Name] = v
+		}
+	}
+
+	var variables []*tfc.Variable
+	specVariables := make(map[string]struct{})
+	for _, v := range w.instance.Spec.TerraformVariables {
+		specVariables[v.Name] = struct{}{}
+		value := v.Value
+		if v.ValueFrom != nil {
+			var err error
+			objectKey := types.NamespacedName{
+				Namespace: w.instance.Namespace,
+			}
+			if cm := v.ValueFrom.ConfigMapKeyRef; cm != nil {
+				objectKey.Name = cm.Name
+				value, err = configMapKeyRef(ctx, r.Client, objectKey, cm.Key)
+			}
+			if s := v.ValueFrom.SecretKeyRef; s != nil {
+				objectKey.Name = s.Name
+				value, err = secretKeyRef(ctx, r.Client, objectKey, s.Key)
+			}
+			if err != nil {
+				w.log.Error(err, "Reconcile Variables", "msg", fmt.Sprintf("failed to get value for the variable %s", v.Name))
+				r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileVariables", fmt.Sprintf("Failed to get value for the variable %s", v.Name))
+				return nil, err
+			}
+		}
+		if len(moduleVariables) > 0 {
+			mv, ok := moduleVariables[v.Name]
+			if !ok {
+				return nil, fmt.Errorf("variable %q is not declared in the no-code module version %s", v.Name, version)
+			}
+			if len(mv.Options) > 0 && !slices.Contains(mv.Options, value) {
+				return nil, fmt.Errorf("value of the variable %q is not one of the allowed options %v", v.Name, mv.Options)
+			}
+		}
+		variables = append(variables, &tfc.Variable{
+			Key:         v.Name,
+			Value:       value,
+			Description: v.Description,
+			Category:    tfc.CategoryTerraform,
+			HCL:         v.HCL,
+			Sensitive:   v.Sensitive,
+		})
+	}
+
+	for n, mv := range moduleVariables {
+		if _, ok := specVariables[n]; mv.Required && !mv.HasGlobal && !ok {
+			return nil, fmt.Errorf("variable %q is required by the no-code module version %s", n, version)
+		}
+	}
+
+	return variables, nil
+}
+
+func (r *NoCodeWorkspaceReconciler) createWorkspace(ctx context.Context, w *noCodeWorkspaceInstance, nc *tfc.RegistryNoCodeModule) (*tfc.Workspace, error) {
+	spec := w.instance.Spec
+
+	variables, err := r.getVariables(ctx, w, nc.ID, nc.VersionPin)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &tfc.RegistryNoCodeModuleCreateWorkspaceOptions{
+		Name:          spec.Name,
+		AutoApply:     tfc.Bool(ApplyMethodToBool(spec.ApplyMethod)),
+		ExecutionMode: tfc.String(spec.ExecutionMode),
+		Variables:     variables,
+	}
+	if spec.Description != "" {
+		options.Description = tfc.String(spec.Description)
+	}
+	if spec.ExecutionMode == "agent" {
+		agentPoolID, err := r.getAgentPoolID(ctx, w)
+		if err != nil {
+			w.log.Error(err, "Reconcile No-Code Workspace", "msg", "failed to get agent pool ID")
+			return nil, err
+		}
+		options.AgentPoolID = tfc.String(agentPoolID)
+	}
+	projectID, err := r.getProjectID(ctx, w)
+	if err != nil {
+		w.log.Error(err, "Reconcile No-Code Workspace", "msg", "failed to get project ID")
+		return nil, err
+	}
+	if projectID != "" {
+		options.Project = &tfc.Project{ID: projectID}
+	}
+
+	workspace, err := w.tfClient.Client.RegistryNoCodeModules.CreateWorkspace(ctx, nc.ID, options)
+	if err != nil {
+		w.log.Error(err, "Reconcile No-Code Workspace", "msg", "failed to create a new workspace")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileNoCodeWorkspace", "Failed to create a new workspace")
+		return nil, err
+	}
+	w.log.Info("Reconcile No-Code Workspace", "msg", fmt.Sprintf("successfully created a new workspace with ID %s", workspace.ID))
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileNoCodeWorkspace", "Successfully created a new workspace with ID %s", workspace.ID)
+
+	w.instance.Status.WorkspaceID = workspace.ID
+	w.instance.Status.Version = nc.VersionPin
+	w.instance.Status.Run = nil
+	w.instance.Status.Output = nil
+	w.instance.Status.Upgrade = nil
+
+	return workspace, r.Status().Update(ctx, &w.instance)
+}
+
+// updateWorkspace updates the workspace settings that are managed outside of the no-code module.
+func (r *NoCodeWorkspaceReconciler) updateWorkspace(ctx context.Context, w *noCodeWorkspaceInstance, workspace *tfc.Workspace) (*tfc.Workspace, error) {
+	spec := w.instance.Spec
+	update := false
+	options := tfc.WorkspaceUpdateOptions{}
+
+	if workspace.Name != spec.Name {
+		options.Name = tfc.String(spec.Name)
+		update = true
+	}
+	if workspace.Description != spec.Description {
+		options.Description = tfc.String(spec.Description)
+		update = true
+	}
+	if workspace.AutoApply != ApplyMethodToBool(spec.ApplyMethod) {
+		options.AutoApply = tfc.Bool(ApplyMethodToBool(spec.ApplyMethod))
+		update = true
+	}
+	if workspace.ExecutionMode != spec.ExecutionMode {
+		options.ExecutionMode = tfc.String(spec.ExecutionMode)
+		update = true
+	}
+	if spec.ExecutionMode == "agent" {
+		agentPoolID, err := r.getAgentPoolID(ctx, w)
+		if err != nil {
+			w.log.Error(err, "Reconcile No-Code Workspace", "msg", "failed to get agent pool ID")
+			return nil, err
+		}
+		if workspace.AgentPool == nil || workspace.AgentPool.ID != agentPoolID {
+			options.ExecutionMode = tfc.String(spec.ExecutionMode)
+			options.AgentPoolID = tfc.String(agentPoolID)
+			update = true
+		}
+	}
+	projectID, err := r.getProjectID(ctx, w)
+	if err != nil {
+		w.log.Error(err, "Reconcile No-Code Workspace", "msg", "failed to get project ID")
+		return nil, err
+	}
+	if projectID != "" && (workspace.Project == nil || workspace.Project.ID != projectID) {
+		options.Project = &tfc.Project{ID: projectID}
+		update = true
+	}
+
+	if !update {
+		return workspace, nil
+	}
+
+	w.log.Info("Reconcile No-Code Workspace", "msg", "update workspace settings")
+	return w.tfClient.Client.Workspaces.UpdateByID(ctx, workspace.ID, options)
+}
+
+// needToUpgrade returns true if the workspace version or Terraform variables differ from the desired ones.
+// Values of sensitive variables cannot be read back, thus changes in them are applied with the next upgrade only.
+func (r *NoCodeWorkspaceReconciler) needToUpgrade(ctx context.Context, w *noCodeWorkspaceInstance, version string, variables []*tfc.Variable) (bool, error) {
+	if w.instance.Status.Version != version {
+		return true, nil
+	}
+
+	workspaceVariables := make(map[string]*tfc.Variable)
+	listOpts := &tfc.VariableListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		vl, err := w.tfClient.Client.Variables.List(ctx, w.instance.Status.WorkspaceID, listOpts)
+		if err != nil {
+			return false, err
+		}
+		for _, v := range vl.Items {
+			if v.Category == tfc.CategoryTerraform {
+				workspaceVariables[v.Key] = v
+			}
+		}
+		if vl.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = vl.NextPage
+	}
+
+	for _, v := range variables {
+		wv, ok := workspaceVariables[v.Key]
+		if !ok {
+			return true, nil
+		}
+		if wv.HCL != v.HCL || wv.Sensitive != v.Sensitive {
+			return true, nil
+		}
+		if !v.Sensitive && wv.Value != v.Value {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// upgradeRunID returns the ID of the run from the plan URL of the workspace upgrade.
+// The URL has the format `https://<host>/app/<organization>/workspaces/<workspace>/runs/<run-id>`.
+func upgradeRunID(planURL string) string {
+	u, err := url.Parse(planURL)
+	if err != nil {
+		return ""
+	}
+	if id := path.Base(u.Path); strings.HasPrefix(id, "run-") {
+		return id
+	}
+
+	return ""
+}
+
+// reconcileUpgrade tracks the ongoing workspace upgrade run.
+// The workspace version is updated once the run is applied. It returns true if the upgrade is still in progress.
+func (r *NoCodeWorkspaceReconciler) reconcileUpgrade(ctx context.Context, w *noCodeWorkspaceInstance) (bool, error) {
+	u := w.instance.Status.Upgrade
+	if u == nil || u.RunID == "" || u.RunCompleted() {
+		return false, nil
+	}
+
+	run, err := w.tfClient.Client.Runs.Read(ctx, u.RunID)
+	if err != nil {
+		w.log.Error(err, "Reconcile No-Code Workspace", "msg", fmt.Sprintf("failed to get the upgrade run %s", u.RunID))
+		return false, err
+	}
+	u.Status = string(run.Status)
+
+	switch {
+	case u.RunApplied():
+		w.log.Info("Reconcile No-Code Workspace", "msg", fmt.Sprintf("successfully upgraded workspace ID %s to version %s", w.instance.Status.WorkspaceID, u.Version))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "UpgradeNoCodeWorkspace", "Successfully upgraded workspace ID %s to version %s", w.instance.Status.WorkspaceID, u.Version)
+		w.instance.Status.Version = u.Version
+		return false, nil
+	case u.RunCompleted():
+		w.log.Info("Reconcile No-Code Workspace", "msg", fmt.Sprintf("upgrade run %s finished with status %s, the upgrade will not be retried until the spec or the version pin changes", u.RunID, u.Status))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "UpgradeNoCodeWorkspace", "Upgrade run %s of workspace ID %s finished with status %s", u.RunID, w.instance.Status.WorkspaceID, u.Status)
+		return false, nil
+	}
+
+	w.log.Info("Reconcile No-Code Workspace", "msg", fmt.Sprintf("upgrade run %s is in status %s", u.RunID, u.Status))
+	return true, nil
+}
+
+// upgradeFailed returns true if the previous upgrade to the given version failed and the spec has not changed since.
+func upgradeFailed(w *noCodeWorkspaceInstance, version string) bool {
+	u := w.instance.Status.Upgrade
+	return u != nil && u.Failed() && u.Version == version && u.ObservedGeneration == w.instance.Generation
+}
+
+// upgradeWorkspace initiates the workspace upgrade when the version or Terraform variables change.
+// It does not start a new upgrade while the previous one is in progress.
+// A failed upgrade is not retried until the spec or the version pin of the no-code module changes.
+func (r *NoCodeWorkspaceReconciler) upgradeWorkspace(ctx context.Context, w *noCodeWorkspaceInstance, nc *tfc.RegistryNoCodeModule) error {
+	inProgress, err := r.reconcileUpgrade(ctx, w)
+	if err != nil || inProgress {
+		return err
+	}
+
+	if upgradeFailed(w, nc.VersionPin) {
+		w.log.Info("Reconcile No-Code Workspace", "msg", fmt.Sprintf("upgrade to version %s failed, waiting for the spec or the version pin to change", nc.VersionPin))
+		return nil
+	}
+
+	variables, err := r.getVariables(ctx, w, nc.ID, nc.VersionPin)
+	if err != nil {
+		return err
+	}
+
+	upgrade, err := r.needToUpgrade(ctx, w, nc.VersionPin, variables)
+	if err != nil {
+		w.log.Error(err, "Reconcile No-Code Workspace", "msg", "failed to get workspace variables")
+		return err
+	}
+	if !upgrade {
+		w.log.Info("Reconcile No-Code Workspace", "msg", "workspace is up to date, no need to upgrade")
+		return nil
+	}
+
+	w.log.Info("Reconcile No-Code Workspace", "msg", fmt.Sprintf("upgrade workspace ID %s to version %s", w.instance.Status.WorkspaceID, nc.VersionPin))
+	wu, err := w.tfClient.Client.RegistryNoCodeModules.UpgradeWorkspace(ctx, nc.ID, w.instance.Status.WorkspaceID, &tfc.RegistryNoCodeModuleUpgradeWorkspaceOptions{
+		Variables: variables,
+	})
+	if err != nil {
+		w.log.Error(err, "Reconcile No-Code Workspace", "msg", fmt.Sprintf("failed to upgrade workspace ID %s", w.instance.Status.WorkspaceID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "UpgradeNoCodeWorkspace", "Failed to upgrade workspace ID %s", w.instance.Status.WorkspaceID)
+		return err
+	}
+	w.log.Info("Reconcile No-Code Workspace", "msg", fmt.Sprintf("workspace upgrade status %s", wu.Status))
+
+	w.instance.Status.Upgrade = &appv1alpha2.NoCodeWorkspaceUpgradeStatus{
+		Version:            nc.VersionPin,
+		RunID:              upgradeRunID(wu.PlanURL),
+		Status:             wu.Status,
+		PlanURL:            wu.PlanURL,
+		Message:            wu.Message,
+		ObservedGeneration: w.instance.Generation,
+	}
+	if w.instance.Status.Upgrade.RunID == "" {
+		w.log.Info("Reconcile No-Code Workspace", "msg", fmt.Sprintf("workspace upgrade has no run: %s", wu.Message))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "UpgradeNoCodeWorkspace", "Upgrade of workspace ID %s did not start a run: %s", w.instance.Status.WorkspaceID, wu.Message)
+		return nil
+	}
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "UpgradeNoCodeWorkspace", "Started upgrade of workspace ID %s to version %s with run %s", w.instance.Status.WorkspaceID, nc.VersionPin, w.instance.Status.Upgrade.RunID)
+
+	return nil
+}
+
+// reconcileRun updates the status of the ongoing or the latest finished run of the workspace.
+func (r *NoCodeWorkspaceReconciler) reconcileRun(ctx context.Context, w *noCodeWorkspaceInstance, workspace *tfc.Workspace) error {
+	if workspace.CurrentRun == nil {
+		w.log.Info("Reconcile Runs", "msg", "there are no ongoing runs")
+		return nil
+	}
+
+	if w.instance.Status.Run != nil {
+		if workspace.CurrentRun.ID == w.instance.Status.Run.ID && w.instance.Status.Run.RunCompleted() {
+			w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("the run %s status is synchronized no actions is required", workspace.CurrentRun.ID))
+			return nil
+		}
+	}
+
+	run, err := w.tfClient.Client.Runs.Read(ctx, workspace.CurrentRun.ID)
+	if err != nil {
+		w.log.Error(err, "Reconcile Runs", "msg", "failed to get the ongoing run status")
+		return err
+	}
+	w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("successfully got the ongoing run status %s", run.Status))
+
+	w.instance.Status.Run = &appv1alpha2.RunStatus{
+		ID:     run.ID,
+		Status: string(run.Status),
+	}
+	if run.ConfigurationVersion != nil {
+		w.instance.Status.Run.ConfigurationVersion = run.ConfigurationVersion.ID
+	}
+
+	return nil
+}
+
+func (r *NoCodeWorkspaceReconciler) reconcileNoCodeWorkspace(ctx context.Context, w *noCodeWorkspaceInstance) error {
+	w.log.Info("Reconcile No-Code Workspace", "msg", "reconciling no-code workspace")
+
+	// verify whether the Kubernetes object has been marked as deleted and if so delete the workspace
+	if isDeletionCandidate(&w.instance, noCodeWorkspaceFinalizer) {
+		w.log.Info("Reconcile No-Code Workspace", "msg", "object marked as deleted, need to delete workspace first")
+		r.Recorder.Event(&w.instance, corev1.EventTypeNormal, "ReconcileNoCodeWorkspace", "Object marked as deleted, need to delete workspace first")
+		return r.deleteNoCodeWorkspace(ctx, w)
+	}
+
+	nc, err := r.getNoCodeModule(ctx, w)
+	if err != nil {
+		w.log.Error(err, "Reconcile No-Code Workspace", "msg", "failed to get no-code module")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileNoCodeWorkspace", "Failed to get no-code module")
+		return err
+	}
+	w.instance.Status.NoCodeModuleID = nc.ID
+
+	var workspace *tfc.Workspace
+
+	// create a new workspace if the workspace ID is unknown(means it was never created by the controller)
+	if w.instance.IsCreationCandidate() {
+		w.log.Info("Reconcile No-Code Workspace", "msg", "status.WorkspaceID is empty, creating a new workspace")
+		workspace, err = r.createWorkspace(ctx, w, nc)
+		if err != nil {
+			return err
+		}
+	} else {
+		workspace, err = w.tfClient.Client.Workspaces.ReadByID(ctx, w.instance.Status.WorkspaceID)
+		if err != nil {
+			if err == tfc.ErrResourceNotFound {
+				w.log.Info("Reconcile No-Code Workspace", "msg", "workspace not found, creating a new workspace")
+				r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileNoCodeWorkspace", "Workspace ID %s not found, creating a new workspace", w.instance.Status.WorkspaceID)
+				workspace, err = r.createWorkspace(ctx, w, nc)
+				if err != nil {
+					return err
+				}
+			} else {
+				w.log.Error(err, "Reconcile No-Code Workspace", "msg", fmt.Sprintf("failed to read workspace ID %s", w.instance.Status.WorkspaceID))
+				return err
+			}
+		} else {
+			workspace, err = r.updateWorkspace(ctx, w, workspace)
+			if err != nil {
+				w.log.Error(err, "Reconcile No-Code Workspace", "msg", "failed to update workspace")
+				r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileNoCodeWorkspace", "Failed to update workspace ID %s", w.instance.Status.WorkspaceID)
+				return err
+			}
+			if err := r.upgradeWorkspace(ctx, w, nc); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := r.reconcileRun(ctx, w, workspace); err != nil {
+		return err
+	}
+
+	if w.instance.Spec.Outputs {
+		if err := r.reconcileOutputs(ctx, w); err != nil {
+			w.log.Error(err, "Reconcile No-Code Workspace", "msg", "failed to reconcile outputs")
+			r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileOutputs", "Failed to reconcile outputs")
+			return err
+		}
+	}
+
+	w.instance.Status.ObservedGeneration = w.instance.Generation
+
+	return r.Status().Update(ctx, &w.instance)
+}
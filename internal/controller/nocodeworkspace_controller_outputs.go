@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func noCodeWorkspaceOutputObjectName(name string) string {
+	return fmt.Sprintf("%s-nocode-outputs", name)
+}
+
+func getNoCodeWorkspaceNamespacedName(instance *appv1alpha2.NoCodeWorkspace) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: instance.Namespace,
+		Name:      noCodeWorkspaceOutputObjectName(instance.Name),
+	}
+}
+
+// configMapAvailable validates whether a Kubernetes ConfigMap is available for creation or update by the operator
+func (r *NoCodeWorkspaceReconciler) configMapAvailable(ctx context.Context, instance *appv1alpha2.NoCodeWorkspace) bool {
+	o := &corev1.ConfigMap{}
+	err := r.Client.Get(ctx, getNoCodeWorkspaceNamespacedName(instance), o)
+	if err != nil {
+		return kerrors.IsNotFound(err)
+	}
+
+	return containsOwnerReference(o.GetOwnerReferences(), instance.UID)
+}
+
+// secretAvailable validates whether a Kubernetes Secret is available for creation or update by the operator
+func (r *NoCodeWorkspaceReconciler) secretAvailable(ctx context.Context, instance *appv1alpha2.NoCodeWorkspace) bool {
+	o := &corev1.Secret{}
+	err := r.Client.Get(ctx, getNoCodeWorkspaceNamespacedName(instance), o)
+	if err != nil {
+		return kerrors.IsNotFound(err)
+	}
+
+	return containsOwnerReference(o.GetOwnerReferences(), instance.UID)
+}
+
+func (r *NoCodeWorkspaceReconciler) setOutputs(ctx context.Context, w *noCodeWorkspaceInstance) error {
+	workspace, err := w.tfClient.Client.Workspaces.ReadByID(ctx, w.instance.Status.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if workspace.CurrentStateVersion == nil {
+		return fmt.Errorf("current workspace state version is not available")
+	}
+
+	oName := noCodeWorkspaceOutputObjectName(w.instance.Name)
+
+	if !r.configMapAvailable(ctx, &w.instance) {
+		return fmt.Errorf("configMap %s is in use by different object thus it cannot be used to store outputs", oName)
+	}
+
+	if !r.secretAvailable(ctx, &w.instance) {
+		return fmt.Errorf("secret %s is in use by different object thus it cannot be used to store outputs", oName)
+	}
+
+	opts := &tfc.StateVersionOutputsListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	var outputs []*tfc.StateVersionOutput
+	for {
+		resp, err := w.tfClient.Client.StateVersions.ListOutputs(ctx, workspace.CurrentStateVersion.ID, opts)
+		if err != nil {
+			return err
+		}
+		outputs = append(outputs, resp.Items...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.PageNumber = resp.NextPage
+	}
+
+	nonSensitiveOutput := make(map[string]string)
+	sensitiveOutput := make(map[string][]byte)
+	for _, o := range outputs {
+		out, err := formatOutput(o)
+		if err != nil {
+			w.log.Error(err, "Reconcile No-Code Workspace Outputs", "mgs", fmt.Sprintf("failed to marshal JSON for %q", o.Name))
+			r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileOutputs", "failed to marshal JSON")
+			continue
+		}
+		if o.Sensitive {
+			sensitiveOutput[o.Name] = []byte(out)
+		} else {
+			nonSensitiveOutput[o.Name] = out
+		}
+	}
+
+	om := metav1.ObjectMeta{
+		Name:      oName,
+		Namespace: w.instance.Namespace,
+	}
+	labels := map[string]string{
+		"workspaceID": w.instance.Status.WorkspaceID,
+	}
+
+	// update ConfigMap output
+	cm := &corev1.ConfigMap{ObjectMeta: om}
+	err = controllerutil.SetControllerReference(&w.instance, cm, r.Scheme)
+	if err != nil {
+		return err
+	}
+
+	ur, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+		cm.Labels = labels
+		cm.Data = nonSensitiveOutput
+		return nil
+	})
+	if err != nil {
+		w.log.Error(err, "Reconcile No-Code Workspace Outputs", "mgs", fmt.Sprintf("failed to create or update ConfigMap %s", oName))
+		return err
+	}
+	w.log.Info("Reconcile No-Code Workspace Outputs", "mgs", fmt.Sprintf("configMap create or update result: %s", ur))
+
+	// update Secrets output
+	secret := &corev1.Secret{ObjectMeta: om}
+	err = controllerutil.SetControllerReference(&w.instance, secret, r.Scheme)
+	if err != nil {
+		return err
+	}
+
+	ur, err = controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Labels = labels
+		secret.Data = sensitiveOutput
+		return nil
+	})
+	if err != nil {
+		w.log.Error(err, "Reconcile No-Code Workspace Outputs", "mgs", fmt.Sprintf("failed to create or update Secret %s", oName))
+		return err
+	}
+	w.log.Info("Reconcile No-Code Workspace Outputs", "mgs", fmt.Sprintf("secret create or update result: %s", ur))
+
+	return nil
+}
+
+func needToUpdateNoCodeWorkspaceOutput(instance *appv1alpha2.NoCodeWorkspace) bool {
+	status := instance.Status
+
+	if status.Run == nil || !status.Run.RunApplied() {
+		return false
+	}
+
+	return status.Output == nil || status.Output.RunID != status.Run.ID
+}
+
+func (r *NoCodeWorkspaceReconciler) reconcileOutputs(ctx context.Context, w *noCodeWorkspaceInstance) error {
+	if !needToUpdateNoCodeWorkspaceOutput(&w.instance) {
+		w.log.Info("Reconcile No-Code Workspace Outputs", "mgs", "no need to update outputs")
+		return nil
+	}
+
+	w.log.Info("Reconcile No-Code Workspace Outputs", "mgs", "creating or updating outputs")
+	if err := r.setOutputs(ctx, w); err != nil {
+		return err
+	}
+	w.instance.Status.Output = &appv1alpha2.OutputStatus{
+		RunID: w.instance.Status.Run.ID,
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestUpgradeRunID(t *testing.T) {
+	cases := map[string]struct {
+		planURL string
+		want    string
+	}{
+		"RunURL": {
+			planURL: "https://app.terraform.io/app/kubernetes-operator/workspaces/nocode/runs/run-CZcmD7eagjhyX0vN",
+			want:    "run-CZcmD7eagjhyX0vN",
+		},
+		"TrailingSlash": {
+			planURL: "https://app.terraform.io/app/kubernetes-operator/workspaces/nocode/runs/run-CZcmD7eagjhyX0vN/",
+			want:    "run-CZcmD7eagjhyX0vN",
+		},
+		"NoRun": {
+			planURL: "https://app.terraform.io/app/kubernetes-operator/workspaces/nocode",
+			want:    "",
+		},
+		"Empty": {
+			planURL: "",
+			want:    "",
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.want, upgradeRunID(c.planURL))
+		})
+	}
+}
+
+func TestUpgradeFailed(t *testing.T) {
+	cases := map[string]struct {
+		upgrade    *appv1alpha2.NoCodeWorkspaceUpgradeStatus
+		version    string
+		generation int64
+		want       bool
+	}{
+		"NoUpgrade": {
+			upgrade:    nil,
+			version:    "1.0.0",
+			generation: 1,
+			want:       false,
+		},
+		"NoRun": {
+			upgrade:    &appv1alpha2.NoCodeWorkspaceUpgradeStatus{Version: "1.0.0", ObservedGeneration: 1},
+			version:    "1.0.0",
+			generation: 1,
+			want:       true,
+		},
+		"Errored": {
+			upgrade:    &appv1alpha2.NoCodeWorkspaceUpgradeStatus{Version: "1.0.0", RunID: "run-1", Status: "errored", ObservedGeneration: 1},
+			version:    "1.0.0",
+			generation: 1,
+			want:       true,
+		},
+		"Applied": {
+			upgrade:    &appv1alpha2.NoCodeWorkspaceUpgradeStatus{Version: "1.0.0", RunID: "run-1", Status: "applied", ObservedGeneration: 1},
+			version:    "1.0.0",
+			generation: 1,
+			want:       false,
+		},
+		"ErroredVersionPinChanged": {
+			upgrade:    &appv1alpha2.NoCodeWorkspaceUpgradeStatus{Version: "1.0.0", RunID: "run-1", Status: "errored", ObservedGeneration: 1},
+			version:    "1.1.0",
+			generation: 1,
+			want:       false,
+		},
+		"ErroredSpecChanged": {
+			upgrade:    &appv1alpha2.NoCodeWorkspaceUpgradeStatus{Version: "1.0.0", RunID: "run-1", Status: "errored", ObservedGeneration: 1},
+			version:    "1.0.0",
+			generation: 2,
+			want:       false,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			w := &noCodeWorkspaceInstance{
+				instance: appv1alpha2.NoCodeWorkspace{
+					ObjectMeta: metav1.ObjectMeta{Generation: c.generation},
+					Status:     appv1alpha2.NoCodeWorkspaceStatus{Upgrade: c.upgrade},
+				},
+			}
+			assert.Equal(t, c.want, upgradeFailed(w, c.version))
+		})
+	}
+}
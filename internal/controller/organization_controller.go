@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/version"
+)
+
+// OrganizationReconciler reconciles a Organization object
+type OrganizationReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+	Scheme   *runtime.Scheme
+}
+
+type organizationInstance struct {
+	instance appv1alpha2.Organization
+
+	log      logr.Logger
+	tfClient HCPTerraformClient
+}
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=organizations,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.terraform.io,resources=organizations/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=organizations/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=list;watch
+
+func (r *OrganizationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	o := organizationInstance{}
+
+	o.log = log.Log.WithValues("organization", req.NamespacedName)
+	o.log.Info("Organization Controller", "msg", "new reconciliation event")
+
+	err := r.Client.Get(ctx, req.NamespacedName, &o.instance)
+	if err != nil {
+		// 'Not found' error occurs when an object is removed from the Kubernetes
+		// No actions are required in this case
+		if kerrors.IsNotFound(err) {
+			o.log.Info("Organization Controller", "msg", "the instance was removed no further action is required")
+			return doNotRequeue()
+		}
+		o.log.Error(err, "Organization Controller", "msg", "get instance object")
+		return requeueAfter(requeueInterval)
+	}
+
+	if a, ok := o.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
+		o.log.Info("Organization Controller", "msg", "reconciliation is paused for this resource")
+		return doNotRequeue()
+	}
+
+	// The operator does not delete organizations, there is nothing to clean up once the object is marked as deleted.
+	if !o.instance.DeletionTimestamp.IsZero() {
+		o.log.Info("Organization Controller", "msg", "object marked as deleted, no further action is required")
+		return doNotRequeue()
+	}
+
+	o.log.Info("Spec Validation", "msg", "validating instance object spec")
+	if err := o.instance.ValidateSpec(); err != nil {
+		o.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
+		r.Recorder.Event(&o.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		return doNotRequeue()
+	}
+	o.log.Info("Spec Validation", "msg", "spec is valid")
+
+	err = r.getTerraformClient(ctx, &o)
+	if err != nil {
+		o.log.Error(err, "Organization Controller", "msg", "failed to get HCP Terraform client")
+		r.Recorder.Event(&o.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		return requeueAfter(requeueInterval)
+	}
+
+	err = r.reconcileOrganization(ctx, &o)
+	if err != nil {
+		o.log.Error(err, "Organization Controller", "msg", "reconcile organization")
+		r.Recorder.Event(&o.instance, corev1.EventTypeWarning, "ReconcileOrganization", "Failed to reconcile organization")
+		return requeueAfter(requeueInterval)
+	}
+	o.log.Info("Organization Controller", "msg", "successfully reconcilied organization")
+	r.Recorder.Eventf(&o.instance, corev1.EventTypeNormal, "ReconcileOrganization", "Successfully reconcilied organization %s", o.instance.Spec.Name)
+
+	return requeueAfter(OrganizationSyncPeriod)
+}
+
+func (r *OrganizationReconciler) getTerraformClient(ctx context.Context, o *organizationInstance) error {
+	nn := types.NamespacedName{
+		Namespace: o.instance.Namespace,
+		Name:      o.instance.Spec.Token.SecretKeyRef.Name,
+	}
+	token, err := secretKeyRef(ctx, r.Client, nn, o.instance.Spec.Token.SecretKeyRef.Key)
+	if err != nil {
+		return err
+	}
+
+	httpClient := tfc.DefaultConfig().HTTPClient
+	insecure := false
+
+	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
+		insecure, err = strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if insecure {
+		o.log.Info("Reconcile Organization", "msg", "client configured to skip TLS certificate verifications")
+	}
+
+	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
+
+	config := &tfc.Config{
+		Token:      token,
+		HTTPClient: httpClient,
+		Headers: http.Header{
+			"User-Agent": []string{version.UserAgent},
+		},
+	}
+	o.tfClient.Client, err = tfc.NewClient(config)
+
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *OrganizationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.Organization{}).
+		WithEventFilter(predicate.Or(genericPredicates())).
+		Complete(r)
+}
+
+func (r *OrganizationReconciler) updateStatus(ctx context.Context, o *organizationInstance, org *tfc.Organization, drift []string) error {
+	o.instance.Status.Name = org.Name
+	o.instance.Status.DefaultProjectID = ""
+	if org.DefaultProject != nil {
+		o.instance.Status.DefaultProjectID = org.DefaultProject.ID
+	}
+	o.instance.Status.DefaultAgentPoolID = ""
+	if org.DefaultAgentPool != nil {
+		o.instance.Status.DefaultAgentPoolID = org.DefaultAgentPool.ID
+	}
+	// The last detected drift is kept until the spec changes.
+	if len(drift) > 0 {
+		o.instance.Status.Drift = drift
+		o.instance.Status.DriftDetectedAt = &metav1.Time{Time: metav1.Now().Time}
+	} else if o.instance.Status.ObservedGeneration != o.instance.Generation {
+		o.instance.Status.Drift = nil
+		o.instance.Status.DriftDetectedAt = nil
+	}
+	o.instance.Status.ObservedGeneration = o.instance.Generation
+
+	return r.Status().Update(ctx, &o.instance)
+}
+
+func (r *OrganizationReconciler) getAgentPoolIDByName(ctx context.Context, o *organizationInstance) (string, error) {
+	agentPoolName := o.instance.Spec.DefaultAgentPool.Name
+
+	listOpts := &tfc.AgentPoolListOptions{
+		Query: agentPoolName,
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		agentPoolIDs, err := o.tfClient.Client.AgentPools.List(ctx, o.instance.Spec.Name, listOpts)
+		if err != nil {
+			return "", err
+		}
+		for _, a := range agentPoolIDs.Items {
+			if a.Name == agentPoolName {
+				return a.ID, nil
+			}
+		}
+		if agentPoolIDs.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = agentPoolIDs.NextPage
+	}
+
+	return "", fmt.Errorf("agent pool ID not found for agent pool name %q", agentPoolName)
+}
+
+// getUpdateOptions compares the organization settings with the desired ones.
+// Only the settings that are set in the spec are compared.
+// It returns the names of the settings that do not match.
+func (r *OrganizationReconciler) getUpdateOptions(ctx context.Context, o *organizationInstance, org *tfc.Organization) (tfc.OrganizationUpdateOptions, []string, error) {
+	spec := o.instance.Spec
+	options := tfc.OrganizationUpdateOptions{}
+	diff := []string{}
+
+	if spec.DefaultExecutionMode != "" && spec.DefaultExecutionMode != org.DefaultExecutionMode {
+		options.DefaultExecutionMode = tfc.String(spec.DefaultExecutionMode)
+		diff = append(diff, "defaultExecutionMode")
+	}
+
+	if spec.DefaultAgentPool != nil {
+		agentPoolID := spec.DefaultAgentPool.ID
+		if spec.DefaultAgentPool.Name != "" {
+			id, err := r.getAgentPoolIDByName(ctx, o)
+			if err != nil {
+				return options, nil, err
+			}
+			agentPoolID = id
+		}
+		if org.DefaultAgentPool == nil || org.DefaultAgentPool.ID != agentPoolID {
+			// The default agent pool requires the default execution mode to be sent in the same request.
+			options.DefaultExecutionMode = tfc.String(spec.DefaultExecutionMode)
+			options.DefaultAgentPool = &tfc.AgentPool{ID: agentPoolID}
+			diff = append(diff, "defaultAgentPool")
+		}
+	}
+
+	if spec.CostEstimationEnabled != nil && *spec.CostEstimationEnabled != org.CostEstimationEnabled {
+		options.CostEstimationEnabled = spec.CostEstimationEnabled
+		diff = append(diff, "costEstimationEnabled")
+	}
+
+	if spec.AssessmentsEnforced != nil && *spec.AssessmentsEnforced != org.AssessmentsEnforced {
+		options.AssessmentsEnforced = spec.AssessmentsEnforced
+		diff = append(diff, "assessmentsEnforced")
+	}
+
+	if spec.SpeculativePlanManagementEnabled != nil && *spec.SpeculativePlanManagementEnabled != org.SpeculativePlanManagementEnabled {
+		options.SpeculativePlanManagementEnabled = spec.SpeculativePlanManagementEnabled
+		diff = append(diff, "speculativePlanManagementEnabled")
+	}
+
+	if spec.Session != nil {
+		if spec.Session.Timeout != nil && *spec.Session.Timeout != org.SessionTimeout {
+			options.SessionTimeout = spec.Session.Timeout
+			diff = append(diff, "session.timeout")
+		}
+		if spec.Session.Remember != nil && *spec.Session.Remember != org.SessionRemember {
+			options.SessionRemember = spec.Session.Remember
+			diff = append(diff, "session.remember")
+		}
+	}
+
+	if spec.CollaboratorAuthPolicy != "" && spec.CollaboratorAuthPolicy != string(org.CollaboratorAuthPolicy) {
+		options.CollaboratorAuthPolicy = tfc.AuthPolicy(tfc.AuthPolicyType(spec.CollaboratorAuthPolicy))
+		diff = append(diff, "collaboratorAuthPolicy")
+	}
+
+	return options, diff, nil
+}
+
+func (r *OrganizationReconciler) reconcileOrganization(ctx context.Context, o *organizationInstance) error {
+	o.log.Info("Reconcile Organization", "msg", "reconciling organization")
+
+	org, err := o.tfClient.Client.Organizations.ReadWithOptions(ctx, o.instance.Spec.Name, tfc.OrganizationReadOptions{
+		Include: []tfc.OrganizationIncludeOpt{tfc.OrganizationDefaultProject},
+	})
+	if err != nil {
+		o.log.Error(err, "Reconcile Organization", "msg", fmt.Sprintf("failed to read organization %s", o.instance.Spec.Name))
+		r.Recorder.Eventf(&o.instance, corev1.EventTypeWarning, "ReconcileOrganization", "Failed to read organization %s", o.instance.Spec.Name)
+		return err
+	}
+
+	options, diff, err := r.getUpdateOptions(ctx, o, org)
+	if err != nil {
+		o.log.Error(err, "Reconcile Organization", "msg", "failed to get organization settings")
+		r.Recorder.Event(&o.instance, corev1.EventTypeWarning, "ReconcileOrganization", "Failed to get organization settings")
+		return err
+	}
+
+	// When the spec has not been changed since the last reconciliation, the observed differences were made outside of the operator.
+	var drift []string
+	if len(diff) > 0 && o.instance.Status.ObservedGeneration == o.instance.Generation {
+		drift = diff
+		o.log.Info("Reconcile Organization", "msg", fmt.Sprintf("drift detected in settings: %s", strings.Join(drift, ", ")))
+		r.Recorder.Eventf(&o.instance, corev1.EventTypeWarning, "OrganizationDrift", "Drift detected in settings: %s", strings.Join(drift, ", "))
+	}
+
+	if len(diff) > 0 {
+		o.log.Info("Reconcile Organization", "msg", fmt.Sprintf("observed and desired states are not matching, need to update organization %s", o.instance.Spec.Name))
+		updated, err := o.tfClient.Client.Organizations.Update(ctx, o.instance.Spec.Name, options)
+		if err != nil {
+			o.log.Error(err, "Reconcile Organization", "msg", fmt.Sprintf("failed to update organization %s", o.instance.Spec.Name))
+			r.Recorder.Eventf(&o.instance, corev1.EventTypeWarning, "ReconcileOrganization", "Failed to update organization %s", o.instance.Spec.Name)
+			return err
+		}
+		// The update response does not include the default project, keep it from the read response.
+		updated.DefaultProject = org.DefaultProject
+		org = updated
+	} else {
+		o.log.Info("Reconcile Organization", "msg", fmt.Sprintf("observed and desired states are matching, no need to update organization %s", o.instance.Spec.Name))
+	}
+
+	return r.updateStatus(ctx, o, org, drift)
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestOrganizationUpdateStatusDrift(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, appv1alpha2.AddToScheme(scheme))
+
+	instance := &appv1alpha2.Organization{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "this", Generation: 1},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).WithStatusSubresource(instance).Build()
+	r := &OrganizationReconciler{Client: k8sClient, Scheme: scheme}
+
+	ctx := context.Background()
+	o := &organizationInstance{log: logr.Discard()}
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(instance), &o.instance))
+	org := &tfc.Organization{Name: "kubernetes-operator"}
+
+	require.NoError(t, r.updateStatus(ctx, o, org, []string{"costEstimationEnabled"}))
+	assert.Equal(t, []string{"costEstimationEnabled"}, o.instance.Status.Drift)
+	require.NotNil(t, o.instance.Status.DriftDetectedAt)
+	detectedAt := o.instance.Status.DriftDetectedAt
+
+	// The drift is kept once it has been remediated.
+	require.NoError(t, r.updateStatus(ctx, o, org, nil))
+	assert.Equal(t, []string{"costEstimationEnabled"}, o.instance.Status.Drift)
+	assert.Equal(t, detectedAt, o.instance.Status.DriftDetectedAt)
+
+	// The drift is cleared when the spec changes.
+	o.instance.Generation = 2
+	require.NoError(t, r.updateStatus(ctx, o, org, nil))
+	assert.Empty(t, o.instance.Status.Drift)
+	assert.Nil(t, o.instance.Status.DriftDetectedAt)
+	assert.Equal(t, int64(2), o.instance.Status.ObservedGeneration)
+}
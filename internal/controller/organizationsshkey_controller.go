@@ -0,0 +1,354 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/version"
+)
+
+// OrganizationSSHKeyReconciler reconciles an OrganizationSSHKey object
+type OrganizationSSHKeyReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+	Scheme   *runtime.Scheme
+}
+
+type sshKeyInstance struct {
+	instance appv1alpha2.OrganizationSSHKey
+
+	log      logr.Logger
+	tfClient HCPTerraformClient
+}
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=organizationsshkeys,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.terraform.io,resources=organizationsshkeys/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=organizationsshkeys/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=list;watch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=workspaces,verbs=list;watch
+
+func (r *OrganizationSSHKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	s := sshKeyInstance{}
+
+	s.log = log.Log.WithValues("sshkey", req.NamespacedName)
+	s.log.Info("SSH Key Controller", "msg", "new reconciliation event")
+
+	err := r.Client.Get(ctx, req.NamespacedName, &s.instance)
+	if err != nil {
+		// 'Not found' error occurs when an object is removed from the Kubernetes
+		// No actions are required in this case
+		if kerrors.IsNotFound(err) {
+			s.log.Info("SSH Key Controller", "msg", "the instance was removed no further action is required")
+			return doNotRequeue()
+		}
+		s.log.Error(err, "SSH Key Controller", "msg", "get instance object")
+		return requeueAfter(requeueInterval)
+	}
+
+	if a, ok := s.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
+		s.log.Info("SSH Key Controller", "msg", "reconciliation is paused for this resource")
+		return doNotRequeue()
+	}
+
+	s.log.Info("Spec Validation", "msg", "validating instance object spec")
+	if err := s.instance.ValidateSpec(); err != nil {
+		s.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
+		r.Recorder.Event(&s.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		return doNotRequeue()
+	}
+	s.log.Info("Spec Validation", "msg", "spec is valid")
+
+	if needToAddFinalizer(&s.instance, sshKeyFinalizer) {
+		err := r.addFinalizer(ctx, &s.instance)
+		if err != nil {
+			s.log.Error(err, "SSH Key Controller", "msg", fmt.Sprintf("failed to add finalizer %s to the object", sshKeyFinalizer))
+			r.Recorder.Eventf(&s.instance, corev1.EventTypeWarning, "AddFinalizer", "Failed to add finalizer %s to the object", sshKeyFinalizer)
+			return requeueOnErr(err)
+		}
+		s.log.Info("SSH Key Controller", "msg", fmt.Sprintf("successfully added finalizer %s to the object", sshKeyFinalizer))
+		r.Recorder.Eventf(&s.instance, corev1.EventTypeNormal, "AddFinalizer", "Successfully added finalizer %s to the object", sshKeyFinalizer)
+	}
+
+	err = r.getTerraformClient(ctx, &s)
+	if err != nil {
+		s.log.Error(err, "SSH Key Controller", "msg", "failed to get HCP Terraform client")
+		r.Recorder.Event(&s.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		return requeueAfter(requeueInterval)
+	}
+
+	err = r.reconcileSSHKey(ctx, &s)
+	if err != nil {
+		s.log.Error(err, "SSH Key Controller", "msg", "reconcile SSH key")
+		r.Recorder.Event(&s.instance, corev1.EventTypeWarning, "ReconcileSSHKey", "Failed to reconcile SSH key")
+		return requeueAfter(requeueInterval)
+	}
+	s.log.Info("SSH Key Controller", "msg", "successfully reconcilied SSH key")
+	r.Recorder.Eventf(&s.instance, corev1.EventTypeNormal, "ReconcileSSHKey", "Successfully reconcilied SSH key ID %s", s.instance.Status.ID)
+
+	if len(s.instance.Status.PreviousIDs) > 0 {
+		return requeueAfter(requeueInterval)
+	}
+
+	return requeueAfter(SSHKeySyncPeriod)
+}
+
+func (r *OrganizationSSHKeyReconciler) addFinalizer(ctx context.Context, instance *appv1alpha2.OrganizationSSHKey) error {
+	controllerutil.AddFinalizer(instance, sshKeyFinalizer)
+
+	return r.Update(ctx, instance)
+}
+
+func (r *OrganizationSSHKeyReconciler) getTerraformClient(ctx context.Context, s *sshKeyInstance) error {
+	nn := types.NamespacedName{
+		Namespace: s.instance.Namespace,
+		Name:      s.instance.Spec.Token.SecretKeyRef.Name,
+	}
+	token, err := secretKeyRef(ctx, r.Client, nn, s.instance.Spec.Token.SecretKeyRef.Key)
+	if err != nil {
+		return err
+	}
+
+	httpClient := tfc.DefaultConfig().HTTPClient
+	insecure := false
+
+	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
+		insecure, err = strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if insecure {
+		s.log.Info("Reconcile SSH Key", "msg", "client configured to skip TLS certificate verifications")
+	}
+
+	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
+
+	config := &tfc.Config{
+		Token:      token,
+		HTTPClient: httpClient,
+		Headers: http.Header{
+			"User-Agent": []string{version.UserAgent},
+		},
+	}
+	s.tfClient.Client, err = tfc.NewClient(config)
+
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *OrganizationSSHKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.OrganizationSSHKey{}, builder.WithPredicates(predicate.Or(genericPredicates()))).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.secretToSSHKeys), builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
+		Watches(&appv1alpha2.Workspace{}, handler.EnqueueRequestsFromMapFunc(r.workspaceToSSHKeys)).
+		Complete(r)
+}
+
+func (r *OrganizationSSHKeyReconciler) updateStatus(ctx context.Context, s *sshKeyInstance, sshKey *tfc.SSHKey) error {
+	s.instance.Status.ObservedGeneration = s.instance.Generation
+	s.instance.Status.ID = sshKey.ID
+	s.instance.Status.Name = sshKey.Name
+
+	return r.Status().Update(ctx, &s.instance)
+}
+
+func (r *OrganizationSSHKeyReconciler) removeFinalizer(ctx context.Context, s *sshKeyInstance) error {
+	controllerutil.RemoveFinalizer(&s.instance, sshKeyFinalizer)
+
+	err := r.Update(ctx, &s.instance)
+	if err != nil {
+		s.log.Error(err, "Reconcile SSH Key", "msg", fmt.Sprintf("failed to remove finalizer %s", sshKeyFinalizer))
+		r.Recorder.Eventf(&s.instance, corev1.EventTypeWarning, "RemoveSSHKey", "Failed to remove finalizer %s", sshKeyFinalizer)
+	}
+
+	return err
+}
+
+// getPrivateKey returns the SSH private key from the referenced Secret as is.
+// Unlike secretKeyRef, it does not trim the value since trailing new lines are part of the key format.
+func (r *OrganizationSSHKeyReconciler) getPrivateKey(ctx context.Context, s *sshKeyInstance) (string, error) {
+	ref := s.instance.Spec.PrivateKey.SecretKeyRef
+	nn := types.NamespacedName{
+		Namespace: s.instance.Namespace,
+		Name:      ref.Name,
+	}
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, nn, secret); err != nil {
+		return "", err
+	}
+
+	if k, ok := secret.Data[ref.Key]; ok {
+		return string(k), nil
+	}
+
+	return "", fmt.Errorf("unable to find key=%q in secret=%q namespace=%q", ref.Key, nn.Name, nn.Namespace)
+}
+
+// sshKeyValueID calculates a hash of an SSH private key.
+func sshKeyValueID(privateKey string) string {
+	hash := sha256.Sum256([]byte(privateKey))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func (r *OrganizationSSHKeyReconciler) createSSHKey(ctx context.Context, s *sshKeyInstance, privateKey string) (*tfc.SSHKey, error) {
+	spec := s.instance.Spec
+	options := tfc.SSHKeyCreateOptions{
+		Name:  tfc.String(spec.Name),
+		Value: tfc.String(privateKey),
+	}
+
+	sshKey, err := s.tfClient.Client.SSHKeys.Create(ctx, spec.Organization, options)
+	if err != nil {
+		return nil, err
+	}
+
+	s.instance.Status.ID = sshKey.ID
+	s.instance.Status.ValueID = sshKeyValueID(privateKey)
+
+	return sshKey, nil
+}
+
+// rotateSSHKey replaces the SSH key with a new one created from the current private key.
+// HCP Terraform does not allow updating the value of an existing SSH key.
+// Workspaces that reference the OrganizationSSHKey object pick up the new SSH key ID on their next reconciliation,
+// the previous SSH key is deleted afterwards by reconcilePreviousSSHKeys.
+func (r *OrganizationSSHKeyReconciler) rotateSSHKey(ctx context.Context, s *sshKeyInstance, privateKey string) (*tfc.SSHKey, error) {
+	oldID := s.instance.Status.ID
+
+	sshKey, err := r.createSSHKey(ctx, s, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	s.instance.Status.RotatedAt = &metav1.Time{Time: metav1.Now().Time}
+	s.instance.Status.PreviousIDs = append(s.instance.Status.PreviousIDs, oldID)
+
+	// Persist the new SSH key ID and keep track of the previous one right away.
+	if err := r.Status().Update(ctx, &s.instance); err != nil {
+		return nil, err
+	}
+
+	return sshKey, nil
+}
+
+func (r *OrganizationSSHKeyReconciler) reconcileSSHKey(ctx context.Context, s *sshKeyInstance) error {
+	s.log.Info("Reconcile SSH Key", "msg", "reconciling SSH key")
+
+	// verify whether the Kubernetes object has been marked as deleted and if so delete the SSH key
+	if isDeletionCandidate(&s.instance, sshKeyFinalizer) {
+		s.log.Info("Reconcile SSH Key", "msg", "object marked as deleted, need to delete SSH key first")
+		r.Recorder.Event(&s.instance, corev1.EventTypeNormal, "ReconcileSSHKey", "Object marked as deleted, need to delete SSH key first")
+		return r.deleteSSHKey(ctx, s)
+	}
+
+	privateKey, err := r.getPrivateKey(ctx, s)
+	if err != nil {
+		s.log.Error(err, "Reconcile SSH Key", "msg", "failed to get SSH private key")
+		r.Recorder.Event(&s.instance, corev1.EventTypeWarning, "ReconcileSSHKey", "Failed to get SSH private key")
+		return err
+	}
+
+	var sshKey *tfc.SSHKey
+
+	// create a new SSH key if SSH key ID is unknown(means it was never created by the controller)
+	// this condition will work just one time, when a new Kubernetes object is created
+	if s.instance.IsCreationCandidate() {
+		s.log.Info("Reconcile SSH Key", "msg", "status.ID is empty, creating a new SSH key")
+		r.Recorder.Event(&s.instance, corev1.EventTypeNormal, "ReconcileSSHKey", "Status.ID is empty, creating a new SSH key")
+		sshKey, err = r.createSSHKey(ctx, s, privateKey)
+		if err != nil {
+			s.log.Error(err, "Reconcile SSH Key", "msg", "failed to create a new SSH key")
+			r.Recorder.Event(&s.instance, corev1.EventTypeWarning, "ReconcileSSHKey", "Failed to create a new SSH key")
+			return err
+		}
+		s.log.Info("Reconcile SSH Key", "msg", "successfully created a new SSH key")
+		r.Recorder.Eventf(&s.instance, corev1.EventTypeNormal, "ReconcileSSHKey", "Successfully created a new SSH key with ID %s", s.instance.Status.ID)
+		// Update the status with the SSH key ID right away to avoid creating duplicates if further steps fail.
+		return r.updateStatus(ctx, s, sshKey)
+	}
+
+	// rotate the SSH key if the private key has been changed
+	if s.instance.Status.ValueID != sshKeyValueID(privateKey) {
+		s.log.Info("Reconcile SSH Key", "msg", fmt.Sprintf("private key has been changed, rotating SSH key ID %s", s.instance.Status.ID))
+		r.Recorder.Eventf(&s.instance, corev1.EventTypeNormal, "RotateSSHKey", "Private key has been changed, rotating SSH key ID %s", s.instance.Status.ID)
+		sshKey, err = r.rotateSSHKey(ctx, s, privateKey)
+		if err != nil {
+			s.log.Error(err, "Reconcile SSH Key", "msg", "failed to rotate SSH key")
+			r.Recorder.Event(&s.instance, corev1.EventTypeWarning, "RotateSSHKey", "Failed to rotate SSH key")
+			return err
+		}
+		s.log.Info("Reconcile SSH Key", "msg", fmt.Sprintf("successfully rotated SSH key, new SSH key ID %s", s.instance.Status.ID))
+		r.Recorder.Eventf(&s.instance, corev1.EventTypeNormal, "RotateSSHKey", "Successfully rotated SSH key, new SSH key ID %s", s.instance.Status.ID)
+	}
+
+	// read the HCP Terraform SSH key to compare it with the Kubernetes object spec
+	sshKey, err = s.tfClient.Client.SSHKeys.Read(ctx, s.instance.Status.ID)
+	if err != nil {
+		// 'ResourceNotFound' means that the SSH key was removed from HCP Terraform bypass the operator
+		if err == tfc.ErrResourceNotFound {
+			s.log.Info("Reconcile SSH Key", "msg", "SSH key not found, creating a new SSH key")
+			r.Recorder.Eventf(&s.instance, corev1.EventTypeWarning, "ReconcileSSHKey", "SSH key ID %s not found, creating a new SSH key", s.instance.Status.ID)
+			sshKey, err = r.createSSHKey(ctx, s, privateKey)
+			if err != nil {
+				s.log.Error(err, "Reconcile SSH Key", "msg", "failed to create a new SSH key")
+				r.Recorder.Event(&s.instance, corev1.EventTypeWarning, "ReconcileSSHKey", "Failed to create a new SSH key")
+				return err
+			}
+			return r.updateStatus(ctx, s, sshKey)
+		}
+		s.log.Error(err, "Reconcile SSH Key", "msg", fmt.Sprintf("failed to read SSH key ID %s", s.instance.Status.ID))
+		r.Recorder.Eventf(&s.instance, corev1.EventTypeWarning, "ReconcileSSHKey", "Failed to read SSH key ID %s", s.instance.Status.ID)
+		return err
+	}
+
+	// update the SSH key name if it has been changed in the Kubernetes object spec or HCP Terraform
+	if sshKey.Name != s.instance.Spec.Name {
+		s.log.Info("Reconcile SSH Key", "msg", fmt.Sprintf("observed and desired states are not matching, need to update SSH key ID %s", s.instance.Status.ID))
+		sshKey, err = s.tfClient.Client.SSHKeys.Update(ctx, s.instance.Status.ID, tfc.SSHKeyUpdateOptions{
+			Name: tfc.String(s.instance.Spec.Name),
+		})
+		if err != nil {
+			s.log.Error(err, "Reconcile SSH Key", "msg", fmt.Sprintf("failed to update SSH key ID %s", s.instance.Status.ID))
+			r.Recorder.Eventf(&s.instance, corev1.EventTypeWarning, "ReconcileSSHKey", "Failed to update SSH key ID %s", s.instance.Status.ID)
+			return err
+		}
+	} else {
+		s.log.Info("Reconcile SSH Key", "msg", fmt.Sprintf("observed and desired states are matching, no need to update SSH key ID %s", s.instance.Status.ID))
+	}
+
+	if err := r.reconcilePreviousSSHKeys(ctx, s); err != nil {
+		s.log.Error(err, "Reconcile SSH Key", "msg", "failed to delete previous SSH keys")
+		r.Recorder.Event(&s.instance, corev1.EventTypeWarning, "RotateSSHKey", "Failed to delete previous SSH keys")
+		return err
+	}
+
+	return r.updateStatus(ctx, s, sshKey)
+}
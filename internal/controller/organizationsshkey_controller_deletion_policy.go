@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func (r *OrganizationSSHKeyReconciler) deleteSSHKey(ctx context.Context, s *sshKeyInstance) error {
+	s.log.Info("Reconcile SSH Key", "msg", fmt.Sprintf("deletion policy is %s", s.instance.Spec.DeletionPolicy))
+
+	if s.instance.Status.ID == "" {
+		s.log.Info("Reconcile SSH Key", "msg", fmt.Sprintf("status.ID is empty, remove finalizer %s", sshKeyFinalizer))
+		return r.removeFinalizer(ctx, s)
+	}
+
+	switch s.instance.Spec.DeletionPolicy {
+	case appv1alpha2.OrganizationSSHKeyDeletionPolicyRetain:
+		s.log.Info("Reconcile SSH Key", "msg", fmt.Sprintf("remove finalizer %s", sshKeyFinalizer))
+		return r.removeFinalizer(ctx, s)
+	case appv1alpha2.OrganizationSSHKeyDeletionPolicyDestroy:
+		if err := r.deletePreviousSSHKeys(ctx, s); err != nil {
+			s.log.Error(err, "Reconcile SSH Key", "msg", "failed to delete previous SSH keys, retry later")
+			r.Recorder.Event(&s.instance, corev1.EventTypeWarning, "ReconcileSSHKey", "Failed to delete previous SSH keys, retry later")
+			return err
+		}
+		err := s.tfClient.Client.SSHKeys.Delete(ctx, s.instance.Status.ID)
+		if err != nil {
+			if err == tfc.ErrResourceNotFound {
+				s.log.Info("Reconcile SSH Key", "msg", "SSH key was not found, remove finalizer")
+				return r.removeFinalizer(ctx, s)
+			}
+			s.log.Error(err, "Reconcile SSH Key", "msg", fmt.Sprintf("failed to delete SSH key ID %s, retry later", s.instance.Status.ID))
+			r.Recorder.Eventf(&s.instance, corev1.EventTypeWarning, "ReconcileSSHKey", "Failed to delete SSH key ID %s, retry later", s.instance.Status.ID)
+			return err
+		}
+
+		s.log.Info("Reconcile SSH Key", "msg", fmt.Sprintf("SSH key ID %s has been deleted, remove finalizer", s.instance.Status.ID))
+		return r.removeFinalizer(ctx, s)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// reconcilePreviousSSHKeys deletes the SSH keys replaced by a rotation once no Workspace that references the OrganizationSSHKey object uses them.
+func (r *OrganizationSSHKeyReconciler) reconcilePreviousSSHKeys(ctx context.Context, s *sshKeyInstance) error {
+	if len(s.instance.Status.PreviousIDs) == 0 {
+		return nil
+	}
+
+	wl := &appv1alpha2.WorkspaceList{}
+	if err := r.Client.List(ctx, wl, client.InNamespace(s.instance.Namespace)); err != nil {
+		return err
+	}
+	if pending := workspacesPendingSSHKey(wl.Items, s.instance.Name, s.instance.Status.ID); len(pending) > 0 {
+		s.log.Info("Reconcile SSH Key", "msg", fmt.Sprintf("previous SSH keys are still in use by workspaces %v", pending))
+		return nil
+	}
+
+	return r.deletePreviousSSHKeys(ctx, s)
+}
+
+// deletePreviousSSHKeys deletes the SSH keys replaced by a rotation.
+// The IDs of the SSH keys that failed to be deleted are kept in the status to retry later.
+func (r *OrganizationSSHKeyReconciler) deletePreviousSSHKeys(ctx context.Context, s *sshKeyInstance) error {
+	var remaining []string
+	var errs []error
+	for _, id := range s.instance.Status.PreviousIDs {
+		s.log.Info("Reconcile SSH Key", "msg", fmt.Sprintf("delete the previous SSH key ID %s", id))
+		if err := s.tfClient.Client.SSHKeys.Delete(ctx, id); err != nil && err != tfc.ErrResourceNotFound {
+			remaining = append(remaining, id)
+			errs = append(errs, err)
+		}
+	}
+	s.instance.Status.PreviousIDs = remaining
+
+	return errors.Join(errs...)
+}
+
+// workspacesPendingSSHKey returns the names of the Workspaces that reference the OrganizationSSHKey object and do not use its current SSH key ID yet.
+func workspacesPendingSSHKey(workspaces []appv1alpha2.Workspace, sshKeyName, sshKeyID string) []string {
+	var pending []string
+	for _, w := range workspaces {
+		if !referencesSSHKey(&w, sshKeyName) {
+			continue
+		}
+		if w.Status.SSHKeyID != sshKeyID {
+			pending = append(pending, w.Name)
+		}
+	}
+
+	return pending
+}
+
+// referencesSSHKey returns true if the Workspace references the OrganizationSSHKey object with the given name.
+func referencesSSHKey(w *appv1alpha2.Workspace, sshKeyName string) bool {
+	return w.Spec.SSHKey != nil && w.Spec.SSHKey.ObjectRef != nil && w.Spec.SSHKey.ObjectRef.Name == sshKeyName
+}
+
+// secretToSSHKeys maps a Secret to the OrganizationSSHKey objects whose private key it holds.
+func (r *OrganizationSSHKeyReconciler) secretToSSHKeys(ctx context.Context, o client.Object) []reconcile.Request {
+	skl := &appv1alpha2.OrganizationSSHKeyList{}
+	if err := r.Client.List(ctx, skl, client.InNamespace(o.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, sk := range skl.Items {
+		if sk.Spec.PrivateKey.SecretKeyRef == nil || sk.Spec.PrivateKey.SecretKeyRef.Name != o.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: sk.Namespace, Name: sk.Name},
+		})
+	}
+
+	return requests
+}
+
+// workspaceToSSHKeys maps a Workspace to the OrganizationSSHKey object it references when that object has previous SSH keys pending deletion.
+func (r *OrganizationSSHKeyReconciler) workspaceToSSHKeys(ctx context.Context, o client.Object) []reconcile.Request {
+	w, ok := o.(*appv1alpha2.Workspace)
+	if !ok || w.Spec.SSHKey == nil || w.Spec.SSHKey.ObjectRef == nil {
+		return nil
+	}
+
+	nn := types.NamespacedName{Namespace: w.Namespace, Name: w.Spec.SSHKey.ObjectRef.Name}
+	sk := &appv1alpha2.OrganizationSSHKey{}
+	if err := r.Client.Get(ctx, nn, sk); err != nil {
+		return nil
+	}
+	if len(sk.Status.PreviousIDs) == 0 || slices.Contains(sk.Status.PreviousIDs, w.Status.SSHKeyID) {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: nn}}
+}
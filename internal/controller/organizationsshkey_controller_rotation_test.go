@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestWorkspacesPendingSSHKey(t *testing.T) {
+	workspace := func(name string, sshKey *appv1alpha2.SSHKey, sshKeyID string) appv1alpha2.Workspace {
+		return appv1alpha2.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       appv1alpha2.WorkspaceSpec{SSHKey: sshKey},
+			Status:     appv1alpha2.WorkspaceStatus{SSHKeyID: sshKeyID},
+		}
+	}
+	ref := &appv1alpha2.SSHKey{ObjectRef: &corev1.LocalObjectReference{Name: "this"}}
+
+	workspaces := []appv1alpha2.Workspace{
+		workspace("up-to-date", ref, "sshkey-new"),
+		workspace("pending", ref, "sshkey-old"),
+		workspace("other-object", &appv1alpha2.SSHKey{ObjectRef: &corev1.LocalObjectReference{Name: "that"}}, "sshkey-old"),
+		workspace("by-id", &appv1alpha2.SSHKey{ID: "sshkey-old"}, "sshkey-old"),
+		workspace("no-ssh-key", nil, ""),
+	}
+
+	assert.Equal(t, []string{"pending"}, workspacesPendingSSHKey(workspaces, "this", "sshkey-new"))
+	assert.Empty(t, workspacesPendingSSHKey(workspaces[:1], "this", "sshkey-new"))
+}
@@ -4,11 +4,15 @@
 package controller
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 )
 
 // genericPredicates return predicates that are common for all controllers.
@@ -84,6 +88,9 @@ func workspacePredicates() predicate.Predicate {
 			if a, ok := e.ObjectNew.GetAnnotations()[WorkspaceAnnotationRunNew]; ok && a == MetaTrue {
 				return true
 			}
+			if a, ok := e.ObjectNew.GetAnnotations()[WorkspaceAnnotationRunOverride]; ok && a != "" {
+				return true
+			}
 
 			// Do not call reconciliation in all other cases
 			return false
@@ -91,13 +98,124 @@ func workspacePredicates() predicate.Predicate {
 	}
 }
 
+// organizationSSHKeyPredicates returns predicates that are specific for OrganizationSSHKey objects watched by the workspace controller.
+// They trigger reconciliation only when the SSH key ID changes, i.e. when the SSH key is created or rotated.
+func organizationSSHKeyPredicates() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			o, ok := e.ObjectOld.(*appv1alpha2.OrganizationSSHKey)
+			if !ok {
+				return false
+			}
+			n, ok := e.ObjectNew.(*appv1alpha2.OrganizationSSHKey)
+			if !ok {
+				return false
+			}
+
+			return n.Status.ID != "" && o.Status.ID != n.Status.ID
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// agentPoolPodPredicates returns predicates that are specific for agent pods watched by the agent pool controller.
+// They trigger reconciliation only when a container of an agent pod starts or stops crash-looping.
+func agentPoolPodPredicates() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			o, ok := e.ObjectOld.(*corev1.Pod)
+			if !ok {
+				return false
+			}
+			n, ok := e.ObjectNew.(*corev1.Pod)
+			if !ok {
+				return false
+			}
+
+			return (crashLoopingContainer(o) == nil) != (crashLoopingContainer(n) == nil)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// agentPoolDeploymentPredicates returns predicates that are specific for agent deployments owned by the agent pool controller.
+// They trigger reconciliation when the deployment spec changes, the deployment is deleted,
+// or the status or reason of the `Available` or `Progressing` condition changes, i.e. when a rollout starts or completes.
+func agentPoolDeploymentPredicates() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			o, ok := e.ObjectOld.(*appsv1.Deployment)
+			if !ok {
+				return false
+			}
+			n, ok := e.ObjectNew.(*appsv1.Deployment)
+			if !ok {
+				return false
+			}
+
+			if o.Generation != n.Generation {
+				return true
+			}
+			for _, t := range []appsv1.DeploymentConditionType{appsv1.DeploymentAvailable, appsv1.DeploymentProgressing} {
+				oc, nc := deploymentCondition(o, t), deploymentCondition(n, t)
+				if oc.Status != nc.Status || oc.Reason != nc.Reason {
+					return true
+				}
+			}
+
+			return false
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// deploymentCondition returns the condition of the deployment with the given type.
+// It returns an empty condition if the deployment does not have it.
+func deploymentCondition(d *appsv1.Deployment, t appsv1.DeploymentConditionType) appsv1.DeploymentCondition {
+	for _, c := range d.Status.Conditions {
+		if c.Type == t {
+			return c
+		}
+	}
+
+	return appsv1.DeploymentCondition{}
+}
+
 func deletionTimestampPredicate(o client.Object) bool {
 	finalizers := []string{
 		agentPoolFinalizer,
 		agentTokenFinalizer,
+		apiTokenFinalizer,
 		moduleFinalizer,
+		noCodeWorkspaceFinalizer,
 		projectFinalizer,
+		registryModuleFinalizer,
 		runsCollectorFinalizer,
+		sshKeyFinalizer,
 		workspaceFinalizer,
 	}
 
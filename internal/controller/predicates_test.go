@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestAgentPoolDeploymentPredicates(t *testing.T) {
+	deployment := func(generation int64, conditions ...appsv1.DeploymentCondition) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Generation: generation},
+			Status:     appsv1.DeploymentStatus{Conditions: conditions},
+		}
+	}
+	available := appsv1.DeploymentCondition{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, Reason: "MinimumReplicasAvailable"}
+	unavailable := appsv1.DeploymentCondition{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse, Reason: "MinimumReplicasUnavailable"}
+	progressing := appsv1.DeploymentCondition{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "ReplicaSetUpdated"}
+	progressed := appsv1.DeploymentCondition{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "NewReplicaSetAvailable"}
+
+	cases := map[string]struct {
+		old      *appsv1.Deployment
+		new      *appsv1.Deployment
+		expected bool
+	}{
+		"GenerationChanged":       {old: deployment(1, available), new: deployment(2, available), expected: true},
+		"AvailableChanged":        {old: deployment(1, available), new: deployment(1, unavailable), expected: true},
+		"ProgressingReasonChange": {old: deployment(1, available, progressing), new: deployment(1, available, progressed), expected: true},
+		"ConditionAdded":          {old: deployment(1), new: deployment(1, available), expected: true},
+		"StatusOnly":              {old: deployment(1, available, progressed), new: deployment(1, available, progressed), expected: false},
+	}
+
+	p := agentPoolDeploymentPredicates()
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.expected, p.Update(event.UpdateEvent{ObjectOld: c.old, ObjectNew: c.new}))
+		})
+	}
+
+	assert.False(t, p.Create(event.CreateEvent{Object: deployment(1)}))
+	assert.True(t, p.Delete(event.DeleteEvent{Object: deployment(1)}))
+	assert.False(t, p.Generic(event.GenericEvent{Object: deployment(1)}))
+}
+
+func TestAgentPodCacheOptions(t *testing.T) {
+	selector := AgentPodCacheOptions().Label
+	assert.True(t, selector.Matches(labels.Set{poolNameLabel: "this"}))
+	assert.False(t, selector.Matches(labels.Set{"app": "other"}))
+}
+
+func TestOrganizationSSHKeyPredicates(t *testing.T) {
+	sshKey := func(id string) *appv1alpha2.OrganizationSSHKey {
+		return &appv1alpha2.OrganizationSSHKey{Status: appv1alpha2.OrganizationSSHKeyStatus{ID: id}}
+	}
+
+	cases := map[string]struct {
+		old      *appv1alpha2.OrganizationSSHKey
+		new      *appv1alpha2.OrganizationSSHKey
+		expected bool
+	}{
+		"Created":   {old: sshKey(""), new: sshKey("sshkey-1"), expected: true},
+		"Rotated":   {old: sshKey("sshkey-1"), new: sshKey("sshkey-2"), expected: true},
+		"Unchanged": {old: sshKey("sshkey-1"), new: sshKey("sshkey-1"), expected: false},
+		"Cleared":   {old: sshKey("sshkey-1"), new: sshKey(""), expected: false},
+	}
+
+	p := organizationSSHKeyPredicates()
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.expected, p.Update(event.UpdateEvent{ObjectOld: c.old, ObjectNew: c.new}))
+		})
+	}
+
+	assert.False(t, p.Create(event.CreateEvent{Object: sshKey("sshkey-1")}))
+	assert.False(t, p.Delete(event.DeleteEvent{Object: sshKey("sshkey-1")}))
+	assert.False(t, p.Generic(event.GenericEvent{Object: sshKey("sshkey-1")}))
+}
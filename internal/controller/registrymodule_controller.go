@@ -0,0 +1,459 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/version"
+)
+
+// RegistryModuleReconciler reconciles a RegistryModule object
+type RegistryModuleReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+	Scheme   *runtime.Scheme
+}
+
+type registryModuleInstance struct {
+	instance appv1alpha2.RegistryModule
+
+	log      logr.Logger
+	tfClient HCPTerraformClient
+}
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=registrymodules,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.terraform.io,resources=registrymodules/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=registrymodules/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=list;watch
+
+func (r *RegistryModuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	m := registryModuleInstance{}
+
+	m.log = log.Log.WithValues("registrymodule", req.NamespacedName)
+	m.log.Info("Registry Module Controller", "msg", "new reconciliation event")
+
+	err := r.Client.Get(ctx, req.NamespacedName, &m.instance)
+	if err != nil {
+		// 'Not found' error occurs when an object is removed from the Kubernetes
+		// No actions are required in this case
+		if kerrors.IsNotFound(err) {
+			m.log.Info("Registry Module Controller", "msg", "the instance was removed no further action is required")
+			return doNotRequeue()
+		}
+		m.log.Error(err, "Registry Module Controller", "msg", "get instance object")
+		return requeueAfter(requeueInterval)
+	}
+
+	if a, ok := m.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
+		m.log.Info("Registry Module Controller", "msg", "reconciliation is paused for this resource")
+		return doNotRequeue()
+	}
+
+	m.log.Info("Spec Validation", "msg", "validating instance object spec")
+	if err := m.instance.ValidateSpec(); err != nil {
+		m.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
+		r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		return doNotRequeue()
+	}
+	m.log.Info("Spec Validation", "msg", "spec is valid")
+
+	if needToAddFinalizer(&m.instance, registryModuleFinalizer) {
+		err := r.addFinalizer(ctx, &m.instance)
+		if err != nil {
+			m.log.Error(err, "Registry Module Controller", "msg", fmt.Sprintf("failed to add finalizer %s to the object", registryModuleFinalizer))
+			r.Recorder.Eventf(&m.instance, corev1.EventTypeWarning, "AddFinalizer", "Failed to add finalizer %s to the object", registryModuleFinalizer)
+			return requeueOnErr(err)
+		}
+		m.log.Info("Registry Module Controller", "msg", fmt.Sprintf("successfully added finalizer %s to the object", registryModuleFinalizer))
+		r.Recorder.Eventf(&m.instance, corev1.EventTypeNormal, "AddFinalizer", "Successfully added finalizer %s to the object", registryModuleFinalizer)
+	}
+
+	err = r.getTerraformClient(ctx, &m)
+	if err != nil {
+		m.log.Error(err, "Registry Module Controller", "msg", "failed to get HCP Terraform client")
+		r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		return requeueAfter(requeueInterval)
+	}
+
+	err = r.reconcileRegistryModule(ctx, &m)
+	if err != nil {
+		m.log.Error(err, "Registry Module Controller", "msg", "reconcile registry module")
+		r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "ReconcileRegistryModule", "Failed to reconcile registry module")
+		return requeueAfter(requeueInterval)
+	}
+	m.log.Info("Registry Module Controller", "msg", "successfully reconcilied registry module")
+	r.Recorder.Eventf(&m.instance, corev1.EventTypeNormal, "ReconcileRegistryModule", "Successfully reconcilied registry module ID %s", m.instance.Status.ID)
+
+	return requeueAfter(RegistryModuleSyncPeriod)
+}
+
+func (r *RegistryModuleReconciler) addFinalizer(ctx context.Context, instance *appv1alpha2.RegistryModule) error {
+	controllerutil.AddFinalizer(instance, registryModuleFinalizer)
+
+	return r.Update(ctx, instance)
+}
+
+func (r *RegistryModuleReconciler) getTerraformClient(ctx context.Context, m *registryModuleInstance) error {
+	nn := types.NamespacedName{
+		Namespace: m.instance.Namespace,
+		Name:      m.instance.Spec.Token.SecretKeyRef.Name,
+	}
+	token, err := secretKeyRef(ctx, r.Client, nn, m.instance.Spec.Token.SecretKeyRef.Key)
+	if err != nil {
+		return err
+	}
+
+	httpClient := tfc.DefaultConfig().HTTPClient
+	insecure := false
+
+	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
+		insecure, err = strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if insecure {
+		m.log.Info("Reconcile Registry Module", "msg", "client configured to skip TLS certificate verifications")
+	}
+
+	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
+
+	config := &tfc.Config{
+		Token:      token,
+		HTTPClient: httpClient,
+		Headers: http.Header{
+			"User-Agent": []string{version.UserAgent},
+		},
+	}
+	m.tfClient.Client, err = tfc.NewClient(config)
+
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RegistryModuleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.RegistryModule{}).
+		WithEventFilter(predicate.Or(genericPredicates())).
+		Complete(r)
+}
+
+func (r *RegistryModuleReconciler) updateStatus(ctx context.Context, m *registryModuleInstance, registryModule *tfc.RegistryModule) error {
+	m.instance.Status.ObservedGeneration = m.instance.Generation
+	m.instance.Status.ID = registryModule.ID
+	m.instance.Status.Name = registryModule.Name
+	m.instance.Status.Provider = registryModule.Provider
+	m.instance.Status.Status = string(registryModule.Status)
+	m.instance.Status.Source = fmt.Sprintf("%s/%s/%s/%s", m.tfClient.Client.BaseURL().Host, m.instance.Spec.Organization, registryModule.Name, registryModule.Provider)
+
+	m.instance.Status.Versions = make([]appv1alpha2.RegistryModuleVersionStatus, len(registryModule.VersionStatuses))
+	for i, v := range registryModule.VersionStatuses {
+		m.instance.Status.Versions[i] = appv1alpha2.RegistryModuleVersionStatus{
+			Version: v.Version,
+			Status:  string(v.Status),
+			Error:   v.Error,
+		}
+	}
+
+	return r.Status().Update(ctx, &m.instance)
+}
+
+func (r *RegistryModuleReconciler) removeFinalizer(ctx context.Context, m *registryModuleInstance) error {
+	controllerutil.RemoveFinalizer(&m.instance, registryModuleFinalizer)
+
+	err := r.Update(ctx, &m.instance)
+	if err != nil {
+		m.log.Error(err, "Reconcile Registry Module", "msg", fmt.Sprintf("failed to remove finalizer %s", registryModuleFinalizer))
+		r.Recorder.Eventf(&m.instance, corev1.EventTypeWarning, "RemoveRegistryModule", "Failed to remove finalizer %s", registryModuleFinalizer)
+	}
+
+	return err
+}
+
+func (r *RegistryModuleReconciler) getAgentPoolIDByName(ctx context.Context, m *registryModuleInstance) (string, error) {
+	agentPoolName := m.instance.Spec.TestConfig.AgentPool.Name
+
+	listOpts := &tfc.AgentPoolListOptions{
+		Query: agentPoolName,
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		agentPoolIDs, err := m.tfClient.Client.AgentPools.List(ctx, m.instance.Spec.Organization, listOpts)
+		if err != nil {
+			return "", err
+		}
+		for _, a := range agentPoolIDs.Items {
+			if a.Name == agentPoolName {
+				return a.ID, nil
+			}
+		}
+		if agentPoolIDs.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = agentPoolIDs.NextPage
+	}
+
+	return "", fmt.Errorf("agent pool ID not found for agent pool name %q", agentPoolName)
+}
+
+// getTestConfigOptions returns the desired test settings of the module.
+// It returns nil if the module is not VCS-backed since tests are only supported for such modules.
+func (r *RegistryModuleReconciler) getTestConfigOptions(ctx context.Context, m *registryModuleInstance) (*tfc.RegistryModuleTestConfigOptions, error) {
+	if !m.instance.IsVersionControlled() {
+		return nil, nil
+	}
+
+	spec := m.instance.Spec.TestConfig
+	if spec == nil {
+		return &tfc.RegistryModuleTestConfigOptions{
+			TestsEnabled: tfc.Bool(false),
+		}, nil
+	}
+
+	options := &tfc.RegistryModuleTestConfigOptions{
+		TestsEnabled:       tfc.Bool(spec.TestsEnabled),
+		AgentExecutionMode: tfc.AgentExecutionModePtr(tfc.AgentExecutionModeRemote),
+	}
+
+	if spec.AgentPool != nil {
+		agentPoolID := spec.AgentPool.ID
+		if spec.AgentPool.Name != "" {
+			id, err := r.getAgentPoolIDByName(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			agentPoolID = id
+		}
+		options.AgentExecutionMode = tfc.AgentExecutionModePtr(tfc.AgentExecutionModeAgent)
+		options.AgentPoolID = tfc.String(agentPoolID)
+	}
+
+	return options, nil
+}
+
+func (r *RegistryModuleReconciler) createRegistryModule(ctx context.Context, m *registryModuleInstance) (*tfc.RegistryModule, error) {
+	spec := m.instance.Spec
+
+	if !m.instance.IsVersionControlled() {
+		return m.tfClient.Client.RegistryModules.Create(ctx, spec.Organization, tfc.RegistryModuleCreateOptions{
+			Name:         tfc.String(spec.Name),
+			Provider:     tfc.String(spec.Provider),
+			RegistryName: tfc.PrivateRegistry,
+			NoCode:       tfc.Bool(spec.NoCode),
+		})
+	}
+
+	testConfig, err := r.getTestConfigOptions(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	vcs := spec.VersionControl
+	options := tfc.RegistryModuleCreateWithVCSConnectionOptions{
+		VCSRepo: &tfc.RegistryModuleVCSRepoOptions{
+			Identifier:        tfc.String(vcs.Repository),
+			DisplayIdentifier: tfc.String(vcs.Repository),
+			OAuthTokenID:      tfc.String(vcs.OAuthTokenID),
+			OrganizationName:  tfc.String(spec.Organization),
+		},
+		TestConfig: testConfig,
+	}
+	if vcs.Branch != "" {
+		options.VCSRepo.Branch = tfc.String(vcs.Branch)
+		if vcs.InitialVersion != "" {
+			options.InitialVersion = tfc.String(vcs.InitialVersion)
+		}
+	} else {
+		options.VCSRepo.Tags = tfc.Bool(true)
+	}
+	if vcs.TagPrefix != "" {
+		options.VCSRepo.TagPrefix = tfc.String(vcs.TagPrefix)
+	}
+	if vcs.SourceDirectory != "" {
+		options.VCSRepo.SourceDirectory = tfc.String(vcs.SourceDirectory)
+	}
+
+	return m.tfClient.Client.RegistryModules.CreateWithVCSConnection(ctx, options)
+}
+
+// getUpdateOptions compares the registry module settings with the desired ones.
+// It returns true if the registry module needs to be updated.
+func (r *RegistryModuleReconciler) getUpdateOptions(ctx context.Context, m *registryModuleInstance, registryModule *tfc.RegistryModule) (tfc.RegistryModuleUpdateOptions, bool, error) {
+	spec := m.instance.Spec
+	options := tfc.RegistryModuleUpdateOptions{}
+	update := false
+
+	if registryModule.NoCode != spec.NoCode {
+		options.NoCode = tfc.Bool(spec.NoCode)
+		update = true
+	}
+
+	if !m.instance.IsVersionControlled() {
+		return options, update, nil
+	}
+
+	testConfig, err := r.getTestConfigOptions(ctx, m)
+	if err != nil {
+		return options, false, err
+	}
+	current := registryModule.TestConfig
+	if current == nil {
+		current = &tfc.TestConfig{}
+	}
+	if current.TestsEnabled != *testConfig.TestsEnabled ||
+		(testConfig.AgentPoolID != nil && (current.AgentPoolID == nil || *current.AgentPoolID != *testConfig.AgentPoolID)) ||
+		(testConfig.AgentPoolID == nil && current.AgentPoolID != nil && *current.AgentPoolID != "") {
+		options.TestConfig = testConfig
+		update = true
+	}
+
+	vcs := spec.VersionControl
+	vcsRepo := registryModule.VCSRepo
+	if vcsRepo == nil {
+		vcsRepo = &tfc.VCSRepo{}
+	}
+	vcsOptions := &tfc.RegistryModuleVCSRepoUpdateOptions{}
+	updateVCS := false
+	if vcs.Branch != "" && vcsRepo.Branch != vcs.Branch {
+		vcsOptions.Branch = tfc.String(vcs.Branch)
+		updateVCS = true
+	}
+	if vcs.Branch == "" && !vcsRepo.Tags {
+		vcsOptions.Tags = tfc.Bool(true)
+		updateVCS = true
+	}
+	if vcsRepo.TagPrefix != vcs.TagPrefix {
+		vcsOptions.TagPrefix = tfc.String(vcs.TagPrefix)
+		updateVCS = true
+	}
+	if vcsRepo.SourceDirectory != vcs.SourceDirectory {
+		vcsOptions.SourceDirectory = tfc.String(vcs.SourceDirectory)
+		updateVCS = true
+	}
+	if updateVCS {
+		options.VCSRepo = vcsOptions
+		update = true
+	}
+
+	return options, update, nil
+}
+
+func (r *RegistryModuleReconciler) reconcileRegistryModule(ctx context.Context, m *registryModuleInstance) error {
+	m.log.Info("Reconcile Registry Module", "msg", "reconciling registry module")
+
+	// verify whether the Kubernetes object has been marked as deleted and if so delete the registry module
+	if isDeletionCandidate(&m.instance, registryModuleFinalizer) {
+		m.log.Info("Reconcile Registry Module", "msg", "object marked as deleted, need to delete registry module first")
+		r.Recorder.Event(&m.instance, corev1.EventTypeNormal, "ReconcileRegistryModule", "Object marked as deleted, need to delete registry module first")
+		return r.deleteRegistryModule(ctx, m)
+	}
+
+	var registryModule *tfc.RegistryModule
+	var err error
+
+	// create a new registry module if registry module ID is unknown(means it was never created by the controller)
+	// this condition will work just one time, when a new Kubernetes object is created
+	if m.instance.IsCreationCandidate() {
+		m.log.Info("Reconcile Registry Module", "msg", "status.ID is empty, creating a new registry module")
+		r.Recorder.Event(&m.instance, corev1.EventTypeNormal, "ReconcileRegistryModule", "Status.ID is empty, creating a new registry module")
+		registryModule, err = r.createRegistryModule(ctx, m)
+		if err != nil {
+			m.log.Error(err, "Reconcile Registry Module", "msg", "failed to create a new registry module")
+			r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "ReconcileRegistryModule", "Failed to create a new registry module")
+			return err
+		}
+		m.log.Info("Reconcile Registry Module", "msg", "successfully created a new registry module")
+		r.Recorder.Eventf(&m.instance, corev1.EventTypeNormal, "ReconcileRegistryModule", "Successfully created a new registry module with ID %s", registryModule.ID)
+		// Update the status with the registry module ID right away to avoid creating duplicates if further steps fail.
+		if err := r.updateStatus(ctx, m, registryModule); err != nil {
+			return err
+		}
+	}
+
+	// read the HCP Terraform registry module to compare it with the Kubernetes object spec
+	registryModule, err = m.tfClient.Client.RegistryModules.Read(ctx, tfc.RegistryModuleID{ID: m.instance.Status.ID})
+	if err != nil {
+		// 'ResourceNotFound' means that the registry module was removed from HCP Terraform bypass the operator
+		if err == tfc.ErrResourceNotFound {
+			m.log.Info("Reconcile Registry Module", "msg", "registry module not found, creating a new registry module")
+			r.Recorder.Eventf(&m.instance, corev1.EventTypeWarning, "ReconcileRegistryModule", "Registry module ID %s not found, creating a new registry module", m.instance.Status.ID)
+			registryModule, err = r.createRegistryModule(ctx, m)
+			if err != nil {
+				m.log.Error(err, "Reconcile Registry Module", "msg", "failed to create a new registry module")
+				r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "ReconcileRegistryModule", "Failed to create a new registry module")
+				return err
+			}
+			return r.updateStatus(ctx, m, registryModule)
+		}
+		m.log.Error(err, "Reconcile Registry Module", "msg", fmt.Sprintf("failed to read registry module ID %s", m.instance.Status.ID))
+		r.Recorder.Eventf(&m.instance, corev1.EventTypeWarning, "ReconcileRegistryModule", "Failed to read registry module ID %s", m.instance.Status.ID)
+		return err
+	}
+
+	options, update, err := r.getUpdateOptions(ctx, m, registryModule)
+	if err != nil {
+		m.log.Error(err, "Reconcile Registry Module", "msg", "failed to get registry module settings")
+		r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "ReconcileRegistryModule", "Failed to get registry module settings")
+		return err
+	}
+	if update {
+		m.log.Info("Reconcile Registry Module", "msg", fmt.Sprintf("observed and desired states are not matching, need to update registry module ID %s", m.instance.Status.ID))
+		registryModule, err = m.tfClient.Client.RegistryModules.Update(ctx, tfc.RegistryModuleID{
+			Organization: m.instance.Spec.Organization,
+			Namespace:    registryModule.Namespace,
+			Name:         registryModule.Name,
+			Provider:     registryModule.Provider,
+			RegistryName: tfc.PrivateRegistry,
+		}, options)
+		if err != nil {
+			m.log.Error(err, "Reconcile Registry Module", "msg", fmt.Sprintf("failed to update registry module ID %s", m.instance.Status.ID))
+			r.Recorder.Eventf(&m.instance, corev1.EventTypeWarning, "ReconcileRegistryModule", "Failed to update registry module ID %s", m.instance.Status.ID)
+			return err
+		}
+	} else {
+		m.log.Info("Reconcile Registry Module", "msg", fmt.Sprintf("observed and desired states are matching, no need to update registry module ID %s", m.instance.Status.ID))
+	}
+
+	if !m.instance.IsVersionControlled() {
+		published, err := r.reconcileVersions(ctx, m, registryModule)
+		if err != nil {
+			return err
+		}
+		if published {
+			// re-read the registry module to report the newly published versions in the status
+			registryModule, err = m.tfClient.Client.RegistryModules.Read(ctx, tfc.RegistryModuleID{ID: m.instance.Status.ID})
+			if err != nil {
+				m.log.Error(err, "Reconcile Registry Module", "msg", fmt.Sprintf("failed to read registry module ID %s", m.instance.Status.ID))
+				return err
+			}
+		}
+	}
+
+	return r.updateStatus(ctx, m, registryModule)
+}
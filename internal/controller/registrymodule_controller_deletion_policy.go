@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func (r *RegistryModuleReconciler) deleteRegistryModule(ctx context.Context, m *registryModuleInstance) error {
+	m.log.Info("Reconcile Registry Module", "msg", fmt.Sprintf("deletion policy is %s", m.instance.Spec.DeletionPolicy))
+
+	if m.instance.Status.ID == "" {
+		m.log.Info("Reconcile Registry Module", "msg", fmt.Sprintf("status.ID is empty, remove finalizer %s", registryModuleFinalizer))
+		return r.removeFinalizer(ctx, m)
+	}
+
+	switch m.instance.Spec.DeletionPolicy {
+	case appv1alpha2.RegistryModuleDeletionPolicyRetain:
+		m.log.Info("Reconcile Registry Module", "msg", fmt.Sprintf("remove finalizer %s", registryModuleFinalizer))
+		return r.removeFinalizer(ctx, m)
+	case appv1alpha2.RegistryModuleDeletionPolicyDestroy:
+		err := m.tfClient.Client.RegistryModules.DeleteProvider(ctx, tfc.RegistryModuleID{
+			Organization: m.instance.Spec.Organization,
+			Namespace:    m.instance.Spec.Organization,
+			Name:         m.instance.Status.Name,
+			Provider:     m.instance.Status.Provider,
+			RegistryName: tfc.PrivateRegistry,
+		})
+		if err != nil {
+			if err == tfc.ErrResourceNotFound {
+				m.log.Info("Reconcile Registry Module", "msg", "registry module was not found, remove finalizer")
+				return r.removeFinalizer(ctx, m)
+			}
+			m.log.Error(err, "Reconcile Registry Module", "msg", fmt.Sprintf("failed to delete registry module ID %s, retry later", m.instance.Status.ID))
+			r.Recorder.Eventf(&m.instance, corev1.EventTypeWarning, "ReconcileRegistryModule", "Failed to delete registry module ID %s, retry later", m.instance.Status.ID)
+			return err
+		}
+
+		m.log.Info("Reconcile Registry Module", "msg", fmt.Sprintf("registry module ID %s has been deleted, remove finalizer", m.instance.Status.ID))
+		return r.removeFinalizer(ctx, m)
+	}
+
+	return nil
+}
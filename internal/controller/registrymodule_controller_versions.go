@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// writeModuleFiles writes each key of the ConfigMap data as a file in a new temporary directory.
+func writeModuleFiles(cm *corev1.ConfigMap) (string, error) {
+	td, err := os.MkdirTemp("", "tf-*")
+	if err != nil {
+		return td, err
+	}
+
+	for k, v := range cm.Data {
+		if err := os.WriteFile(filepath.Join(td, k), []byte(v), 0o600); err != nil {
+			return td, err
+		}
+	}
+
+	return td, nil
+}
+
+func (r *RegistryModuleReconciler) uploadVersion(ctx context.Context, m *registryModuleInstance, rmv *tfc.RegistryModuleVersion, source appv1alpha2.RegistryModuleVersionSource) error {
+	cm := &corev1.ConfigMap{}
+
+	if source.ConfigMapRef != nil {
+		nn := types.NamespacedName{
+			Namespace: m.instance.Namespace,
+			Name:      source.ConfigMapRef.Name,
+		}
+		if err := r.Client.Get(ctx, nn, cm); err != nil {
+			return err
+		}
+
+		path, err := writeModuleFiles(cm)
+		defer os.RemoveAll(path)
+		if err != nil {
+			return err
+		}
+
+		return m.tfClient.Client.RegistryModules.Upload(ctx, *rmv, path)
+	}
+
+	nn := types.NamespacedName{
+		Namespace: m.instance.Namespace,
+		Name:      source.TarballRef.Name,
+	}
+	if err := r.Client.Get(ctx, nn, cm); err != nil {
+		return err
+	}
+
+	tarball, ok := cm.BinaryData[source.TarballRef.Key]
+	if !ok {
+		return fmt.Errorf("unable to find key=%q in configmap=%q namespace=%q", source.TarballRef.Key, nn.Name, nn.Namespace)
+	}
+
+	uploadURL, ok := rmv.Links["upload"].(string)
+	if !ok {
+		return fmt.Errorf("registry module version %s does not contain an upload link", rmv.Version)
+	}
+
+	return m.tfClient.Client.RegistryModules.UploadTarGzip(ctx, uploadURL, bytes.NewReader(tarball))
+}
+
+// reconcileVersions publishes versions from the spec that are missing in the private registry.
+// Versions that are already published are immutable and are not uploaded again.
+// It returns true if at least one version has been published.
+func (r *RegistryModuleReconciler) reconcileVersions(ctx context.Context, m *registryModuleInstance, registryModule *tfc.RegistryModule) (bool, error) {
+	m.log.Info("Reconcile Registry Module Versions", "msg", "new reconciliation event")
+
+	published := make(map[string]struct{}, len(registryModule.VersionStatuses))
+	for _, v := range registryModule.VersionStatuses {
+		published[v.Version] = struct{}{}
+	}
+
+	moduleID := tfc.RegistryModuleID{
+		Organization: m.instance.Spec.Organization,
+		Namespace:    registryModule.Namespace,
+		Name:         registryModule.Name,
+		Provider:     registryModule.Provider,
+		RegistryName: tfc.PrivateRegistry,
+	}
+
+	publishedNew := false
+	for _, v := range m.instance.Spec.Versions {
+		if _, ok := published[v.Version]; ok {
+			continue
+		}
+
+		m.log.Info("Reconcile Registry Module Versions", "msg", fmt.Sprintf("creating a new version %s", v.Version))
+		rmv, err := m.tfClient.Client.RegistryModules.CreateVersion(ctx, moduleID, tfc.RegistryModuleCreateVersionOptions{
+			Version: tfc.String(v.Version),
+		})
+		if err != nil {
+			m.log.Error(err, "Reconcile Registry Module Versions", "msg", fmt.Sprintf("failed to create a new version %s", v.Version))
+			r.Recorder.Eventf(&m.instance, corev1.EventTypeWarning, "ReconcileRegistryModuleVersions", "Failed to create a new version %s", v.Version)
+			return publishedNew, err
+		}
+
+		if err := r.uploadVersion(ctx, m, rmv, v.Source); err != nil {
+			m.log.Error(err, "Reconcile Registry Module Versions", "msg", fmt.Sprintf("failed to upload version %s", v.Version))
+			r.Recorder.Eventf(&m.instance, corev1.EventTypeWarning, "ReconcileRegistryModuleVersions", "Failed to upload version %s", v.Version)
+			// A version without uploaded content stays pending forever, remove it to retry during the next reconciliation.
+			if derr := m.tfClient.Client.RegistryModules.DeleteVersion(ctx, moduleID, v.Version); derr != nil && derr != tfc.ErrResourceNotFound {
+				m.log.Error(derr, "Reconcile Registry Module Versions", "msg", fmt.Sprintf("failed to delete pending version %s", v.Version))
+			}
+			return publishedNew, err
+		}
+		publishedNew = true
+		m.log.Info("Reconcile Registry Module Versions", "msg", fmt.Sprintf("successfully uploaded version %s", v.Version))
+		r.Recorder.Eventf(&m.instance, corev1.EventTypeNormal, "ReconcileRegistryModuleVersions", "Successfully uploaded version %s", v.Version)
+	}
+
+	return publishedNew, nil
+}
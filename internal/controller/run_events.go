@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// runEventReason is the reason of the Kubernetes Events recorded on run status transitions.
+	runEventReason = "RunStatusChanged"
+	// runCloudEventType is the type of the CloudEvents sent on run status transitions.
+	runCloudEventType = "io.terraform.app.run.status.changed"
+	// runEventAnnotationPrefix is the prefix of the Kubernetes Event annotations that describe the run.
+	runEventAnnotationPrefix = "run.app.terraform.io/"
+	// runEventsSinkTimeout is the timeout of sending a CloudEvent to the sink.
+	runEventsSinkTimeout = 5 * time.Second
+)
+
+// RunEventsSinkURL is the URL of the HTTP sink to which CloudEvents of run status transitions are sent.
+// CloudEvents are not sent if it is empty.
+var RunEventsSinkURL string
+
+// runEvent describes a transition of an HCP Terraform run status.
+type runEvent struct {
+	Kind           string `json:"kind"`
+	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`
+	Organization   string `json:"organization,omitempty"`
+	WorkspaceID    string `json:"workspaceID,omitempty"`
+	WorkspaceName  string `json:"workspaceName,omitempty"`
+	RunID          string `json:"runID"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previousStatus,omitempty"`
+	Message        string `json:"message,omitempty"`
+	Source         string `json:"source,omitempty"`
+	TriggeredBy    string `json:"triggeredBy,omitempty"`
+	PlanOnly       bool   `json:"planOnly"`
+	IsDestroy      bool   `json:"isDestroy"`
+}
+
+// runEventWorkspace identifies the workspace of the run in a run event.
+type runEventWorkspace struct {
+	organization string
+	id           string
+	name         string
+}
+
+// newRunEvent returns a run event if the run status differs from the previous one, and nil otherwise.
+func newRunEvent(kind string, o client.Object, ws runEventWorkspace, run *tfc.Run, previous string) *runEvent {
+	if run == nil || string(run.Status) == previous {
+		return nil
+	}
+
+	e := &runEvent{
+		Kind:           kind,
+		Namespace:      o.GetNamespace(),
+		Name:           o.GetName(),
+		Organization:   ws.organization,
+		WorkspaceID:    ws.id,
+		WorkspaceName:  ws.name,
+		RunID:          run.ID,
+		Status:         string(run.Status),
+		PreviousStatus: previous,
+		Message:        run.Message,
+		Source:         string(run.Source),
+		PlanOnly:       run.PlanOnly,
+		IsDestroy:      run.IsDestroy,
+	}
+	if run.CreatedBy != nil {
+		e.TriggeredBy = run.CreatedBy.Username
+	}
+	if e.WorkspaceID == "" && run.Workspace != nil {
+		e.WorkspaceID = run.Workspace.ID
+	}
+
+	return e
+}
+
+// eventType returns the Kubernetes Event type of the run event.
+func (e *runEvent) eventType() string {
+	switch tfc.RunStatus(e.Status) {
+	case tfc.RunErrored, tfc.RunCanceled, tfc.RunPolicySoftFailed:
+		return corev1.EventTypeWarning
+	}
+	return corev1.EventTypeNormal
+}
+
+// annotations returns the Kubernetes Event annotations that describe the run.
+func (e *runEvent) annotations() map[string]string {
+	a := map[string]string{
+		runEventAnnotationPrefix + "id":     e.RunID,
+		runEventAnnotationPrefix + "status": e.Status,
+	}
+	for k, v := range map[string]string{
+		"previous-status": e.PreviousStatus,
+		"workspace-id":    e.WorkspaceID,
+		"workspace-name":  e.WorkspaceName,
+		"triggered-by":    e.TriggeredBy,
+		"source":          e.Source,
+	} {
+		if v != "" {
+			a[runEventAnnotationPrefix+k] = v
+		}
+	}
+	return a
+}
+
+// message returns the human-readable message of the run event.
+func (e *runEvent) message() string {
+	var b strings.Builder
+	if e.PreviousStatus == "" {
+		fmt.Fprintf(&b, "Run %s is %s", e.RunID, e.Status)
+	} else {
+		fmt.Fprintf(&b, "Run %s changed status from %s to %s", e.RunID, e.PreviousStatus, e.Status)
+	}
+	if e.WorkspaceName != "" {
+		fmt.Fprintf(&b, " in workspace %s", e.WorkspaceName)
+	}
+	if e.TriggeredBy != "" {
+		fmt.Fprintf(&b, ", triggered by %s", e.TriggeredBy)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %q", e.Message)
+	}
+	return b.String()
+}
+
+// cloudEvent returns the run event as a CloudEvent in the structured content mode.
+// More information:
+//   - https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/formats/json-format.md
+func (e *runEvent) cloudEvent(now time.Time) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"specversion":     "1.0",
+		"id":              fmt.Sprintf("%s-%s", e.RunID, e.Status),
+		"source":          fmt.Sprintf("/apis/app.terraform.io/v1alpha2/namespaces/%s/%ss/%s", e.Namespace, strings.ToLower(e.Kind), e.Name),
+		"type":            runCloudEventType,
+		"subject":         e.RunID,
+		"time":            now.UTC().Format(time.RFC3339),
+		"datacontenttype": "application/json",
+		"data":            e,
+	})
+}
+
+// sendCloudEvent sends the run event as a CloudEvent to the HTTP sink.
+func sendCloudEvent(ctx context.Context, url string, e *runEvent) error {
+	body, err := e.cloudEvent(time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, runEventsSinkTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json; charset=utf-8")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("run events sink responded with status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// publishRunEvents records the run events as Kubernetes Events on the object, and sends them as CloudEvents if the sink is configured.
+// It must be called once the run statuses are stored in the object status, otherwise a failed status update publishes the same transition again.
+// CloudEvents are sent asynchronously and in order, failures are logged and do not interrupt the reconciliation.
+func publishRunEvents(recorder record.EventRecorder, l logr.Logger, o client.Object, events ...*runEvent) {
+	var sink []*runEvent
+	for _, e := range events {
+		if e == nil {
+			continue
+		}
+		recorder.AnnotatedEventf(o, e.annotations(), e.eventType(), runEventReason, "%s", e.message())
+		sink = append(sink, e)
+	}
+
+	if RunEventsSinkURL == "" || len(sink) == 0 {
+		return
+	}
+	go func(url string) {
+		for _, e := range sink {
+			if err := sendCloudEvent(context.Background(), url, e); err != nil {
+				l.Error(err, "Run Events", "msg", fmt.Sprintf("failed to send event of run %s to the sink", e.RunID))
+			}
+		}
+	}(RunEventsSinkURL)
+}
+
+// readRun reads the run including the user who created it and the cost estimate.
+func readRun(ctx context.Context, c *tfc.Client, id string) (*tfc.Run, error) {
+	return c.Runs.ReadWithOptions(ctx, id, &tfc.RunReadOptions{
+		Include: []tfc.RunIncludeOpt{tfc.RunCreatedBy, tfc.RunCostEstimate},
+	})
+}
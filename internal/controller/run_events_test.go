@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func newTestRunEvent(status tfc.RunStatus, previous string) *runEvent {
+	o := &appv1alpha2.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "this",
+		},
+	}
+	run := &tfc.Run{
+		ID:        "run-1",
+		Status:    status,
+		Message:   "Triggered via UI",
+		Source:    tfc.RunSourceUI,
+		CreatedBy: &tfc.User{Username: "alice"},
+	}
+	return newRunEvent("Workspace", o, runEventWorkspace{organization: "org", id: "ws-1", name: "this"}, run, previous)
+}
+
+func TestNewRunEvent(t *testing.T) {
+	assert.Nil(t, newTestRunEvent(tfc.RunPlanning, "planning"))
+
+	e := newTestRunEvent(tfc.RunPlanned, "planning")
+	assert.Equal(t, &runEvent{
+		Kind:           "Workspace",
+		Namespace:      "default",
+		Name:           "this",
+		Organization:   "org",
+		WorkspaceID:    "ws-1",
+		WorkspaceName:  "this",
+		RunID:          "run-1",
+		Status:         "planned",
+		PreviousStatus: "planning",
+		Message:        "Triggered via UI",
+		Source:         "tfe-ui",
+		TriggeredBy:    "alice",
+	}, e)
+	assert.Equal(t, corev1.EventTypeNormal, e.eventType())
+	assert.Equal(t, `Run run-1 changed status from planning to planned in workspace this, triggered by alice: "Triggered via UI"`, e.message())
+	assert.Equal(t, "alice", e.annotations()["run.app.terraform.io/triggered-by"])
+
+	e = newTestRunEvent(tfc.RunErrored, "")
+	assert.Equal(t, corev1.EventTypeWarning, e.eventType())
+	assert.Equal(t, `Run run-1 is errored in workspace this, triggered by alice: "Triggered via UI"`, e.message())
+	assert.NotContains(t, e.annotations(), "run.app.terraform.io/previous-status")
+}
+
+func TestSendCloudEvent(t *testing.T) {
+	var ce map[string]any
+	var contentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &ce)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	e := newTestRunEvent(tfc.RunApplied, "applying")
+	assert.NoError(t, sendCloudEvent(context.Background(), srv.URL, e))
+	assert.Equal(t, "application/cloudevents+json; charset=utf-8", contentType)
+	assert.Equal(t, "1.0", ce["specversion"])
+	assert.Equal(t, "run-1-applied", ce["id"])
+	assert.Equal(t, runCloudEventType, ce["type"])
+	assert.Equal(t, "/apis/app.terraform.io/v1alpha2/namespaces/default/workspaces/this", ce["source"])
+	assert.Equal(t, "run-1", ce["subject"])
+	_, err := time.Parse(time.RFC3339, ce["time"].(string))
+	assert.NoError(t, err)
+	data := ce["data"].(map[string]any)
+	assert.Equal(t, "applied", data["status"])
+	assert.Equal(t, "applying", data["previousStatus"])
+	assert.Equal(t, "alice", data["triggeredBy"])
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	assert.Error(t, sendCloudEvent(context.Background(), failing.URL, e))
+}
+
+func TestPublishRunEvents(t *testing.T) {
+	ids := make(chan string, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ce map[string]any
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &ce)
+		ids <- ce["id"].(string)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	defer func(url string) { RunEventsSinkURL = url }(RunEventsSinkURL)
+	RunEventsSinkURL = srv.URL
+
+	recorder := record.NewFakeRecorder(3)
+	o := &appv1alpha2.Workspace{}
+	applying := newTestRunEvent(tfc.RunApplying, "confirmed")
+	// The message is not a format string.
+	applying.WorkspaceName = "100%s"
+	publishRunEvents(recorder, logr.Discard(), o, applying, nil, newTestRunEvent(tfc.RunApplied, "applying"))
+
+	assert.Len(t, recorder.Events, 2)
+	assert.Contains(t, <-recorder.Events, applying.message())
+	for _, id := range []string{"run-1-applying", "run-1-applied"} {
+		select {
+		case got := <-ids:
+			assert.Equal(t, id, got)
+		case <-time.After(runEventsSinkTimeout):
+			t.Fatalf("CloudEvent %s was not sent", id)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// defaultRunHistoryLimit is the number of runs retained in the run history when the limit is not set.
+const defaultRunHistoryLimit = 10
+
+// runHistoryLimit returns the number of runs to retain in the run history.
+func runHistoryLimit(limit *int32) int {
+	if limit == nil {
+		return defaultRunHistoryLimit
+	}
+	return int(*limit)
+}
+
+// listRunHistory returns the run history of the workspace with the given number of the most recent runs.
+// It returns nil if the limit is 0.
+func listRunHistory(ctx context.Context, c *tfc.Client, workspaceID string, limit int, previous *appv1alpha2.RunHistoryStatus) (*appv1alpha2.RunHistoryStatus, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	runs, err := c.Runs.List(ctx, workspaceID, &tfc.RunListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize: limit,
+		},
+		Include: []tfc.RunIncludeOpt{tfc.RunPlan, tfc.RunCreatedBy},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newRunHistory(runs.Items, limit, previous, metav1.Now()), nil
+}
+
+// newRunHistory converts the runs, ordered from the newest to the oldest, to the run history.
+// The update timestamp of the previous history is kept if the runs have not changed, so that the status is not updated on every reconciliation.
+func newRunHistory(runs []*tfc.Run, limit int, previous *appv1alpha2.RunHistoryStatus, now metav1.Time) *appv1alpha2.RunHistoryStatus {
+	if len(runs) > limit {
+		runs = runs[:limit]
+	}
+
+	history := &appv1alpha2.RunHistoryStatus{
+		Runs:      make([]appv1alpha2.RunHistoryEntry, 0, len(runs)),
+		UpdatedAt: &now,
+	}
+	for _, run := range runs {
+		history.Runs = append(history.Runs, runHistoryEntry(run))
+		if run.Status == tfc.RunErrored {
+			history.Errored++
+		}
+	}
+	if previous != nil && previous.UpdatedAt != nil && equality.Semantic.DeepEqual(previous.Runs, history.Runs) {
+		history.UpdatedAt = previous.UpdatedAt
+	}
+
+	return history
+}
+
+// runHistoryEntry converts the run to a run history entry.
+func runHistoryEntry(run *tfc.Run) appv1alpha2.RunHistoryEntry {
+	e := appv1alpha2.RunHistoryEntry{
+		ID:     run.ID,
+		Type:   runType(run),
+		Source: string(run.Source),
+		Status: string(run.Status),
+	}
+	if !run.CreatedAt.IsZero() {
+		e.CreatedAt = runHistoryTime(run.CreatedAt)
+	}
+	if run.StatusTimestamps != nil {
+		if t := runCompletedAt(run.StatusTimestamps); !t.IsZero() {
+			e.CompletedAt = runHistoryTime(t)
+		}
+	}
+	if run.CreatedBy != nil {
+		e.TriggeredBy = run.CreatedBy.Username
+	}
+	if run.Plan != nil {
+		e.Additions = run.Plan.ResourceAdditions
+		e.Changes = run.Plan.ResourceChanges
+		e.Destructions = run.Plan.ResourceDestructions
+	}
+
+	return e
+}
+
+// runHistoryTime truncates the timestamp to seconds, the precision it is stored with in the status, so that unchanged runs compare equal to the stored ones.
+func runHistoryTime(t time.Time) *metav1.Time {
+	return &metav1.Time{Time: t.Truncate(time.Second)}
+}
+
+// runType returns the type of the run: `plan`, `apply`, `refresh`, or `destroy`.
+func runType(run *tfc.Run) string {
+	switch {
+	case run.IsDestroy:
+		return "destroy"
+	case run.RefreshOnly:
+		return RunTypeRefresh
+	case run.PlanOnly:
+		return RunTypePlan
+	default:
+		return RunTypeApply
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+func TestRunHistoryLimit(t *testing.T) {
+	assert.Equal(t, defaultRunHistoryLimit, runHistoryLimit(nil))
+	assert.Equal(t, 0, runHistoryLimit(pointer.PointerOf(int32(0))))
+	assert.Equal(t, 25, runHistoryLimit(pointer.PointerOf(int32(25))))
+}
+
+func TestRunType(t *testing.T) {
+	cases := map[string]struct {
+		run      *tfc.Run
+		expected string
+	}{
+		"Apply":   {run: &tfc.Run{}, expected: "apply"},
+		"Plan":    {run: &tfc.Run{PlanOnly: true}, expected: "plan"},
+		"Refresh": {run: &tfc.Run{RefreshOnly: true}, expected: "refresh"},
+		"Destroy": {run: &tfc.Run{IsDestroy: true}, expected: "destroy"},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.expected, runType(c.run))
+		})
+	}
+}
+
+func TestNewRunHistory(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	appliedAt := createdAt.Add(5 * time.Minute)
+	now := metav1.NewTime(createdAt.Add(time.Hour))
+	runs := []*tfc.Run{
+		{
+			ID:               "run-3",
+			Status:           tfc.RunPlanning,
+			Source:           tfc.RunSourceUI,
+			CreatedAt:        createdAt.Add(2 * time.Minute),
+			StatusTimestamps: &tfc.RunStatusTimestamps{},
+		},
+		{
+			ID:               "run-2",
+			Status:           tfc.RunApplied,
+			Source:           tfc.RunSourceAPI,
+			CreatedAt:        createdAt,
+			StatusTimestamps: &tfc.RunStatusTimestamps{AppliedAt: appliedAt},
+			CreatedBy:        &tfc.User{Username: "alice"},
+			Plan: &tfc.Plan{
+				ResourceAdditions:    2,
+				ResourceChanges:      1,
+				ResourceDestructions: 3,
+			},
+		},
+		{
+			ID:     "run-1",
+			Status: tfc.RunErrored,
+		},
+	}
+
+	history := newRunHistory(runs, 2, nil, now)
+	assert.Equal(t, &appv1alpha2.RunHistoryStatus{
+		Runs: []appv1alpha2.RunHistoryEntry{
+			{
+				ID:        "run-3",
+				Type:      "apply",
+				Source:    "tfe-ui",
+				Status:    "planning",
+				CreatedAt: &metav1.Time{Time: createdAt.Add(2 * time.Minute)},
+			},
+			{
+				ID:           "run-2",
+				Type:         "apply",
+				Source:       "tfe-api",
+				Status:       "applied",
+				CreatedAt:    &metav1.Time{Time: createdAt},
+				CompletedAt:  &metav1.Time{Time: appliedAt},
+				TriggeredBy:  "alice",
+				Additions:    2,
+				Changes:      1,
+				Destructions: 3,
+			},
+		},
+		Errored:   0,
+		UpdatedAt: &now,
+	}, history)
+
+	history = newRunHistory(runs, 10, nil, now)
+	assert.Len(t, history.Runs, 3)
+	assert.Equal(t, int32(1), history.Errored)
+}
+
+func TestNewRunHistoryUpdatedAt(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 250*int(time.Millisecond), time.UTC)
+	updatedAt := metav1.NewTime(createdAt.Add(time.Hour).Truncate(time.Second))
+	now := metav1.NewTime(createdAt.Add(2 * time.Hour))
+	runs := []*tfc.Run{
+		{
+			ID:        "run-1",
+			Status:    tfc.RunPlanning,
+			CreatedAt: createdAt,
+		},
+	}
+
+	// Simulate the history stored in the status, whose timestamps have a precision of seconds.
+	data, err := json.Marshal(newRunHistory(runs, 10, nil, updatedAt))
+	require.NoError(t, err)
+	previous := &appv1alpha2.RunHistoryStatus{}
+	require.NoError(t, json.Unmarshal(data, previous))
+
+	history := newRunHistory(runs, 10, previous, now)
+	assert.True(t, updatedAt.Equal(history.UpdatedAt), "UpdatedAt must be kept when the runs have not changed")
+
+	runs[0].Status = tfc.RunPlanned
+	history = newRunHistory(runs, 10, previous, now)
+	assert.Equal(t, &now, history.UpdatedAt)
+}
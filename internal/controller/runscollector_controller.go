@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/go-logr/logr"
 	tfc "github.com/hashicorp/go-tfe"
@@ -78,6 +79,7 @@ type runsCollectorInstance struct {
 //+kubebuilder:rbac:groups=app.terraform.io,resources=runscollectors,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=app.terraform.io,resources=runscollectors/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=app.terraform.io,resources=runscollectors/finalizers,verbs=update
+//+kubebuilder:rbac:groups=app.terraform.io,resources=agentpools,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *RunsCollectorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -107,8 +109,10 @@ func (r *RunsCollectorReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	if a, ok := rc.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
 		rc.log.Info("Runs Collector Controller", "msg", "reconciliation is paused for this resource")
+		setRunsCollectorPaused(&rc.instance, true)
 		return doNotRequeue()
 	}
+	setRunsCollectorPaused(&rc.instance, false)
 
 	rc.log.Info("Spec Validation", "msg", "validating instance object spec")
 	if err := rc.instance.ValidateSpec(); err != nil {
@@ -133,6 +137,7 @@ func (r *RunsCollectorReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	if err != nil {
 		rc.log.Error(err, "Runs Collector Controller", "msg", "failed to get HCP Terraform client")
 		r.Recorder.Event(&rc.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		runsCollectorScrapeFailed(&rc.instance)
 		return requeueAfter(requeueInterval)
 	}
 
@@ -207,9 +212,19 @@ func (r *RunsCollectorReconciler) removeFinalizer(ctx context.Context, rc *runsC
 	if err != nil {
 		rc.log.Error(err, "Reconcile Runs Collector", "msg", fmt.Sprintf("failed to remove finalizer %s", runsCollectorFinalizer))
 		r.Recorder.Eventf(&rc.instance, corev1.EventTypeWarning, "RemoveRunsCollector", "Failed to remove finalizer %s", runsCollectorFinalizer)
+		return err
 	}
 
-	return err
+	for _, p := range rc.metricAgentPools() {
+		if p.ID == "" {
+			deleteRunsMetrics(rc.instance.Spec.Organization, nil)
+			continue
+		}
+		deleteRunsMetrics(rc.instance.Spec.Organization, &p)
+	}
+	deleteRunsCollectorHealthMetrics(&rc.instance)
+
+	return nil
 }
 
 func (r *RunsCollectorReconciler) getAgentPoolIDByName(ctx context.Context, rc *runsCollectorInstance) (*tfc.AgentPool, error) {
@@ -266,68 +281,113 @@ func (r *RunsCollectorReconciler) updateStatusAgentPool(ctx context.Context, rc
 }
 
 func (r *RunsCollectorReconciler) reconcileRuns(ctx context.Context, rc *runsCollectorInstance) error {
-	// TODO:
-	// - Think if we need to reset all metrics to 0 when remove finalizer.
 	if isDeletionCandidate(&rc.instance, runsCollectorFinalizer) {
 		rc.log.Info("Reconcile Runs Collector", "msg", "object marked as deleted, need to remove finalizer")
 		r.Recorder.Event(&rc.instance, corev1.EventTypeNormal, "ReconcileRunsCollector", "Object marked as deleted, need to remove finalizer")
 		return r.removeFinalizer(ctx, rc)
 	}
 
-	runs := map[tfc.RunStatus]float64{}
-	var runsTotal float64
-	// TODO:
-	// - If a new name or ID is set in the spec, we need to zero related metrics.
-	if rc.instance.NeedUpdateStatus() {
-		r.updateStatusAgentPool(ctx, rc)
+	start := time.Now()
+	err := r.collectRuns(ctx, rc)
+	observeRunsCollectorScrape(&rc.instance, time.Since(start), err)
+	if err != nil {
+		return err
 	}
 
-	listOpts := &tfc.RunListForOrganizationOptions{
-		AgentPoolNames: rc.instance.Status.AgentPool.Name,
-		StatusGroup:    "non_final",
-		ListOptions: tfc.ListOptions{
-			PageSize:   MaxPageSize,
-			PageNumber: InitPageNumber,
-		},
+	rc.instance.Status.ObservedGeneration = rc.instance.Generation
+
+	return r.Status().Update(ctx, &rc.instance)
+}
+
+// collectRuns collects runs from the agent pool, or from the entire organization, and exports them as metrics.
+func (r *RunsCollectorReconciler) collectRuns(ctx context.Context, rc *runsCollectorInstance) error {
+	org := rc.instance.Spec.Organization
+	oldPools := rc.pools()
+	if err := r.updateStatusAgentPools(ctx, rc); err != nil {
+		return err
+	}
+	// Remove the series of the agent pools, or the organization, from which runs are no longer collected.
+	if rc.instance.Status.ObservedGeneration > 0 {
+		if len(oldPools) == 0 && rc.instance.Spec.AgentPool != nil {
+			deleteRunsMetrics(org, nil)
+		}
+		for _, p := range staleAgentPoolRefs(oldPools, rc.pools()) {
+			rc.log.Info("Reconcile Runs Collector", "msg", fmt.Sprintf("no longer collecting runs from agent pool %q, removing stale metrics", p.ID))
+			deleteRunsMetrics(org, &p)
+		}
 	}
 
-	for {
-		runsList, err := rc.tfClient.Client.Runs.ListForOrganization(ctx, rc.instance.Spec.Organization, listOpts)
-		// TODO:
-		// - Think if we need to reset all metrics to 0 in case of error.
-		if err != nil {
-			return err
+	var pending []*tfc.Run
+	if !rc.skipRuns() {
+		listOpts := rc.listRunsOptions("non_final")
+		for {
+			runsList, err := rc.tfClient.Client.Runs.ListForOrganization(ctx, org, listOpts)
+			if err != nil {
+				return err
+			}
+			pending = append(pending, runsList.Items...)
+			if runsList.NextPage == 0 {
+				break
+			}
+			listOpts.PageNumber = runsList.NextPage
 		}
-		runsTotal += float64(len(runsList.Items))
-		for _, run := range runsList.Items {
+	}
+
+	rc.log.Info("Reconcile Runs Collector", "msg", fmt.Sprintf("Total Runs: %d", len(pending)))
+	if rc.organizationWide() {
+		runs := map[tfc.RunStatus]float64{}
+		for _, run := range pending {
 			runs[run.Status]++
 		}
-		if runsList.NextPage == 0 {
-			break
+		for _, status := range runStatuses {
+			MetricOrganizationRuns.WithLabelValues(
+				org,            // organization
+				string(status), // run_status
+			).Set(runs[status])
 		}
-		listOpts.PageNumber = runsList.NextPage
-	}
 
-	for _, status := range runStatuses {
-		MetricRuns.WithLabelValues(
-			string(status),                    // run_status
-			rc.instance.Status.AgentPool.ID,   // agent_pool_id
-			rc.instance.Status.AgentPool.Name, // agent_pool_name
-		).Set(float64(runs[status]))
+		MetricOrganizationRunsTotal.WithLabelValues(
+			org, // organization
+		).Set(float64(len(pending)))
+	} else {
+		runs := map[string]map[tfc.RunStatus]float64{}
+		runsTotal := map[string]float64{}
+		for _, run := range pending {
+			id := rc.runAgentPoolID(run)
+			if runs[id] == nil {
+				runs[id] = map[tfc.RunStatus]float64{}
+			}
+			runs[id][run.Status]++
+			runsTotal[id]++
+		}
+		for _, p := range rc.pools() {
+			for _, status := range runStatuses {
+				MetricRuns.WithLabelValues(
+					string(status), // run_status
+					p.ID,           // agent_pool_id
+					p.Name,         // agent_pool_name
+				).Set(runs[p.ID][status])
+			}
+
+			MetricRunsTotal.WithLabelValues(
+				p.ID,   // agent_pool_id
+				p.Name, // agent_pool_name
+			).Set(runsTotal[p.ID])
+		}
 	}
 
-	rc.log.Info("Reconcile Runs Collector", "msg", fmt.Sprintf("Total Runs: %.0f", runsTotal))
-	MetricRunsTotal.WithLabelValues(
-		rc.instance.Status.AgentPool.ID,   // agent_pool_id
-		rc.instance.Status.AgentPool.Name, // agent_pool_name
-	).Set(runsTotal)
+	if err := r.setRunsBreakdownMetrics(ctx, rc, pending); err != nil {
+		return err
+	}
 
-	rc.instance.Status.ObservedGeneration = rc.instance.Generation
+	if rc.instance.Spec.Histograms {
+		if err := r.observeRunDurations(ctx, rc, pending); err != nil {
+			return err
+		}
+	} else {
+		rc.instance.Status.RunsObservedUntil = nil
+		rc.instance.Status.PendingRunIDs = nil
+	}
 
-	return r.Status().Update(ctx, &rc.instance)
+	return nil
 }
-
-// TODO:
-// - Since we have one CR per Agent Pool, we can implement an aggregation logic
-//   to to perform one API call to collect runs for all Agent Pools instead of one
-//   call per CR.
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/prometheus/client_golang/prometheus"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// runsBreakdownKey is a set of label values of the runs breakdown metric.
+type runsBreakdownKey struct {
+	status        tfc.RunStatus
+	workspaceID   string
+	workspaceName string
+	projectID     string
+	projectName   string
+}
+
+// breakdownRuns counts runs by status and by the dimensions configured in the spec.
+// Project names are looked up in the given map by project ID.
+func breakdownRuns(instance *appv1alpha2.RunsCollector, runs []*tfc.Run, projects map[string]string) map[runsBreakdownKey]float64 {
+	byWorkspace := instance.HasBreakdown(appv1alpha2.RunsCollectorBreakdownWorkspace)
+	byProject := instance.HasBreakdown(appv1alpha2.RunsCollectorBreakdownProject)
+
+	m := make(map[runsBreakdownKey]float64)
+	for _, run := range runs {
+		k := runsBreakdownKey{status: run.Status}
+		if ws := run.Workspace; ws != nil {
+			if byWorkspace {
+				k.workspaceID = ws.ID
+				k.workspaceName = ws.Name
+			}
+			if byProject && ws.Project != nil {
+				k.projectID = ws.Project.ID
+				k.projectName = projects[ws.Project.ID]
+			}
+		}
+		m[k]++
+	}
+
+	return m
+}
+
+// listProjectNames returns the names of the organization projects by ID.
+func (r *RunsCollectorReconciler) listProjectNames(ctx context.Context, rc *runsCollectorInstance) (map[string]string, error) {
+	projects := make(map[string]string)
+	listOpts := &tfc.ProjectListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize:   MaxPageSize,
+			PageNumber: InitPageNumber,
+		},
+	}
+	for {
+		pl, err := rc.tfClient.Client.Projects.List(ctx, rc.instance.Spec.Organization, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pl.Items {
+			projects[p.ID] = p.Name
+		}
+		if pl.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = pl.NextPage
+	}
+
+	return projects, nil
+}
+
+// setRunsBreakdownMetrics exports pending runs broken down by the dimensions configured in the spec, per agent pool.
+// Series of workspaces and projects that no longer have pending runs are removed.
+func (r *RunsCollectorReconciler) setRunsBreakdownMetrics(ctx context.Context, rc *runsCollectorInstance, runs []*tfc.Run) error {
+	pools := rc.metricAgentPools()
+	for _, p := range pools {
+		MetricRunsBreakdown.DeletePartialMatch(prometheus.Labels{
+			"organization":  rc.instance.Spec.Organization,
+			"agent_pool_id": p.ID,
+		})
+	}
+
+	if len(rc.instance.Spec.Breakdown) == 0 {
+		return nil
+	}
+
+	var projects map[string]string
+	if rc.instance.HasBreakdown(appv1alpha2.RunsCollectorBreakdownProject) {
+		var err error
+		projects, err = r.listProjectNames(ctx, rc)
+		if err != nil {
+			rc.log.Error(err, "Reconcile Runs Collector", "msg", "failed to list projects")
+			return err
+		}
+	}
+
+	byPool := make(map[string][]*tfc.Run)
+	for _, run := range runs {
+		id := rc.runAgentPoolID(run)
+		byPool[id] = append(byPool[id], run)
+	}
+
+	for _, p := range pools {
+		for k, v := range breakdownRuns(&rc.instance, byPool[p.ID], projects) {
+			MetricRunsBreakdown.WithLabelValues(
+				rc.instance.Spec.Organization, // organization
+				p.ID,                          // agent_pool_id
+				p.Name,                        // agent_pool_name
+				string(k.status),              // run_status
+				k.workspaceID,                 // workspace_id
+				k.workspaceName,               // workspace_name
+				k.projectID,                   // project_id
+				k.projectName,                 // project_name
+			).Set(v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestBreakdownRuns(t *testing.T) {
+	runs := []*tfc.Run{
+		{Status: tfc.RunPending, Workspace: &tfc.Workspace{ID: "ws-1", Name: "one", Project: &tfc.Project{ID: "prj-1"}}},
+		{Status: tfc.RunPending, Workspace: &tfc.Workspace{ID: "ws-1", Name: "one", Project: &tfc.Project{ID: "prj-1"}}},
+		{Status: tfc.RunPlanning, Workspace: &tfc.Workspace{ID: "ws-2", Name: "two", Project: &tfc.Project{ID: "prj-1"}}},
+		{Status: tfc.RunPending, Workspace: &tfc.Workspace{ID: "ws-3", Name: "three", Project: &tfc.Project{ID: "prj-2"}}},
+	}
+	projects := map[string]string{"prj-1": "alpha", "prj-2": "beta"}
+
+	newInstance := func(b ...appv1alpha2.RunsCollectorBreakdown) *appv1alpha2.RunsCollector {
+		return &appv1alpha2.RunsCollector{
+			Spec: appv1alpha2.RunsCollectorSpec{Breakdown: b},
+		}
+	}
+
+	t.Run("Workspace", func(t *testing.T) {
+		assert.Equal(t, map[runsBreakdownKey]float64{
+			{status: tfc.RunPending, workspaceID: "ws-1", workspaceName: "one"}:   2,
+			{status: tfc.RunPlanning, workspaceID: "ws-2", workspaceName: "two"}:  1,
+			{status: tfc.RunPending, workspaceID: "ws-3", workspaceName: "three"}: 1,
+		}, breakdownRuns(newInstance(appv1alpha2.RunsCollectorBreakdownWorkspace), runs, nil))
+	})
+
+	t.Run("Project", func(t *testing.T) {
+		assert.Equal(t, map[runsBreakdownKey]float64{
+			{status: tfc.RunPending, projectID: "prj-1", projectName: "alpha"}:  2,
+			{status: tfc.RunPlanning, projectID: "prj-1", projectName: "alpha"}: 1,
+			{status: tfc.RunPending, projectID: "prj-2", projectName: "beta"}:   1,
+		}, breakdownRuns(newInstance(appv1alpha2.RunsCollectorBreakdownProject), runs, projects))
+	})
+
+	t.Run("WorkspaceAndProject", func(t *testing.T) {
+		m := breakdownRuns(newInstance(appv1alpha2.RunsCollectorBreakdownWorkspace, appv1alpha2.RunsCollectorBreakdownProject), runs, projects)
+		assert.Len(t, m, 3)
+		assert.Equal(t, float64(2), m[runsBreakdownKey{status: tfc.RunPending, workspaceID: "ws-1", workspaceName: "one", projectID: "prj-1", projectName: "alpha"}])
+	})
+}
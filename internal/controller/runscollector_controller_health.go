@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// runsCollectorLabels returns the labels that identify the RunsCollector in the health metrics.
+func runsCollectorLabels(instance *appv1alpha2.RunsCollector) prometheus.Labels {
+	return prometheus.Labels{
+		"namespace": instance.Namespace,
+		"name":      instance.Name,
+	}
+}
+
+// setRunsCollectorPaused reports whether the reconciliation of the RunsCollector is paused.
+// A paused collector is reported as down, since its metrics are no longer updated.
+func setRunsCollectorPaused(instance *appv1alpha2.RunsCollector, paused bool) {
+	labels := runsCollectorLabels(instance)
+	if paused {
+		MetricRunsCollectorPaused.With(labels).Set(1)
+		MetricRunsCollectorUp.With(labels).Set(0)
+		return
+	}
+	MetricRunsCollectorPaused.With(labels).Set(0)
+}
+
+// runsCollectorScrapeFailed reports that the RunsCollector failed to collect runs.
+func runsCollectorScrapeFailed(instance *appv1alpha2.RunsCollector) {
+	labels := runsCollectorLabels(instance)
+	MetricRunsCollectorUp.With(labels).Set(0)
+	MetricRunsCollectorScrapeErrors.With(labels).Inc()
+}
+
+// observeRunsCollectorScrape reports the duration and the result of collecting runs.
+func observeRunsCollectorScrape(instance *appv1alpha2.RunsCollector, d time.Duration, err error) {
+	labels := runsCollectorLabels(instance)
+	MetricRunsCollectorScrapeDuration.With(labels).Observe(d.Seconds())
+	if err != nil {
+		runsCollectorScrapeFailed(instance)
+		return
+	}
+	MetricRunsCollectorUp.With(labels).Set(1)
+	MetricRunsCollectorLastSuccess.With(labels).Set(float64(time.Now().Unix()))
+}
+
+// deleteRunsCollectorHealthMetrics removes the health metrics of the RunsCollector.
+func deleteRunsCollectorHealthMetrics(instance *appv1alpha2.RunsCollector) {
+	labels := runsCollectorLabels(instance)
+	MetricRunsCollectorUp.Delete(labels)
+	MetricRunsCollectorPaused.Delete(labels)
+	MetricRunsCollectorLastSuccess.Delete(labels)
+	MetricRunsCollectorScrapeDuration.Delete(labels)
+	MetricRunsCollectorScrapeErrors.Delete(labels)
+}
+
+// deleteRunsMetrics removes the series of runs collected from the agent pool, or from the entire organization if the agent pool is nil.
+func deleteRunsMetrics(organization string, pool *appv1alpha2.AgentPoolRef) {
+	if pool != nil {
+		MetricRuns.DeletePartialMatch(prometheus.Labels{"agent_pool_id": pool.ID})
+		MetricRunsTotal.DeletePartialMatch(prometheus.Labels{"agent_pool_id": pool.ID})
+	} else {
+		MetricOrganizationRuns.DeletePartialMatch(prometheus.Labels{"organization": organization})
+		MetricOrganizationRunsTotal.DeletePartialMatch(prometheus.Labels{"organization": organization})
+	}
+
+	labels := prometheus.Labels{
+		"organization":  organization,
+		"agent_pool_id": agentPoolRefID(pool),
+	}
+	MetricRunsBreakdown.DeletePartialMatch(labels)
+	MetricRunQueueDuration.DeletePartialMatch(labels)
+	MetricRunPhaseDuration.DeletePartialMatch(labels)
+}
+
+// agentPoolRefID returns the ID of the agent pool reference, or an empty string if it is nil.
+func agentPoolRefID(pool *appv1alpha2.AgentPoolRef) string {
+	if pool == nil {
+		return ""
+	}
+	return pool.ID
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestRunsCollectorHealthMetrics(t *testing.T) {
+	instance := &appv1alpha2.RunsCollector{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "health",
+		},
+	}
+	labels := runsCollectorLabels(instance)
+	t.Cleanup(func() { deleteRunsCollectorHealthMetrics(instance) })
+
+	observeRunsCollectorScrape(instance, time.Second, nil)
+	assert.Equal(t, float64(1), testutil.ToFloat64(MetricRunsCollectorUp.With(labels)))
+	assert.NotZero(t, testutil.ToFloat64(MetricRunsCollectorLastSuccess.With(labels)))
+
+	observeRunsCollectorScrape(instance, time.Second, errors.New("unreachable"))
+	assert.Equal(t, float64(0), testutil.ToFloat64(MetricRunsCollectorUp.With(labels)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(MetricRunsCollectorScrapeErrors.With(labels)))
+
+	setRunsCollectorPaused(instance, true)
+	assert.Equal(t, float64(1), testutil.ToFloat64(MetricRunsCollectorPaused.With(labels)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(MetricRunsCollectorUp.With(labels)))
+
+	deleteRunsCollectorHealthMetrics(instance)
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRunsCollectorUp.MustCurryWith(prometheus.Labels{"name": "health"})))
+}
+
+func TestDeleteRunsMetrics(t *testing.T) {
+	pool := &appv1alpha2.AgentPoolRef{ID: "apool-delete", Name: "delete"}
+
+	MetricRuns.WithLabelValues("pending", pool.ID, pool.Name).Set(1)
+	MetricRunsTotal.WithLabelValues(pool.ID, pool.Name).Set(1)
+	MetricRunsBreakdown.WithLabelValues("org-delete", pool.ID, pool.Name, "pending", "ws-1", "one", "", "").Set(1)
+	MetricOrganizationRunsTotal.WithLabelValues("org-delete").Set(1)
+
+	deleteRunsMetrics("org-delete", pool)
+	assert.False(t, MetricRunsTotal.DeleteLabelValues(pool.ID, pool.Name))
+	assert.False(t, MetricRunsBreakdown.DeleteLabelValues("org-delete", pool.ID, pool.Name, "pending", "ws-1", "one", "", ""))
+	// Series of the organization are kept when an agent pool collector is removed.
+	assert.True(t, MetricOrganizationRunsTotal.DeleteLabelValues("org-delete"))
+
+	MetricOrganizationRunsTotal.WithLabelValues("org-delete").Set(1)
+	deleteRunsMetrics("org-delete", nil)
+	assert.False(t, MetricOrganizationRunsTotal.DeleteLabelValues("org-delete"))
+}
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+const (
+	// runsObservationLookback is how long before the last observation runs are listed to find runs that have completed since then.
+	// Runs are listed from newest to oldest and paging stops at the first run created earlier.
+	// Runs that were not completed at the last observation are tracked by ID in the status and read separately,
+	// therefore the lookback only needs to cover runs that were created and completed between two observations.
+	// It is kept short, since completed runs are listed on every reconciliation.
+	runsObservationLookback = time.Hour
+
+	runPhasePlan  = "plan"
+	runPhaseApply = "apply"
+)
+
+// runCompletedAt returns the time when the run reached a final status, or the zero time if it has not.
+func runCompletedAt(ts *tfc.RunStatusTimestamps) time.Time {
+	var t time.Time
+	for _, v := range []time.Time{
+		ts.AppliedAt,
+		ts.PlannedAndFinishedAt,
+		ts.PlannedAndSavedAt,
+		ts.ErroredAt,
+		ts.CanceledAt,
+		ts.ForceCanceledAt,
+		ts.DiscardedAt,
+	} {
+		if v.After(t) {
+			t = v
+		}
+	}
+	return t
+}
+
+// firstNonZeroTime returns the first of the given times that is not zero.
+func firstNonZeroTime(times ...time.Time) time.Time {
+	for _, t := range times {
+		if !t.IsZero() {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// elapsed returns the duration between two times, and false if one of them is not set or they are out of order.
+func elapsed(from, to time.Time) (time.Duration, bool) {
+	if from.IsZero() || to.IsZero() || to.Before(from) {
+		return 0, false
+	}
+	return to.Sub(from), true
+}
+
+// runQueueDurations returns the time the run spent in the queue before the plan and apply started, by phase.
+func runQueueDurations(ts *tfc.RunStatusTimestamps) map[string]time.Duration {
+	m := make(map[string]time.Duration)
+	if d, ok := elapsed(ts.PlanQueuedAt, ts.PlanningAt); ok {
+		m[runPhasePlan] = d
+	}
+	if d, ok := elapsed(ts.ApplyQueuedAt, ts.ApplyingAt); ok {
+		m[runPhaseApply] = d
+	}
+	return m
+}
+
+// runPhaseDurations returns the duration of the plan and apply of the run, by phase.
+func runPhaseDurations(ts *tfc.RunStatusTimestamps) map[string]time.Duration {
+	m := make(map[string]time.Duration)
+	if d, ok := elapsed(ts.PlanningAt, firstNonZeroTime(ts.PlannedAt, ts.PlannedAndFinishedAt, ts.PlannedAndSavedAt)); ok {
+		m[runPhasePlan] = d
+	}
+	if d, ok := elapsed(ts.ApplyingAt, ts.AppliedAt); ok {
+		m[runPhaseApply] = d
+	}
+	return m
+}
+
+// observeRun observes the queue time and the plan and apply duration of the completed run.
+// It returns false if the run does not belong to any of the given agent pools.
+func (rc *runsCollectorInstance) observeRun(run *tfc.Run, pools map[string]appv1alpha2.AgentPoolRef) bool {
+	p, ok := pools[rc.runAgentPoolID(run)]
+	if !ok {
+		return false
+	}
+	for phase, d := range runQueueDurations(run.StatusTimestamps) {
+		MetricRunQueueDuration.WithLabelValues(
+			rc.instance.Spec.Organization, // organization
+			p.ID,                          // agent_pool_id
+			p.Name,                        // agent_pool_name
+			phase,                         // phase
+		).Observe(d.Seconds())
+	}
+	for phase, d := range runPhaseDurations(run.StatusTimestamps) {
+		MetricRunPhaseDuration.WithLabelValues(
+			rc.instance.Spec.Organization, // organization
+			p.ID,                          // agent_pool_id
+			p.Name,                        // agent_pool_name
+			phase,                         // phase
+		).Observe(d.Seconds())
+	}
+	return true
+}
+
+// observeRunDurations observes the queue time and the plan and apply duration of runs that have completed since the last observation.
+// The given pending runs are recorded in the status, and those of them that are no longer pending on the next call are read and observed.
+// On the first call, it only records the current time and the pending runs, so the history of runs is not replayed at once.
+func (r *RunsCollectorReconciler) observeRunDurations(ctx context.Context, rc *runsCollectorInstance, pending []*tfc.Run) error {
+	var pendingIDs []string
+	for _, run := range pending {
+		pendingIDs = append(pendingIDs, run.ID)
+	}
+	slices.Sort(pendingIDs)
+
+	if rc.instance.Status.RunsObservedUntil == nil {
+		rc.instance.Status.RunsObservedUntil = &metav1.Time{Time: time.Now()}
+		rc.instance.Status.PendingRunIDs = pendingIDs
+		return nil
+	}
+
+	if rc.skipRuns() {
+		rc.instance.Status.PendingRunIDs = nil
+		return nil
+	}
+
+	since := rc.instance.Status.RunsObservedUntil.Time
+	until := since
+	createdAfter := since.Add(-runsObservationLookback)
+
+	pools := make(map[string]appv1alpha2.AgentPoolRef)
+	for _, p := range rc.metricAgentPools() {
+		pools[p.ID] = p
+	}
+
+	// Runs that were pending at the last observation are read by ID, so they are observed however long they take.
+	listOpts := rc.listRunsOptions("final")
+	tracked := make(map[string]bool)
+	for _, id := range rc.instance.Status.PendingRunIDs {
+		tracked[id] = true
+		if slices.Contains(pendingIDs, id) {
+			continue
+		}
+		run, err := rc.tfClient.Client.Runs.ReadWithOptions(ctx, id, &tfc.RunReadOptions{Include: listOpts.Include})
+		if err != nil {
+			if err == tfc.ErrResourceNotFound {
+				continue
+			}
+			rc.log.Error(err, "Reconcile Runs Collector", "msg", fmt.Sprintf("failed to get run %q", id))
+			return err
+		}
+		if run.StatusTimestamps == nil {
+			continue
+		}
+		completedAt := runCompletedAt(run.StatusTimestamps).Truncate(time.Second)
+		if completedAt.IsZero() {
+			continue
+		}
+		if rc.observeRun(run, pools) && completedAt.After(until) {
+			until = completedAt
+		}
+	}
+
+	for {
+		runsList, err := rc.tfClient.Client.Runs.ListForOrganization(ctx, rc.instance.Spec.Organization, listOpts)
+		if err != nil {
+			rc.log.Error(err, "Reconcile Runs Collector", "msg", "failed to list completed runs")
+			return err
+		}
+		done := false
+		for _, run := range runsList.Items {
+			if run.CreatedAt.Before(createdAfter) {
+				done = true
+				break
+			}
+			if run.StatusTimestamps == nil || tracked[run.ID] {
+				continue
+			}
+			// The observation time is stored with a second precision.
+			completedAt := runCompletedAt(run.StatusTimestamps).Truncate(time.Second)
+			if !completedAt.After(since) {
+				continue
+			}
+			if rc.observeRun(run, pools) && completedAt.After(until) {
+				until = completedAt
+			}
+		}
+		if done || runsList.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = runsList.NextPage
+	}
+
+	rc.instance.Status.RunsObservedUntil = &metav1.Time{Time: until}
+	rc.instance.Status.PendingRunIDs = pendingIDs
+
+	return nil
+}
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-tfe/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestRunCompletedAt(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		ts       *tfc.RunStatusTimestamps
+		expected time.Time
+	}{
+		"NotCompleted": {
+			ts:       &tfc.RunStatusTimestamps{PlanningAt: start},
+			expected: time.Time{},
+		},
+		"Applied": {
+			ts:       &tfc.RunStatusTimestamps{PlanningAt: start, AppliedAt: start.Add(time.Minute)},
+			expected: start.Add(time.Minute),
+		},
+		"Errored": {
+			ts:       &tfc.RunStatusTimestamps{PlanningAt: start, ErroredAt: start.Add(2 * time.Minute)},
+			expected: start.Add(2 * time.Minute),
+		},
+		"Discarded": {
+			ts:       &tfc.RunStatusTimestamps{PlannedAt: start, DiscardedAt: start.Add(3 * time.Minute)},
+			expected: start.Add(3 * time.Minute),
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.expected, runCompletedAt(c.ts))
+		})
+	}
+}
+
+func TestRunDurations(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("PlanAndApply", func(t *testing.T) {
+		ts := &tfc.RunStatusTimestamps{
+			PlanQueuedAt:  start,
+			PlanningAt:    start.Add(10 * time.Second),
+			PlannedAt:     start.Add(70 * time.Second),
+			ApplyQueuedAt: start.Add(100 * time.Second),
+			ApplyingAt:    start.Add(130 * time.Second),
+			AppliedAt:     start.Add(250 * time.Second),
+		}
+		assert.Equal(t, map[string]time.Duration{
+			runPhasePlan:  10 * time.Second,
+			runPhaseApply: 30 * time.Second,
+		}, runQueueDurations(ts))
+		assert.Equal(t, map[string]time.Duration{
+			runPhasePlan:  60 * time.Second,
+			runPhaseApply: 120 * time.Second,
+		}, runPhaseDurations(ts))
+	})
+
+	t.Run("PlanOnly", func(t *testing.T) {
+		ts := &tfc.RunStatusTimestamps{
+			PlanQueuedAt:         start,
+			PlanningAt:           start.Add(5 * time.Second),
+			PlannedAndFinishedAt: start.Add(65 * time.Second),
+		}
+		assert.Equal(t, map[string]time.Duration{runPhasePlan: 5 * time.Second}, runQueueDurations(ts))
+		assert.Equal(t, map[string]time.Duration{runPhasePlan: 60 * time.Second}, runPhaseDurations(ts))
+	})
+
+	t.Run("NotStarted", func(t *testing.T) {
+		ts := &tfc.RunStatusTimestamps{
+			PlanQueuedAt: start,
+			CanceledAt:   start.Add(time.Minute),
+		}
+		assert.Empty(t, runQueueDurations(ts))
+		assert.Empty(t, runPhaseDurations(ts))
+	})
+}
+
+func TestObserveRunDurations(t *testing.T) {
+	since := time.Now().Add(-10 * time.Minute).Truncate(time.Second)
+	completedAt := since.Add(5 * time.Minute)
+
+	mockCtrl := gomock.NewController(t)
+	mockRuns := mocks.NewMockRuns(mockCtrl)
+	// The second page is never requested, since the first one ends with a run created before the lookback.
+	mockRuns.EXPECT().
+		ListForOrganization(gomock.Any(), "observe-run-durations", gomock.Any()).
+		Return(&tfc.OrganizationRunList{
+			Items: []*tfc.Run{
+				{
+					ID:        "run-completed",
+					CreatedAt: since.Add(-time.Minute),
+					StatusTimestamps: &tfc.RunStatusTimestamps{
+						PlanningAt: since.Add(time.Minute),
+						PlannedAt:  since.Add(2 * time.Minute),
+						ApplyingAt: since.Add(3 * time.Minute),
+						AppliedAt:  completedAt,
+					},
+				},
+				{
+					ID:        "run-observed",
+					CreatedAt: since.Add(-2 * time.Minute),
+					StatusTimestamps: &tfc.RunStatusTimestamps{
+						AppliedAt: since.Add(-time.Minute),
+					},
+				},
+				{
+					ID:        "run-old",
+					CreatedAt: since.Add(-runsObservationLookback - time.Minute),
+				},
+			},
+			PaginationNextPrev: &tfc.PaginationNextPrev{NextPage: 2},
+		}, nil).
+		Times(1)
+
+	rc := &runsCollectorInstance{
+		instance: appv1alpha2.RunsCollector{
+			Spec: appv1alpha2.RunsCollectorSpec{Organization: "observe-run-durations"},
+			Status: appv1alpha2.RunsCollectorStatus{
+				RunsObservedUntil: &metav1.Time{Time: since},
+			},
+		},
+		log:      logr.Discard(),
+		tfClient: HCPTerraformClient{Client: &tfc.Client{Runs: mockRuns}},
+	}
+
+	r := &RunsCollectorReconciler{}
+	assert.NoError(t, r.observeRunDurations(context.Background(), rc, nil))
+	assert.True(t, completedAt.Equal(rc.instance.Status.RunsObservedUntil.Time))
+	assert.Nil(t, rc.instance.Status.PendingRunIDs)
+}
+
+func TestObserveRunDurationsPendingRuns(t *testing.T) {
+	since := time.Now().Add(-10 * time.Minute).Truncate(time.Second)
+	completedAt := since.Add(5 * time.Minute)
+	longRun := &tfc.Run{
+		ID:        "run-long",
+		CreatedAt: since.Add(-3 * runsObservationLookback),
+		StatusTimestamps: &tfc.RunStatusTimestamps{
+			PlanningAt: since.Add(-2 * runsObservationLookback),
+			PlannedAt:  since.Add(time.Minute),
+			ApplyingAt: since.Add(2 * time.Minute),
+			AppliedAt:  completedAt,
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	mockRuns := mocks.NewMockRuns(mockCtrl)
+	// The run that is still pending is not read.
+	mockRuns.EXPECT().
+		ReadWithOptions(gomock.Any(), "run-long", gomock.Any()).
+		Return(longRun, nil).
+		Times(1)
+	// The completed run was created before the lookback, it is observed only because it was pending at the last observation.
+	mockRuns.EXPECT().
+		ListForOrganization(gomock.Any(), "observe-pending-runs", gomock.Any()).
+		Return(&tfc.OrganizationRunList{
+			Items:              []*tfc.Run{longRun},
+			PaginationNextPrev: &tfc.PaginationNextPrev{},
+		}, nil).
+		Times(1)
+
+	rc := &runsCollectorInstance{
+		instance: appv1alpha2.RunsCollector{
+			Spec: appv1alpha2.RunsCollectorSpec{Organization: "observe-pending-runs"},
+			Status: appv1alpha2.RunsCollectorStatus{
+				RunsObservedUntil: &metav1.Time{Time: since},
+				PendingRunIDs:     []string{"run-long", "run-still"},
+			},
+		},
+		log:      logr.Discard(),
+		tfClient: HCPTerraformClient{Client: &tfc.Client{Runs: mockRuns}},
+	}
+
+	r := &RunsCollectorReconciler{}
+	pending := []*tfc.Run{{ID: "run-new"}, {ID: "run-still"}}
+	assert.NoError(t, r.observeRunDurations(context.Background(), rc, pending))
+	assert.True(t, completedAt.Equal(rc.instance.Status.RunsObservedUntil.Time))
+	assert.Equal(t, []string{"run-new", "run-still"}, rc.instance.Status.PendingRunIDs)
+}
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// pools returns the agent pools from which runs are collected, or nil when runs are collected from the entire organization.
+func (rc *runsCollectorInstance) pools() []appv1alpha2.AgentPoolRef {
+	if rc.instance.Status.AgentPool != nil {
+		return []appv1alpha2.AgentPoolRef{*rc.instance.Status.AgentPool}
+	}
+	return rc.instance.Status.AgentPools
+}
+
+// organizationWide returns true if runs are collected from the entire organization.
+func (rc *runsCollectorInstance) organizationWide() bool {
+	return rc.instance.Spec.AgentPool == nil && rc.instance.Spec.AgentPools == nil
+}
+
+// metricAgentPools returns the agent pools by which the runs metrics are labelled.
+// When runs are collected from the entire organization, it returns a single agent pool with empty ID and name.
+func (rc *runsCollectorInstance) metricAgentPools() []appv1alpha2.AgentPoolRef {
+	if rc.organizationWide() {
+		return []appv1alpha2.AgentPoolRef{{}}
+	}
+	return rc.pools()
+}
+
+// runAgentPoolID returns the ID of the agent pool the run belongs to.
+// When runs are collected from multiple agent pools, it is the agent pool of the run workspace.
+func (rc *runsCollectorInstance) runAgentPoolID(run *tfc.Run) string {
+	if rc.instance.Status.AgentPool != nil {
+		return rc.instance.Status.AgentPool.ID
+	}
+	if rc.instance.Spec.AgentPools != nil && run.Workspace != nil && run.Workspace.AgentPool != nil {
+		return run.Workspace.AgentPool.ID
+	}
+	return ""
+}
+
+// listRunsOptions returns the options to list runs of the given status group once for all agent pools of the collector.
+func (rc *runsCollectorInstance) listRunsOptions(statusGroup string) *tfc.RunListForOrganizationOptions {
+	names := make([]string, 0, len(rc.pools()))
+	for _, p := range rc.pools() {
+		names = append(names, p.Name)
+	}
+
+	listOpts := &tfc.RunListForOrganizationOptions{
+		AgentPoolNames: strings.Join(names, ","),
+		StatusGroup:    statusGroup,
+		ListOptions: tfc.ListOptions{
+			PageSize:   MaxPageSize,
+			PageNumber: InitPageNumber,
+		},
+	}
+	if rc.instance.Spec.AgentPools != nil || len(rc.instance.Spec.Breakdown) > 0 {
+		listOpts.Include = []tfc.RunIncludeOpt{tfc.RunWorkspace}
+	}
+
+	return listOpts
+}
+
+// skipRuns returns true if the collector selects agent pools but none of them matches, so there are no runs to list.
+func (rc *runsCollectorInstance) skipRuns() bool {
+	return rc.instance.Spec.AgentPools != nil && len(rc.instance.Status.AgentPools) == 0
+}
+
+// staleAgentPoolRefs returns the agent pools of the old list that are not in the new one.
+func staleAgentPoolRefs(old, new []appv1alpha2.AgentPoolRef) []appv1alpha2.AgentPoolRef {
+	var stale []appv1alpha2.AgentPoolRef
+	for _, o := range old {
+		if !slices.ContainsFunc(new, func(n appv1alpha2.AgentPoolRef) bool { return n.ID == o.ID }) {
+			stale = append(stale, o)
+		}
+	}
+	return stale
+}
+
+// filterAgentPoolsByName returns references to the agent pools whose names match the regular expression.
+// A nil regular expression matches all agent pools.
+func filterAgentPoolsByName(pools []*tfc.AgentPool, re *regexp.Regexp) []appv1alpha2.AgentPoolRef {
+	var refs []appv1alpha2.AgentPoolRef
+	for _, p := range pools {
+		if re == nil || re.MatchString(p.Name) {
+			refs = append(refs, appv1alpha2.AgentPoolRef{ID: p.ID, Name: p.Name})
+		}
+	}
+	return refs
+}
+
+// listOrganizationAgentPools returns all agent pools of the organization.
+func (r *RunsCollectorReconciler) listOrganizationAgentPools(ctx context.Context, rc *runsCollectorInstance) ([]*tfc.AgentPool, error) {
+	var pools []*tfc.AgentPool
+	listOpts := &tfc.AgentPoolListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize:   MaxPageSize,
+			PageNumber: InitPageNumber,
+		},
+	}
+	for {
+		ap, err := rc.tfClient.Client.AgentPools.List(ctx, rc.instance.Spec.Organization, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, ap.Items...)
+		if ap.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = ap.NextPage
+	}
+
+	return pools, nil
+}
+
+// listSelectedAgentPools returns the agent pools selected by spec.agentPools, sorted by name.
+func (r *RunsCollectorReconciler) listSelectedAgentPools(ctx context.Context, rc *runsCollectorInstance) ([]appv1alpha2.AgentPoolRef, error) {
+	spec := rc.instance.Spec.AgentPools
+
+	var refs []appv1alpha2.AgentPoolRef
+	if spec.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+		apl := &appv1alpha2.AgentPoolList{}
+		if err := r.Client.List(ctx, apl, client.InNamespace(rc.instance.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, err
+		}
+		for _, ap := range apl.Items {
+			if ap.Spec.Organization != rc.instance.Spec.Organization || ap.Status.AgentPoolID == "" {
+				continue
+			}
+			refs = append(refs, appv1alpha2.AgentPoolRef{ID: ap.Status.AgentPoolID, Name: ap.Spec.Name})
+		}
+	} else {
+		var re *regexp.Regexp
+		if spec.NamePattern != "" {
+			var err error
+			re, err = regexp.Compile(spec.NamePattern)
+			if err != nil {
+				return nil, err
+			}
+		}
+		pools, err := r.listOrganizationAgentPools(ctx, rc)
+		if err != nil {
+			return nil, err
+		}
+		refs = filterAgentPoolsByName(pools, re)
+	}
+
+	slices.SortFunc(refs, func(a, b appv1alpha2.AgentPoolRef) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return refs, nil
+}
+
+// updateStatusAgentPools resolves the agent pools from which runs are collected.
+func (r *RunsCollectorReconciler) updateStatusAgentPools(ctx context.Context, rc *runsCollectorInstance) error {
+	switch {
+	case rc.instance.Spec.AgentPools != nil:
+		pools, err := r.listSelectedAgentPools(ctx, rc)
+		if err != nil {
+			rc.log.Error(err, "Reconcile Runs Collector", "msg", "failed to list selected agent pools")
+			return err
+		}
+		rc.instance.Status.AgentPool = nil
+		rc.instance.Status.AgentPools = pools
+	case rc.instance.Spec.AgentPool != nil:
+		rc.instance.Status.AgentPools = nil
+		if rc.instance.NeedUpdateStatus() {
+			if err := r.updateStatusAgentPool(ctx, rc); err != nil {
+				rc.log.Error(err, "Reconcile Runs Collector", "msg", "failed to get agent pool")
+				return err
+			}
+		}
+	default:
+		rc.instance.Status.AgentPool = nil
+		rc.instance.Status.AgentPools = nil
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"regexp"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestFilterAgentPoolsByName(t *testing.T) {
+	pools := []*tfc.AgentPool{
+		{ID: "apool-1", Name: "team-a"},
+		{ID: "apool-2", Name: "team-b"},
+		{ID: "apool-3", Name: "shared"},
+	}
+
+	assert.Equal(t, []appv1alpha2.AgentPoolRef{
+		{ID: "apool-1", Name: "team-a"},
+		{ID: "apool-2", Name: "team-b"},
+	}, filterAgentPoolsByName(pools, regexp.MustCompile("^team-")))
+	assert.Len(t, filterAgentPoolsByName(pools, nil), 3)
+	assert.Empty(t, filterAgentPoolsByName(pools, regexp.MustCompile("^none$")))
+}
+
+func TestStaleAgentPoolRefs(t *testing.T) {
+	old := []appv1alpha2.AgentPoolRef{{ID: "apool-1", Name: "a"}, {ID: "apool-2", Name: "b"}}
+	new := []appv1alpha2.AgentPoolRef{{ID: "apool-2", Name: "b"}, {ID: "apool-3", Name: "c"}}
+
+	assert.Equal(t, []appv1alpha2.AgentPoolRef{{ID: "apool-1", Name: "a"}}, staleAgentPoolRefs(old, new))
+	assert.Empty(t, staleAgentPoolRefs(nil, new))
+	assert.Equal(t, old, staleAgentPoolRefs(old, nil))
+}
+
+func TestRunsCollectorAgentPools(t *testing.T) {
+	run := &tfc.Run{
+		Workspace: &tfc.Workspace{ID: "ws-1", AgentPool: &tfc.AgentPool{ID: "apool-2"}},
+	}
+
+	t.Run("OrganizationWide", func(t *testing.T) {
+		rc := &runsCollectorInstance{}
+		assert.True(t, rc.organizationWide())
+		assert.Equal(t, []appv1alpha2.AgentPoolRef{{}}, rc.metricAgentPools())
+		assert.Empty(t, rc.runAgentPoolID(run))
+		assert.Empty(t, rc.listRunsOptions("non_final").AgentPoolNames)
+		assert.False(t, rc.skipRuns())
+	})
+
+	t.Run("AgentPool", func(t *testing.T) {
+		rc := &runsCollectorInstance{
+			instance: appv1alpha2.RunsCollector{
+				Spec:   appv1alpha2.RunsCollectorSpec{AgentPool: &appv1alpha2.AgentPoolRef{Name: "a"}},
+				Status: appv1alpha2.RunsCollectorStatus{AgentPool: &appv1alpha2.AgentPoolRef{ID: "apool-1", Name: "a"}},
+			},
+		}
+		assert.False(t, rc.organizationWide())
+		assert.Equal(t, []appv1alpha2.AgentPoolRef{{ID: "apool-1", Name: "a"}}, rc.metricAgentPools())
+		assert.Equal(t, "apool-1", rc.runAgentPoolID(run))
+		assert.Equal(t, "a", rc.listRunsOptions("non_final").AgentPoolNames)
+		assert.Empty(t, rc.listRunsOptions("non_final").Include)
+	})
+
+	t.Run("AgentPools", func(t *testing.T) {
+		rc := &runsCollectorInstance{
+			instance: appv1alpha2.RunsCollector{
+				Spec: appv1alpha2.RunsCollectorSpec{AgentPools: &appv1alpha2.RunsCollectorAgentPools{All: true}},
+				Status: appv1alpha2.RunsCollectorStatus{AgentPools: []appv1alpha2.AgentPoolRef{
+					{ID: "apool-1", Name: "a"},
+					{ID: "apool-2", Name: "b"},
+				}},
+			},
+		}
+		assert.False(t, rc.organizationWide())
+		assert.Len(t, rc.metricAgentPools(), 2)
+		assert.Equal(t, "apool-2", rc.runAgentPoolID(run))
+		assert.Equal(t, "a,b", rc.listRunsOptions("non_final").AgentPoolNames)
+		assert.Equal(t, []tfc.RunIncludeOpt{tfc.RunWorkspace}, rc.listRunsOptions("non_final").Include)
+		assert.False(t, rc.skipRuns())
+	})
+
+	t.Run("AgentPoolsNoMatch", func(t *testing.T) {
+		rc := &runsCollectorInstance{
+			instance: appv1alpha2.RunsCollector{
+				Spec: appv1alpha2.RunsCollectorSpec{AgentPools: &appv1alpha2.RunsCollectorAgentPools{NamePattern: "^none$"}},
+			},
+		}
+		assert.Empty(t, rc.metricAgentPools())
+		assert.True(t, rc.skipRuns())
+	})
+}
@@ -19,8 +19,10 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
@@ -44,11 +46,14 @@ type workspaceInstance struct {
 
 	log      logr.Logger
 	tfClient HCPTerraformClient
+	// runEvents are the run events to publish once the status is updated.
+	runEvents []*runEvent
 }
 
 // +kubebuilder:rbac:groups=app.terraform.io,resources=workspaces,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=app.terraform.io,resources=workspaces/finalizers,verbs=update
 // +kubebuilder:rbac:groups=app.terraform.io,resources=workspaces/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=app.terraform.io,resources=organizations,verbs=list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=create;list;update;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;list;update;watch
@@ -140,8 +145,8 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 // SetupWithManager sets up the controller with the Manager.
 func (r *WorkspaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&appv1alpha2.Workspace{}).
-		WithEventFilter(predicate.Or(genericPredicates(), workspacePredicates())).
+		For(&appv1alpha2.Workspace{}, builder.WithPredicates(predicate.Or(genericPredicates(), workspacePredicates()))).
+		Watches(&appv1alpha2.OrganizationSSHKey{}, handler.EnqueueRequestsFromMapFunc(r.sshKeyToWorkspaces), builder.WithPredicates(organizationSSHKeyPredicates())).
 		Complete(r)
 }
 
@@ -239,9 +244,11 @@ func (r *WorkspaceReconciler) removeFinalizer(ctx context.Context, w *workspaceI
 	if err != nil {
 		w.log.Error(err, "Reconcile Workspace", "msg", fmt.Sprintf("failed to remove finalizer %s", workspaceFinalizer))
 		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "RemoveFinalizer", "Failed to remove finalizer %s", workspaceFinalizer)
+		return err
 	}
+	deleteWorkspaceMetrics(&w.instance)
 
-	return err
+	return nil
 }
 
 // STATUS
@@ -252,7 +259,7 @@ func (r *WorkspaceReconciler) updateStatus(ctx context.Context, w *workspaceInst
 	w.instance.Status.UpdateAt = workspace.UpdatedAt.Unix()
 	w.instance.Status.TerraformVersion = workspace.TerraformVersion
 
-	return r.Status().Update(ctx, &w.instance)
+	return r.updateStatusRunEvents(ctx, w)
 }
 
 // WORKSPACES
@@ -298,10 +305,10 @@ func (r *WorkspaceReconciler) createWorkspace(ctx context.Context, w *workspaceI
 		options.GlobalRemoteState = tfc.Bool(spec.RemoteStateSharing.AllWorkspaces)
 	}
 
-	org, err := w.tfClient.Client.Organizations.Read(ctx, w.instance.Spec.Organization)
+	defaultProjectID, err := r.getDefaultProjectID(ctx, w)
 	if err != nil {
-		w.log.Error(err, "Reconcile Workspace", "msg", "failed to get organization")
-		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to get organization")
+		w.log.Error(err, "Reconcile Workspace", "msg", "failed to get default project ID")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to get default project ID")
 		return nil, err
 	}
 
@@ -322,9 +329,7 @@ func (r *WorkspaceReconciler) createWorkspace(ctx context.Context, w *workspaceI
 	}
 
 	patch := client.MergeFrom(w.instance.DeepCopy())
-	if org != nil && org.DefaultProject != nil {
-		w.instance.Status.DefaultProjectID = org.DefaultProject.ID
-	}
+	w.instance.Status.DefaultProjectID = defaultProjectID
 	w.instance.Status.WorkspaceID = workspace.ID
 	if err = r.Status().Patch(ctx, &w.instance, patch); err != nil {
 		w.log.Error(err, "Reconcile Workspace", "msg", "failed to update status with workspace ID")
@@ -452,15 +457,15 @@ func (r *WorkspaceReconciler) updateWorkspace(ctx context.Context, w *workspaceI
 			updateOptions.Project = &tfc.Project{ID: w.instance.Status.DefaultProjectID}
 			w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("default project ID %s will be used", w.instance.Status.DefaultProjectID))
 		} else {
-			org, err := w.tfClient.Client.Organizations.Read(ctx, w.instance.Spec.Organization)
+			defaultProjectID, err := r.getDefaultProjectID(ctx, w)
 			if err != nil {
-				w.log.Error(err, "Reconcile Workspace", "msg", "failed to get organization")
-				r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to get organization")
+				w.log.Error(err, "Reconcile Workspace", "msg", "failed to get default project ID")
+				r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to get default project ID")
 				return nil, err
 			}
-			if org != nil && org.DefaultProject != nil {
-				w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("default project ID %s will be used", org.DefaultProject.ID))
-				updateOptions.Project = &tfc.Project{ID: org.DefaultProject.ID}
+			if defaultProjectID != "" {
+				w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("default project ID %s will be used", defaultProjectID))
+				updateOptions.Project = &tfc.Project{ID: defaultProjectID}
 			}
 		}
 	}
@@ -559,7 +564,7 @@ func (r *WorkspaceReconciler) reconcileWorkspace(ctx context.Context, w *workspa
 	}
 
 	// reconcile SSH key
-	err = w.reconcileSSHKey(ctx, workspace)
+	err = r.reconcileSSHKey(ctx, w, workspace)
 	if err != nil {
 		w.log.Error(err, "Reconcile SSH Key", "msg", "failed to assign ssh key ID")
 		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileSSHKey", "Failed to assign SSH Key ID")
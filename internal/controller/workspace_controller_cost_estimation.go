@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// reconcileCostEstimation records the cost estimate of the current non-speculative run and enforces the workspace budget.
+func (r *WorkspaceReconciler) reconcileCostEstimation(ctx context.Context, w *workspaceInstance, run *tfc.Run) error {
+	if run.CostEstimate == nil {
+		w.log.Info("Reconcile Cost Estimation", "msg", fmt.Sprintf("run %s has no cost estimate", run.ID))
+		w.instance.Status.CostEstimation = nil
+		deleteWorkspaceMetrics(&w.instance)
+		return nil
+	}
+
+	status := w.instance.Status.CostEstimation
+	if status == nil || status.RunID != run.ID {
+		status = &appv1alpha2.CostEstimationStatus{RunID: run.ID}
+		w.instance.Status.CostEstimation = status
+	}
+	status.Status = string(run.CostEstimate.Status)
+
+	if run.CostEstimate.Status != tfc.CostEstimateFinished {
+		w.log.Info("Reconcile Cost Estimation", "msg", fmt.Sprintf("cost estimate of run %s is %s", run.ID, run.CostEstimate.Status))
+		return nil
+	}
+
+	status.PriorMonthlyCost = run.CostEstimate.PriorMonthlyCost
+	status.ProposedMonthlyCost = run.CostEstimate.ProposedMonthlyCost
+	status.DeltaMonthlyCost = run.CostEstimate.DeltaMonthlyCost
+	status.ResourcesCount = run.CostEstimate.ResourcesCount
+	status.MatchedResourcesCount = run.CostEstimate.MatchedResourcesCount
+	status.UnmatchedResourcesCount = run.CostEstimate.UnmatchedResourcesCount
+	setWorkspaceCostMetrics(&w.instance, status)
+
+	budget := w.instance.Spec.Budget
+	if budget == nil {
+		status.BudgetExceeded = false
+		status.BudgetAction = ""
+		MetricWorkspaceBudgetExceeded.Delete(workspaceMetricLabels(&w.instance))
+		return nil
+	}
+
+	exceeded, err := costEstimateExceedsBudget(budget, status)
+	if err != nil {
+		w.log.Error(err, "Reconcile Cost Estimation", "msg", fmt.Sprintf("failed to compare cost estimate of run %s with the budget", run.ID))
+		return err
+	}
+	status.BudgetExceeded = exceeded
+	if exceeded {
+		MetricWorkspaceBudgetExceeded.With(workspaceMetricLabels(&w.instance)).Set(1)
+	} else {
+		MetricWorkspaceBudgetExceeded.With(workspaceMetricLabels(&w.instance)).Set(0)
+	}
+
+	if !exceeded || status.BudgetAction != "" {
+		return nil
+	}
+	if _, ok := userInteractionRunStatuses[run.Status]; !ok {
+		w.log.Info("Reconcile Cost Estimation", "msg", fmt.Sprintf("run %s exceeds the budget but it is in status %s, no action is taken", run.ID, run.Status))
+		return nil
+	}
+
+	switch budget.Action {
+	case appv1alpha2.BudgetActionDiscard:
+		w.log.Info("Reconcile Cost Estimation", "msg", fmt.Sprintf("discard run %s that exceeds the budget", run.ID))
+		err := w.tfClient.Client.Runs.Discard(ctx, run.ID, tfc.RunDiscardOptions{
+			Comment: tfc.String(fmt.Sprintf("Discarded by the operator: proposed monthly cost %s, delta %s exceeds the budget", status.ProposedMonthlyCost, status.DeltaMonthlyCost)),
+		})
+		if err != nil {
+			w.log.Error(err, "Reconcile Cost Estimation", "msg", fmt.Sprintf("failed to discard run %s", run.ID))
+			r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "BudgetExceeded", "Failed to discard run %s that exceeds the budget", run.ID)
+			return err
+		}
+		status.BudgetAction = appv1alpha2.BudgetActionDiscard
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "BudgetExceeded", "Discarded run %s: proposed monthly cost %s, delta %s exceeds the budget", run.ID, status.ProposedMonthlyCost, status.DeltaMonthlyCost)
+	default:
+		// The operator cannot prevent the run from being confirmed, it only reports that the run exceeds the budget.
+		w.log.Info("Reconcile Cost Estimation", "msg", fmt.Sprintf("report run %s that exceeds the budget", run.ID))
+		status.BudgetAction = appv1alpha2.BudgetActionReport
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "BudgetExceeded", "Run %s exceeds the budget: proposed monthly cost %s, delta %s", run.ID, status.ProposedMonthlyCost, status.DeltaMonthlyCost)
+	}
+
+	return nil
+}
+
+// costEstimateExceedsBudget returns true if the proposed monthly cost or the monthly cost delta exceeds the corresponding budget threshold.
+func costEstimateExceedsBudget(budget *appv1alpha2.WorkspaceBudget, status *appv1alpha2.CostEstimationStatus) (bool, error) {
+	thresholds := []struct {
+		max  string
+		cost string
+	}{
+		{max: budget.MaxMonthlyCost, cost: status.ProposedMonthlyCost},
+		{max: budget.MaxMonthlyCostDelta, cost: status.DeltaMonthlyCost},
+	}
+	for _, t := range thresholds {
+		if t.max == "" {
+			continue
+		}
+		limit, err := strconv.ParseFloat(t.max, 64)
+		if err != nil {
+			return false, err
+		}
+		cost, err := strconv.ParseFloat(t.cost, 64)
+		if err != nil {
+			return false, err
+		}
+		if cost > limit {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// workspaceMetricLabels returns the labels that identify the workspace in the workspace metrics.
+func workspaceMetricLabels(instance *appv1alpha2.Workspace) prometheus.Labels {
+	return prometheus.Labels{
+		"namespace":    instance.Namespace,
+		"name":         instance.Name,
+		"workspace_id": instance.Status.WorkspaceID,
+	}
+}
+
+// setWorkspaceCostMetrics exports the monthly costs of the cost estimate.
+// Costs that cannot be parsed are not exported.
+func setWorkspaceCostMetrics(instance *appv1alpha2.Workspace, status *appv1alpha2.CostEstimationStatus) {
+	costs := map[string]string{
+		"prior":    status.PriorMonthlyCost,
+		"proposed": status.ProposedMonthlyCost,
+		"delta":    status.DeltaMonthlyCost,
+	}
+	for k, v := range costs {
+		labels := workspaceMetricLabels(instance)
+		labels["cost"] = k
+		c, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			MetricWorkspaceMonthlyCost.Delete(labels)
+			continue
+		}
+		MetricWorkspaceMonthlyCost.With(labels).Set(c)
+	}
+}
+
+// deleteWorkspaceMetrics removes all workspace metric series of the instance.
+func deleteWorkspaceMetrics(instance *appv1alpha2.Workspace) {
+	labels := prometheus.Labels{
+		"namespace": instance.Namespace,
+		"name":      instance.Name,
+	}
+	MetricWorkspaceMonthlyCost.DeletePartialMatch(labels)
+	MetricWorkspaceBudgetExceeded.DeletePartialMatch(labels)
+}
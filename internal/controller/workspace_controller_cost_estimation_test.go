@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestCostEstimateExceedsBudget(t *testing.T) {
+	status := &appv1alpha2.CostEstimationStatus{
+		PriorMonthlyCost:    "100.00",
+		ProposedMonthlyCost: "150.50",
+		DeltaMonthlyCost:    "50.50",
+	}
+
+	cases := map[string]struct {
+		budget   *appv1alpha2.WorkspaceBudget
+		exceeded bool
+	}{
+		"UnderMaxMonthlyCost": {
+			budget:   &appv1alpha2.WorkspaceBudget{MaxMonthlyCost: "200"},
+			exceeded: false,
+		},
+		"EqualMaxMonthlyCost": {
+			budget:   &appv1alpha2.WorkspaceBudget{MaxMonthlyCost: "150.50"},
+			exceeded: false,
+		},
+		"OverMaxMonthlyCost": {
+			budget:   &appv1alpha2.WorkspaceBudget{MaxMonthlyCost: "150"},
+			exceeded: true,
+		},
+		"OverMaxMonthlyCostDelta": {
+			budget:   &appv1alpha2.WorkspaceBudget{MaxMonthlyCost: "200", MaxMonthlyCostDelta: "50"},
+			exceeded: true,
+		},
+		"UnderBothThresholds": {
+			budget:   &appv1alpha2.WorkspaceBudget{MaxMonthlyCost: "200", MaxMonthlyCostDelta: "100"},
+			exceeded: false,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			exceeded, err := costEstimateExceedsBudget(c.budget, status)
+			assert.NoError(t, err)
+			assert.Equal(t, c.exceeded, exceeded)
+		})
+	}
+
+	_, err := costEstimateExceedsBudget(&appv1alpha2.WorkspaceBudget{MaxMonthlyCost: "100"}, &appv1alpha2.CostEstimationStatus{})
+	assert.Error(t, err)
+}
+
+func TestWorkspaceCostMetrics(t *testing.T) {
+	instance := &appv1alpha2.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "cost",
+		},
+		Status: appv1alpha2.WorkspaceStatus{
+			WorkspaceID: "ws-cost",
+		},
+	}
+	t.Cleanup(func() { deleteWorkspaceMetrics(instance) })
+
+	setWorkspaceCostMetrics(instance, &appv1alpha2.CostEstimationStatus{
+		PriorMonthlyCost:    "10.25",
+		ProposedMonthlyCost: "12.75",
+		DeltaMonthlyCost:    "2.50",
+	})
+	labels := workspaceMetricLabels(instance)
+	labels["cost"] = "delta"
+	assert.Equal(t, 2.5, testutil.ToFloat64(MetricWorkspaceMonthlyCost.With(labels)))
+	assert.Equal(t, 3, testutil.CollectAndCount(MetricWorkspaceMonthlyCost))
+
+	deleteWorkspaceMetrics(instance)
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricWorkspaceMonthlyCost))
+}
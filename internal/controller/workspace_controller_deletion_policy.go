@@ -72,12 +72,13 @@ func (r *WorkspaceReconciler) deleteWorkspace(ctx context.Context, w *workspaceI
 			w.log.Info("Destroy Run", "msg", fmt.Sprintf("successfully created a new destroy run: %s", run.ID))
 
 			w.instance.Status.DestroyRunID = run.ID
+			r.queueCurrentRunEvent(w, run)
 			w.updateWorkspaceStatusRun(run)
-			return r.Status().Update(ctx, &w.instance)
+			return r.updateStatusRunEvents(ctx, w)
 		}
 
 		w.log.Info("Destroy Run", "msg", fmt.Sprintf("get destroy run %s", w.instance.Status.DestroyRunID))
-		run, err := w.tfClient.Client.Runs.Read(ctx, w.instance.Status.DestroyRunID)
+		run, err := readRun(ctx, w.tfClient.Client, w.instance.Status.DestroyRunID)
 		if err != nil {
 			if err == tfc.ErrResourceNotFound {
 				w.log.Info("Reconcile Workspace", "msg", "Destroy run was not found, check if the workspace exists")
@@ -88,6 +89,7 @@ func (r *WorkspaceReconciler) deleteWorkspace(ctx context.Context, w *workspaceI
 			w.log.Info("Destroy Run", "msg", fmt.Sprintf("failed to get destroy run: %s", w.instance.Status.DestroyRunID))
 			return err
 		}
+		r.queueCurrentRunEvent(w, run)
 
 		if _, ok := runStatusComplete[run.Status]; ok {
 			w.log.Info("Destroy Run", "msg", fmt.Sprintf("current destroy run %s is finished", run.ID))
@@ -96,17 +98,22 @@ func (r *WorkspaceReconciler) deleteWorkspace(ctx context.Context, w *workspaceI
 			}
 
 			w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("workspace ID %s has been deleted, remove finalizer", w.instance.Status.WorkspaceID))
-			return r.removeFinalizer(ctx, w)
+			if err := r.removeFinalizer(ctx, w); err != nil {
+				return err
+			}
+			r.publishRunEvents(w)
+			return nil
 		}
 
 		if _, ok := runStatusUnsuccessful[run.Status]; ok {
 			w.log.Info("Destroy Run", "msg", fmt.Sprintf("destroy run %s is unsuccessful: %s", run.ID, run.Status))
-			return nil
+			w.updateWorkspaceStatusRun(run)
+			return r.updateStatusRunEvents(ctx, w)
 		}
 		w.log.Info("Destroy Run", "msg", fmt.Sprintf("destroy run %s is not finished", run.ID))
 
 		w.updateWorkspaceStatusRun(run)
-		return r.Status().Update(ctx, &w.instance)
+		return r.updateStatusRunEvents(ctx, w)
 	case appv1alpha2.DeletionPolicyForce:
 		err := w.tfClient.Client.Workspaces.DeleteByID(ctx, w.instance.Status.WorkspaceID)
 		if err != nil {
@@ -8,6 +8,9 @@ import (
 	"fmt"
 
 	tfc "github.com/hashicorp/go-tfe"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 )
 
 func (w *workspaceInstance) getProjectIDByName(ctx context.Context) (string, error) {
@@ -53,3 +56,29 @@ func (w *workspaceInstance) getProjectID(ctx context.Context) (string, error) {
 	w.log.Info("Reconcile Project", "msg", "getting project ID from the spec.Project.ID")
 	return specProject.ID, nil
 }
+
+// getDefaultProjectID returns the default project ID of the workspace organization.
+// It prefers the ID reported by an Organization object in the same namespace and falls back to the API.
+func (r *WorkspaceReconciler) getDefaultProjectID(ctx context.Context, w *workspaceInstance) (string, error) {
+	orgs := &appv1alpha2.OrganizationList{}
+	if err := r.Client.List(ctx, orgs, client.InNamespace(w.instance.Namespace)); err != nil {
+		return "", err
+	}
+	for _, o := range orgs.Items {
+		if o.Spec.Name == w.instance.Spec.Organization && o.Status.DefaultProjectID != "" {
+			w.log.Info("Reconcile Project", "msg", fmt.Sprintf("getting default project ID from the organization object %s", o.Name))
+			return o.Status.DefaultProjectID, nil
+		}
+	}
+
+	w.log.Info("Reconcile Project", "msg", "getting default project ID from the organization")
+	org, err := w.tfClient.Client.Organizations.Read(ctx, w.instance.Spec.Organization)
+	if err != nil {
+		return "", err
+	}
+	if org.DefaultProject == nil {
+		return "", nil
+	}
+
+	return org.DefaultProject.ID, nil
+}
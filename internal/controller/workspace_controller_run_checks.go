@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// reconcileRunChecks records the policy evaluations, legacy policy checks, and run task results of the current non-speculative run.
+// Failures are logged and do not block the reconciliation, the previously recorded checks are kept.
+func (r *WorkspaceReconciler) reconcileRunChecks(ctx context.Context, w *workspaceInstance, run *tfc.Run) {
+	w.log.Info("Reconcile Run Checks", "msg", fmt.Sprintf("get policy evaluations and run task results of run %s", run.ID))
+
+	stages, err := listTaskStages(ctx, w.tfClient.Client, run.ID)
+	if err != nil {
+		w.log.Error(err, "Reconcile Run Checks", "msg", fmt.Sprintf("failed to get task stages of run %s", run.ID))
+		return
+	}
+
+	checks := &appv1alpha2.RunChecksStatus{RunID: run.ID}
+	for _, s := range stages {
+		checks.Stages = append(checks.Stages, appv1alpha2.RunStageStatus{
+			ID:     s.ID,
+			Stage:  string(s.Stage),
+			Status: string(s.Status),
+		})
+		checks.RunTasks = append(checks.RunTasks, runTaskResults(s)...)
+		for _, pe := range s.PolicyEvaluations {
+			outcomes, err := listPolicySetOutcomes(ctx, w.tfClient.Client, pe.ID)
+			if err != nil {
+				w.log.Error(err, "Reconcile Run Checks", "msg", fmt.Sprintf("failed to get policy set outcomes of policy evaluation %s", pe.ID))
+				return
+			}
+			for _, o := range outcomes {
+				checks.Policies = append(checks.Policies, policyOutcomes(s.Stage, pe.PolicyKind, o)...)
+			}
+		}
+	}
+
+	policyChecks, err := listPolicyChecks(ctx, w.tfClient.Client, run.ID)
+	if err != nil {
+		w.log.Error(err, "Reconcile Run Checks", "msg", fmt.Sprintf("failed to get policy checks of run %s", run.ID))
+		return
+	}
+	for _, pc := range policyChecks {
+		checks.PolicyChecks = append(checks.PolicyChecks, policyCheckStatus(pc))
+	}
+
+	w.instance.Status.RunChecks = checks
+	w.log.Info("Reconcile Run Checks", "msg", fmt.Sprintf("successfully got %d policy outcomes, %d policy checks, and %d run task results of run %s", len(checks.Policies), len(checks.PolicyChecks), len(checks.RunTasks), run.ID))
+}
+
+// reconcileRunOverride overrides failed policies and run tasks of the current non-speculative run when it is requested via annotations.
+// The request annotations are removed once the request is processed, and the result is recorded in the status.
+func (r *WorkspaceReconciler) reconcileRunOverride(ctx context.Context, w *workspaceInstance) error {
+	runID, ok := w.instance.Annotations[WorkspaceAnnotationRunOverride]
+	if !ok || runID == "" {
+		return nil
+	}
+	w.log.Info("Reconcile Run Override", "msg", fmt.Sprintf("override of run %s is requested", runID))
+
+	justification := w.instance.Annotations[WorkspaceAnnotationRunOverrideJustification]
+	status := &appv1alpha2.RunOverrideStatus{
+		RunID:         runID,
+		Justification: justification,
+	}
+
+	overridden, err := r.overrideRun(ctx, w, runID, justification)
+	if err != nil {
+		w.log.Error(err, "Reconcile Run Override", "msg", fmt.Sprintf("failed to override run %s", runID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "RunOverride", "Rejected override of run %s: %s", runID, err.Error())
+		status.Result = runOverrideResultRejected
+		status.Message = err.Error()
+	} else {
+		w.log.Info("Reconcile Run Override", "msg", fmt.Sprintf("successfully overrode run %s", runID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "RunOverride", "Overrode %s of run %s: %s", overridden, runID, justification)
+		status.Result = runOverrideResultOverridden
+		status.Message = fmt.Sprintf("overrode %s", overridden)
+	}
+	now := metav1.Now()
+	status.ProcessedAt = &now
+
+	// Patch a copy of the object, so that the in-memory status changes are kept until the status is updated.
+	instance := w.instance.DeepCopy()
+	patch := client.MergeFrom(instance.DeepCopy())
+	delete(instance.Annotations, WorkspaceAnnotationRunOverride)
+	delete(instance.Annotations, WorkspaceAnnotationRunOverrideJustification)
+	if err := r.Patch(ctx, instance, patch); err != nil {
+		w.log.Error(err, "Reconcile Run Override", "msg", "failed to remove the run override annotations")
+		return err
+	}
+	w.instance.Annotations = instance.Annotations
+	w.instance.ResourceVersion = instance.ResourceVersion
+	w.instance.Status.RunOverride = status
+
+	return nil
+}
+
+// overrideRun overrides the task stages awaiting override and the overridable legacy policy checks of the run.
+// It returns a description of what was overridden, or an error if the request cannot be fulfilled.
+func (r *WorkspaceReconciler) overrideRun(ctx context.Context, w *workspaceInstance, runID, justification string) (string, error) {
+	if err := validateRunOverride(w.instance.Status.Run, runID, justification); err != nil {
+		return "", err
+	}
+
+	stages, err := listTaskStages(ctx, w.tfClient.Client, runID)
+	if err != nil {
+		return "", err
+	}
+	policyChecks, err := listPolicyChecks(ctx, w.tfClient.Client, runID)
+	if err != nil {
+		return "", err
+	}
+
+	var overridden []string
+	for _, s := range stages {
+		if s.Status != tfc.TaskStageAwaitingOverride {
+			continue
+		}
+		if s.Permissions != nil && s.Permissions.CanOverride != nil && !*s.Permissions.CanOverride {
+			return "", fmt.Errorf("the token is not authorized to override task stage %s", s.ID)
+		}
+		if _, err := w.tfClient.Client.TaskStages.Override(ctx, s.ID, tfc.TaskStageOverrideOptions{
+			Comment: tfc.String(justification),
+		}); err != nil {
+			return "", err
+		}
+		overridden = append(overridden, fmt.Sprintf("task stage %s", s.Stage))
+	}
+	for _, pc := range policyChecks {
+		if pc.Actions == nil || !pc.Actions.IsOverridable {
+			continue
+		}
+		if pc.Permissions != nil && !pc.Permissions.CanOverride {
+			return "", fmt.Errorf("the token is not authorized to override policy check %s", pc.ID)
+		}
+		if _, err := w.tfClient.Client.PolicyChecks.Override(ctx, pc.ID); err != nil {
+			return "", err
+		}
+		overridden = append(overridden, fmt.Sprintf("policy check %s", pc.ID))
+	}
+
+	if len(overridden) == 0 {
+		return "", errors.New("the run has no task stages or policy checks awaiting override")
+	}
+
+	return strings.Join(overridden, ", "), nil
+}
+
+// validateRunOverride validates that the override targets the current run, which awaits a decision, and that the justification is set.
+func validateRunOverride(current *appv1alpha2.RunStatus, runID, justification string) error {
+	if justification == "" {
+		return fmt.Errorf("annotation %s must be set", WorkspaceAnnotationRunOverrideJustification)
+	}
+	if current == nil || current.ID != runID {
+		return fmt.Errorf("run %s is not the current run of the workspace", runID)
+	}
+	switch tfc.RunStatus(current.Status) {
+	case tfc.RunPolicySoftFailed, tfc.RunPolicyOverride, tfc.RunPostPlanAwaitingDecision:
+		return nil
+	}
+
+	return fmt.Errorf("run %s in status %s does not await override", runID, current.Status)
+}
+
+// listTaskStages returns the task stages of the run including their task results and policy evaluations.
+func listTaskStages(ctx context.Context, c *tfc.Client, runID string) ([]*tfc.TaskStage, error) {
+	var items []*tfc.TaskStage
+	listOpts := &tfc.TaskStageListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		list, err := c.TaskStages.List(ctx, runID, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, list.Items...)
+		if list.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = list.NextPage
+	}
+
+	stages := make([]*tfc.TaskStage, 0, len(items))
+	for _, s := range items {
+		stage, err := c.TaskStages.Read(ctx, s.ID, &tfc.TaskStageReadOptions{
+			Include: []tfc.TaskStageIncludeOpt{tfc.TaskStageTaskResults, tfc.PolicyEvaluationsTaskResults},
+		})
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, stage)
+	}
+
+	return stages, nil
+}
+
+// listPolicySetOutcomes returns all policy set outcomes of the policy evaluation.
+func listPolicySetOutcomes(ctx context.Context, c *tfc.Client, policyEvaluationID string) ([]*tfc.PolicySetOutcome, error) {
+	var outcomes []*tfc.PolicySetOutcome
+	listOpts := &tfc.PolicySetOutcomeListOptions{
+		ListOptions: &tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		list, err := c.PolicySetOutcomes.List(ctx, policyEvaluationID, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		outcomes = append(outcomes, list.Items...)
+		if list.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = list.NextPage
+	}
+
+	return outcomes, nil
+}
+
+// listPolicyChecks returns all legacy policy checks of the run.
+func listPolicyChecks(ctx context.Context, c *tfc.Client, runID string) ([]*tfc.PolicyCheck, error) {
+	var policyChecks []*tfc.PolicyCheck
+	listOpts := &tfc.PolicyCheckListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		list, err := c.PolicyChecks.List(ctx, runID, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		policyChecks = append(policyChecks, list.Items...)
+		if list.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = list.NextPage
+	}
+
+	return policyChecks, nil
+}
+
+// runTaskResults converts the task results of the task stage to the status.
+func runTaskResults(stage *tfc.TaskStage) []appv1alpha2.RunTaskResultStatus {
+	results := make([]appv1alpha2.RunTaskResultStatus, 0, len(stage.TaskResults))
+	for _, tr := range stage.TaskResults {
+		results = append(results, appv1alpha2.RunTaskResultStatus{
+			Stage:            string(stage.Stage),
+			Name:             tr.TaskName,
+			Status:           string(tr.Status),
+			EnforcementLevel: string(tr.WorkspaceTaskEnforcementLevel),
+			Message:          tr.Message,
+		})
+	}
+
+	return results
+}
+
+// policyOutcomes converts the outcomes of the policy set to the status.
+func policyOutcomes(stage tfc.Stage, kind tfc.PolicyKind, outcome *tfc.PolicySetOutcome) []appv1alpha2.PolicyOutcomeStatus {
+	policies := make([]appv1alpha2.PolicyOutcomeStatus, 0, len(outcome.Outcomes))
+	for _, o := range outcome.Outcomes {
+		policies = append(policies, appv1alpha2.PolicyOutcomeStatus{
+			Stage:            string(stage),
+			Kind:             string(kind),
+			PolicySet:        outcome.PolicySetName,
+			Policy:           o.PolicyName,
+			Result:           o.Status,
+			EnforcementLevel: string(o.EnforcementLevel),
+		})
+	}
+
+	return policies
+}
+
+// policyCheckStatus converts the legacy policy check to the status.
+func policyCheckStatus(pc *tfc.PolicyCheck) appv1alpha2.PolicyCheckStatus {
+	s := appv1alpha2.PolicyCheckStatus{
+		ID:     pc.ID,
+		Status: string(pc.Status),
+	}
+	if pc.Result != nil {
+		s.Passed = pc.Result.Passed
+		s.AdvisoryFailed = pc.Result.AdvisoryFailed
+		s.SoftFailed = pc.Result.SoftFailed
+		s.HardFailed = pc.Result.HardFailed
+	}
+
+	return s
+}
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-tfe/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestValidateRunOverride(t *testing.T) {
+	successCases := map[string]*appv1alpha2.RunStatus{
+		"PolicySoftFailed":         {ID: "run-1", Status: string(tfc.RunPolicySoftFailed)},
+		"PolicyOverride":           {ID: "run-1", Status: string(tfc.RunPolicyOverride)},
+		"PostPlanAwaitingDecision": {ID: "run-1", Status: string(tfc.RunPostPlanAwaitingDecision)},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			assert.NoError(t, validateRunOverride(c, "run-1", "approved by the change board"))
+		})
+	}
+
+	errorCases := map[string]struct {
+		current       *appv1alpha2.RunStatus
+		justification string
+	}{
+		"NoJustification": {
+			current: &appv1alpha2.RunStatus{ID: "run-1", Status: string(tfc.RunPolicySoftFailed)},
+		},
+		"NoCurrentRun": {
+			justification: "approved",
+		},
+		"NotCurrentRun": {
+			current:       &appv1alpha2.RunStatus{ID: "run-2", Status: string(tfc.RunPolicySoftFailed)},
+			justification: "approved",
+		},
+		"NotAwaitingOverride": {
+			current:       &appv1alpha2.RunStatus{ID: "run-1", Status: string(tfc.RunPlanned)},
+			justification: "approved",
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			assert.Error(t, validateRunOverride(c.current, "run-1", c.justification))
+		})
+	}
+}
+
+func TestRunTaskResults(t *testing.T) {
+	stage := &tfc.TaskStage{
+		Stage: tfc.PostPlan,
+		TaskResults: []*tfc.TaskResult{
+			{
+				TaskName:                      "scanner",
+				Status:                        tfc.TaskFailed,
+				WorkspaceTaskEnforcementLevel: tfc.Mandatory,
+				Message:                       "2 critical findings",
+			},
+		},
+	}
+
+	assert.Equal(t, []appv1alpha2.RunTaskResultStatus{
+		{
+			Stage:            "post_plan",
+			Name:             "scanner",
+			Status:           "failed",
+			EnforcementLevel: "mandatory",
+			Message:          "2 critical findings",
+		},
+	}, runTaskResults(stage))
+}
+
+func TestPolicyOutcomes(t *testing.T) {
+	outcome := &tfc.PolicySetOutcome{
+		PolicySetName: "baseline",
+		Outcomes: []tfc.Outcome{
+			{PolicyName: "tags", Status: "passed", EnforcementLevel: tfc.EnforcementAdvisory},
+			{PolicyName: "regions", Status: "failed", EnforcementLevel: tfc.EnforcementMandatory},
+		},
+	}
+
+	assert.Equal(t, []appv1alpha2.PolicyOutcomeStatus{
+		{Stage: "post_plan", Kind: "opa", PolicySet: "baseline", Policy: "tags", Result: "passed", EnforcementLevel: "advisory"},
+		{Stage: "post_plan", Kind: "opa", PolicySet: "baseline", Policy: "regions", Result: "failed", EnforcementLevel: "mandatory"},
+	}, policyOutcomes(tfc.PostPlan, tfc.OPA, outcome))
+}
+
+func TestPolicyCheckStatus(t *testing.T) {
+	pc := &tfc.PolicyCheck{
+		ID:     "polchk-1",
+		Status: tfc.PolicySoftFailed,
+		Result: &tfc.PolicyResult{
+			Passed:     3,
+			SoftFailed: 1,
+		},
+	}
+
+	assert.Equal(t, appv1alpha2.PolicyCheckStatus{
+		ID:         "polchk-1",
+		Status:     "soft_failed",
+		Passed:     3,
+		SoftFailed: 1,
+	}, policyCheckStatus(pc))
+	assert.Equal(t, appv1alpha2.PolicyCheckStatus{ID: "polchk-2", Status: "pending"}, policyCheckStatus(&tfc.PolicyCheck{ID: "polchk-2", Status: tfc.PolicyPending}))
+}
+
+func TestReconcileRunOverride(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, appv1alpha2.AddToScheme(scheme))
+
+	instance := &appv1alpha2.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "this",
+			Annotations: map[string]string{
+				WorkspaceAnnotationRunOverride:              "run-1",
+				WorkspaceAnnotationRunOverrideJustification: "approved",
+				"owner": "platform",
+			},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).WithStatusSubresource(instance).Build()
+	r := &WorkspaceReconciler{
+		Client:   k8sClient,
+		Recorder: record.NewFakeRecorder(10),
+		Scheme:   scheme,
+	}
+
+	ctx := context.Background()
+	w := &workspaceInstance{log: logr.Discard()}
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(instance), &w.instance))
+	// The status changes made earlier in the reconciliation must be kept.
+	w.instance.Status.Run = &appv1alpha2.RunStatus{ID: "run-2", Status: string(tfc.RunPolicySoftFailed)}
+
+	require.NoError(t, r.reconcileRunOverride(ctx, w))
+	assert.Equal(t, map[string]string{"owner": "platform"}, w.instance.Annotations)
+	assert.Equal(t, "run-2", w.instance.Status.Run.ID)
+	require.NotNil(t, w.instance.Status.RunOverride)
+	assert.Equal(t, "run-1", w.instance.Status.RunOverride.RunID)
+	assert.Equal(t, runOverrideResultRejected, w.instance.Status.RunOverride.Result)
+
+	require.NoError(t, r.Status().Update(ctx, &w.instance))
+
+	got := &appv1alpha2.Workspace{}
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(instance), got))
+	assert.Equal(t, map[string]string{"owner": "platform"}, got.Annotations)
+	assert.Equal(t, "run-2", got.Status.Run.ID)
+	require.NotNil(t, got.Status.RunOverride)
+	assert.Equal(t, runOverrideResultRejected, got.Status.RunOverride.Result)
+}
+
+func TestListRunChecksPagination(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	mockTaskStages := mocks.NewMockTaskStages(mockCtrl)
+	gomock.InOrder(
+		mockTaskStages.EXPECT().
+			List(gomock.Any(), "run-1", &tfc.TaskStageListOptions{ListOptions: tfc.ListOptions{PageSize: MaxPageSize}}).
+			Return(&tfc.TaskStageList{Items: []*tfc.TaskStage{{ID: "ts-1"}}, Pagination: &tfc.Pagination{NextPage: 2}}, nil),
+		mockTaskStages.EXPECT().
+			List(gomock.Any(), "run-1", &tfc.TaskStageListOptions{ListOptions: tfc.ListOptions{PageSize: MaxPageSize, PageNumber: 2}}).
+			Return(&tfc.TaskStageList{Items: []*tfc.TaskStage{{ID: "ts-2"}}, Pagination: &tfc.Pagination{}}, nil),
+	)
+	mockTaskStages.EXPECT().
+		Read(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, id string, _ *tfc.TaskStageReadOptions) (*tfc.TaskStage, error) {
+			return &tfc.TaskStage{ID: id}, nil
+		}).
+		Times(2)
+
+	mockPolicySetOutcomes := mocks.NewMockPolicySetOutcomes(mockCtrl)
+	gomock.InOrder(
+		mockPolicySetOutcomes.EXPECT().
+			List(gomock.Any(), "poleval-1", &tfc.PolicySetOutcomeListOptions{ListOptions: &tfc.ListOptions{PageSize: MaxPageSize}}).
+			Return(&tfc.PolicySetOutcomeList{Items: []*tfc.PolicySetOutcome{{ID: "psout-1"}}, Pagination: &tfc.Pagination{NextPage: 2}}, nil),
+		mockPolicySetOutcomes.EXPECT().
+			List(gomock.Any(), "poleval-1", &tfc.PolicySetOutcomeListOptions{ListOptions: &tfc.ListOptions{PageSize: MaxPageSize, PageNumber: 2}}).
+			Return(&tfc.PolicySetOutcomeList{Items: []*tfc.PolicySetOutcome{{ID: "psout-2"}}, Pagination: &tfc.Pagination{}}, nil),
+	)
+
+	mockPolicyChecks := mocks.NewMockPolicyChecks(mockCtrl)
+	gomock.InOrder(
+		mockPolicyChecks.EXPECT().
+			List(gomock.Any(), "run-1", &tfc.PolicyCheckListOptions{ListOptions: tfc.ListOptions{PageSize: MaxPageSize}}).
+			Return(&tfc.PolicyCheckList{Items: []*tfc.PolicyCheck{{ID: "polchk-1"}}, Pagination: &tfc.Pagination{NextPage: 2}}, nil),
+		mockPolicyChecks.EXPECT().
+			List(gomock.Any(), "run-1", &tfc.PolicyCheckListOptions{ListOptions: tfc.ListOptions{PageSize: MaxPageSize, PageNumber: 2}}).
+			Return(&tfc.PolicyCheckList{Items: []*tfc.PolicyCheck{{ID: "polchk-2"}}, Pagination: &tfc.Pagination{}}, nil),
+	)
+
+	c := &tfc.Client{
+		TaskStages:        mockTaskStages,
+		PolicySetOutcomes: mockPolicySetOutcomes,
+		PolicyChecks:      mockPolicyChecks,
+	}
+
+	stages, err := listTaskStages(ctx, c, "run-1")
+	require.NoError(t, err)
+	assert.Equal(t, []*tfc.TaskStage{{ID: "ts-1"}, {ID: "ts-2"}}, stages)
+
+	outcomes, err := listPolicySetOutcomes(ctx, c, "poleval-1")
+	require.NoError(t, err)
+	assert.Equal(t, []*tfc.PolicySetOutcome{{ID: "psout-1"}, {ID: "psout-2"}}, outcomes)
+
+	policyChecks, err := listPolicyChecks(ctx, c, "run-1")
+	require.NoError(t, err)
+	assert.Equal(t, []*tfc.PolicyCheck{{ID: "polchk-1"}, {ID: "polchk-2"}}, policyChecks)
+}
@@ -48,13 +48,34 @@ func (r *WorkspaceReconciler) reconcileRuns(ctx context.Context, w *workspaceIns
 		return err
 	}
 
+	if err := r.reconcileRunOverride(ctx, w); err != nil {
+		return err
+	}
+
 	if err := r.reconcilePlanRun(ctx, w); err != nil {
 		return err
 	}
 
+	r.reconcileRunHistory(ctx, w, workspace)
+
 	return nil
 }
 
+// reconcileRunHistory records the most recent runs of the workspace.
+// Failures are logged and do not block the reconciliation, the previously recorded history is kept.
+func (r *WorkspaceReconciler) reconcileRunHistory(ctx context.Context, w *workspaceInstance, workspace *tfc.Workspace) {
+	limit := runHistoryLimit(w.instance.Spec.RunHistoryLimit)
+	history, err := listRunHistory(ctx, w.tfClient.Client, workspace.ID, limit, w.instance.Status.RunHistory)
+	if err != nil {
+		w.log.Error(err, "Reconcile Runs", "msg", "failed to get the run history")
+		return
+	}
+	w.instance.Status.RunHistory = history
+	if history != nil {
+		w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("successfully got the run history of %d runs", len(history.Runs)))
+	}
+}
+
 func (r *WorkspaceReconciler) reconcileCurrentRun(ctx context.Context, w *workspaceInstance, workspace *tfc.Workspace) error {
 	if workspace.CurrentRun == nil {
 		w.log.Info("Reconcile Runs", "msg", "there are no ongoing non-speculative runs")
@@ -69,13 +90,14 @@ func (r *WorkspaceReconciler) reconcileCurrentRun(ctx context.Context, w *worksp
 	}
 
 	w.log.Info("Reconcile Runs", "msg", "get the ongoing non-speculative run status")
-	run, err := w.tfClient.Client.Runs.Read(ctx, workspace.CurrentRun.ID)
+	run, err := readRun(ctx, w.tfClient.Client, workspace.CurrentRun.ID)
 	if err != nil {
 		w.log.Error(err, "Reconcile Runs", "msg", "failed to get the ongoing non-speculative run status")
 		return err
 	}
 	w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("successfully got the ongoing non-speculative run status %s", run.Status))
 
+	r.queueCurrentRunEvent(w, run)
 	if w.instance.Status.Run == nil {
 		w.instance.Status.Run = &appv1alpha2.RunStatus{}
 	}
@@ -84,7 +106,9 @@ func (r *WorkspaceReconciler) reconcileCurrentRun(ctx context.Context, w *worksp
 	w.instance.Status.Run.Status = string(run.Status)
 	w.instance.Status.Run.ConfigurationVersion = run.ConfigurationVersion.ID
 
-	return nil
+	r.reconcileRunChecks(ctx, w, run)
+
+	return r.reconcileCostEstimation(ctx, w, run)
 }
 
 func (r *WorkspaceReconciler) reconcilePlanRun(ctx context.Context, w *workspaceInstance) error {
@@ -95,12 +119,13 @@ func (r *WorkspaceReconciler) reconcilePlanRun(ctx context.Context, w *workspace
 
 	if !w.instance.Status.Plan.RunCompleted() {
 		w.log.Info("Reconcile Runs", "msg", "get the speculative run status")
-		run, err := w.tfClient.Client.Runs.Read(ctx, w.instance.Status.Plan.ID)
+		run, err := readRun(ctx, w.tfClient.Client, w.instance.Status.Plan.ID)
 		if err != nil {
 			w.log.Error(err, "Reconcile Runs", "msg", "failed to get the speculative run status")
 			return err
 		}
 		w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("successfully got the speculative run status %s", run.Status))
+		r.queueRunEvent(w, run, w.instance.Status.Plan.ID, w.instance.Status.Plan.Status)
 		w.instance.Status.Plan.ID = run.ID
 		w.instance.Status.Plan.Status = string(run.Status)
 	}
@@ -127,6 +152,7 @@ func (r *WorkspaceReconciler) triggerApplyRun(ctx context.Context, w *workspaceI
 	}
 
 	// Update status
+	r.queueCurrentRunEvent(w, run)
 	if w.instance.Status.Run == nil {
 		w.instance.Status.Run = &appv1alpha2.RunStatus{}
 	}
@@ -155,6 +181,7 @@ func (r *WorkspaceReconciler) triggerPlanRun(ctx context.Context, w *workspaceIn
 	}
 
 	// Update status
+	r.queueRunEvent(w, run, "", "")
 	w.instance.Status.Plan = &appv1alpha2.PlanStatus{
 		ID:               run.ID,
 		Status:           string(run.Status),
@@ -163,3 +190,44 @@ func (r *WorkspaceReconciler) triggerPlanRun(ctx context.Context, w *workspaceIn
 
 	return nil
 }
+
+// queueRunEvent queues the transition of the run status, if the run is the same as the one previously recorded in the status.
+// Queued events are published once the status is updated.
+func (r *WorkspaceReconciler) queueRunEvent(w *workspaceInstance, run *tfc.Run, previousID, previousStatus string) {
+	if run.ID != previousID {
+		previousStatus = ""
+	}
+	ws := runEventWorkspace{
+		organization: w.instance.Spec.Organization,
+		id:           w.instance.Status.WorkspaceID,
+		name:         w.instance.Spec.Name,
+	}
+	if e := newRunEvent("Workspace", &w.instance, ws, run, previousStatus); e != nil {
+		w.runEvents = append(w.runEvents, e)
+	}
+}
+
+// queueCurrentRunEvent queues the transition of the status of the non-speculative run.
+func (r *WorkspaceReconciler) queueCurrentRunEvent(w *workspaceInstance, run *tfc.Run) {
+	if w.instance.Status.Run == nil {
+		r.queueRunEvent(w, run, "", "")
+		return
+	}
+	r.queueRunEvent(w, run, w.instance.Status.Run.ID, w.instance.Status.Run.Status)
+}
+
+// updateStatusRunEvents updates the status and publishes the queued run events once the status is stored.
+func (r *WorkspaceReconciler) updateStatusRunEvents(ctx context.Context, w *workspaceInstance) error {
+	if err := r.Status().Update(ctx, &w.instance); err != nil {
+		return err
+	}
+	r.publishRunEvents(w)
+
+	return nil
+}
+
+// publishRunEvents publishes and clears the queued run events.
+func (r *WorkspaceReconciler) publishRunEvents(w *workspaceInstance) {
+	publishRunEvents(r.Recorder, w.log, &w.instance, w.runEvents...)
+	w.runEvents = nil
+}
@@ -9,13 +9,60 @@ import (
 	"fmt"
 
 	tfc "github.com/hashicorp/go-tfe"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 )
 
-func (w *workspaceInstance) getSSHKeyID(ctx context.Context) (string, error) {
+// sshKeyToWorkspaces maps an OrganizationSSHKey object to the Workspaces that reference it via `spec.sshKey.objectRef`.
+// This way, a rotated SSH key is assigned to the Workspaces without waiting for their next periodic reconciliation.
+func (r *WorkspaceReconciler) sshKeyToWorkspaces(ctx context.Context, o client.Object) []reconcile.Request {
+	wl := &appv1alpha2.WorkspaceList{}
+	if err := r.Client.List(ctx, wl, client.InNamespace(o.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, w := range wl.Items {
+		if !referencesSSHKey(&w, o.GetName()) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: w.Namespace, Name: w.Name},
+		})
+	}
+
+	return requests
+}
+
+func (r *WorkspaceReconciler) getSSHKeyIDByObjectRef(ctx context.Context, w *workspaceInstance) (string, error) {
+	nn := types.NamespacedName{
+		Namespace: w.instance.Namespace,
+		Name:      w.instance.Spec.SSHKey.ObjectRef.Name,
+	}
+	sshKey := &appv1alpha2.OrganizationSSHKey{}
+	if err := r.Client.Get(ctx, nn, sshKey); err != nil {
+		return "", err
+	}
+	if sshKey.Status.ID == "" {
+		return "", fmt.Errorf("ssh key ID is not yet available in the status of OrganizationSSHKey object %q", nn.Name)
+	}
+
+	return sshKey.Status.ID, nil
+}
+
+func (r *WorkspaceReconciler) getSSHKeyID(ctx context.Context, w *workspaceInstance) (string, error) {
 	if w.instance.Spec.SSHKey == nil {
 		return "", errors.New("instance spec.SSHKey is nil")
 	}
 
+	if w.instance.Spec.SSHKey.ObjectRef != nil {
+		w.log.Info("Reconcile SSH Key", "msg", "getting SSH key ID from the OrganizationSSHKey object")
+		return r.getSSHKeyIDByObjectRef(ctx, w)
+	}
+
 	if w.instance.Spec.SSHKey.Name != "" {
 		w.log.Info("Reconcile SSH Key", "msg", "getting SSH key ID by name")
 		listOpts := &tfc.SSHKeyListOptions{
@@ -48,7 +95,7 @@ func (w *workspaceInstance) getSSHKeyID(ctx context.Context) (string, error) {
 	return w.instance.Spec.SSHKey.ID, nil
 }
 
-func (w *workspaceInstance) reconcileSSHKey(ctx context.Context, workspace *tfc.Workspace) error {
+func (r *WorkspaceReconciler) reconcileSSHKey(ctx context.Context, w *workspaceInstance, workspace *tfc.Workspace) error {
 	w.log.Info("Reconcile SSH Key", "msg", "new reconciliation event")
 
 	if w.instance.Spec.SSHKey == nil && workspace.SSHKey != nil {
@@ -60,8 +107,16 @@ func (w *workspaceInstance) reconcileSSHKey(ctx context.Context, workspace *tfc.
 	}
 
 	if w.instance.Spec.SSHKey != nil {
+		// The SSH key ID of a referenced OrganizationSSHKey object changes when the key gets rotated.
+		if w.instance.Spec.SSHKey.ObjectRef != nil {
+			sshKeyID, err := r.getSSHKeyIDByObjectRef(ctx, w)
+			if err != nil {
+				return err
+			}
+			w.instance.Status.SSHKeyID = sshKeyID
+		}
 		if workspace.SSHKey == nil || workspace.SSHKey.ID != w.instance.Status.SSHKeyID {
-			sshKeyID, err := w.getSSHKeyID(ctx)
+			sshKeyID, err := r.getSSHKeyID(ctx, w)
 			if err != nil {
 				return err
 			}
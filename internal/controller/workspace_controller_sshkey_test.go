@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestSSHKeyToWorkspaces(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, appv1alpha2.AddToScheme(scheme))
+
+	workspace := func(namespace, name string, sshKey *appv1alpha2.SSHKey) client.Object {
+		return &appv1alpha2.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       appv1alpha2.WorkspaceSpec{SSHKey: sshKey},
+		}
+	}
+	objectRef := func(name string) *appv1alpha2.SSHKey {
+		return &appv1alpha2.SSHKey{ObjectRef: &corev1.LocalObjectReference{Name: name}}
+	}
+
+	r := &WorkspaceReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			workspace("default", "referenced", objectRef("this")),
+			workspace("default", "other-object", objectRef("other")),
+			workspace("default", "by-name", &appv1alpha2.SSHKey{Name: "this"}),
+			workspace("default", "no-ssh-key", nil),
+			workspace("other", "other-namespace", objectRef("this")),
+		).Build(),
+	}
+
+	sshKey := &appv1alpha2.OrganizationSSHKey{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "this"}}
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "referenced"}},
+	}, r.sshKeyToWorkspaces(context.Background(), sshKey))
+}
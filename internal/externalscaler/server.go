@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package externalscaler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+const (
+	// MetricName is the name of the metric the scaler reports to KEDA.
+	MetricName = "pendingRuns"
+	// metadataAgentPool is the scaler metadata key of the AgentPool object name.
+	// If not set, the ScaledObject name is used.
+	metadataAgentPool = "agentPool"
+	// metadataTargetPendingRuns is the scaler metadata key of the number of pending runs per agent replica.
+	// If not set, defaults to 1.
+	metadataTargetPendingRuns = "targetPendingRuns"
+	defaultTargetPendingRuns  = 1
+	// streamInterval is the frequency at which StreamIsActive re-evaluates the AgentPool object.
+	streamInterval = 15 * time.Second
+)
+
+// Server implements the KEDA external scaler gRPC service.
+// It reports the number of pending runs of the AgentPool objects from their status,
+// which is updated by the AgentPool controller when `spec.externalAutoscaling` is set.
+// More information:
+//   - https://keda.sh/docs/latest/concepts/external-scalers/
+type Server struct {
+	UnimplementedExternalScalerServer
+
+	// Reader reads AgentPool objects, usually the manager cache.
+	Reader client.Reader
+	// BindAddress is the TCP address the gRPC server listens on.
+	BindAddress string
+	Log         logr.Logger
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// All operator replicas serve the scaler, since it only reads the AgentPool objects.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable. It serves gRPC requests until the context is done.
+func (s *Server) Start(ctx context.Context) error {
+	l, err := net.Listen("tcp", s.BindAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.BindAddress, err)
+	}
+
+	gs := grpc.NewServer()
+	RegisterExternalScalerServer(gs, s)
+
+	go func() {
+		<-ctx.Done()
+		gs.GracefulStop()
+	}()
+
+	s.Log.Info("External Scaler", "msg", fmt.Sprintf("serving on %s", s.BindAddress))
+
+	return gs.Serve(l)
+}
+
+// pendingRuns returns the number of pending runs of the AgentPool object referred by the ScaledObject.
+func (s *Server) pendingRuns(ctx context.Context, ref *ScaledObjectRef) (int64, error) {
+	if ref == nil {
+		return 0, status.Error(codes.InvalidArgument, "scaled object reference must be set")
+	}
+
+	name := ref.GetScalerMetadata()[metadataAgentPool]
+	if name == "" {
+		name = ref.GetName()
+	}
+
+	ap := &appv1alpha2.AgentPool{}
+	err := s.Reader.Get(ctx, types.NamespacedName{Namespace: ref.GetNamespace(), Name: name}, ap)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return 0, status.Errorf(codes.NotFound, "agent pool %s/%s not found", ref.GetNamespace(), name)
+		}
+		return 0, status.Errorf(codes.Internal, "failed to get agent pool %s/%s: %s", ref.GetNamespace(), name, err)
+	}
+
+	if ap.Spec.ExternalAutoscaling == nil {
+		return 0, status.Errorf(codes.FailedPrecondition, "agent pool %s/%s does not have external autoscaling configured", ref.GetNamespace(), name)
+	}
+
+	if ap.Status.ExternalAutoscaling == nil {
+		return 0, nil
+	}
+
+	return int64(ap.Status.ExternalAutoscaling.PendingRuns), nil
+}
+
+// targetPendingRuns returns the number of pending runs per agent replica from the scaler metadata.
+func targetPendingRuns(ref *ScaledObjectRef) (int64, error) {
+	v, ok := ref.GetScalerMetadata()[metadataTargetPendingRuns]
+	if !ok {
+		return defaultTargetPendingRuns, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 1 {
+		return 0, status.Errorf(codes.InvalidArgument, "%s must be a positive integer, got %q", metadataTargetPendingRuns, v)
+	}
+	return n, nil
+}
+
+// IsActive reports whether the AgentPool has pending runs.
+func (s *Server) IsActive(ctx context.Context, ref *ScaledObjectRef) (*IsActiveResponse, error) {
+	n, err := s.pendingRuns(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IsActiveResponse{Result: n > 0}, nil
+}
+
+// StreamIsActive periodically reports whether the AgentPool has pending runs until the stream is closed.
+func (s *Server) StreamIsActive(ref *ScaledObjectRef, stream grpc.ServerStreamingServer[IsActiveResponse]) error {
+	ticker := time.NewTicker(streamInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+			r, err := s.IsActive(stream.Context(), ref)
+			if err != nil {
+				s.Log.Error(err, "External Scaler", "msg", fmt.Sprintf("failed to check if scaled object %s/%s is active", ref.GetNamespace(), ref.GetName()))
+				continue
+			}
+			if err := stream.Send(r); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetMetricSpec returns the target number of pending runs per agent replica.
+func (s *Server) GetMetricSpec(_ context.Context, ref *ScaledObjectRef) (*GetMetricSpecResponse, error) {
+	t, err := targetPendingRuns(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetMetricSpecResponse{
+		MetricSpecs: []*MetricSpec{
+			{
+				MetricName: MetricName,
+				TargetSize: t,
+			},
+		},
+	}, nil
+}
+
+// GetMetrics returns the number of pending runs of the AgentPool.
+func (s *Server) GetMetrics(ctx context.Context, req *GetMetricsRequest) (*GetMetricsResponse, error) {
+	n, err := s.pendingRuns(ctx, req.GetScaledObjectRef())
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetMetricsResponse{
+		MetricValues: []*MetricValue{
+			{
+				MetricName:  MetricName,
+				MetricValue: n,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package externalscaler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, appv1alpha2.AddToScheme(scheme))
+
+	return &Server{
+		Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&appv1alpha2.AgentPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "this", Namespace: "default"},
+				Spec: appv1alpha2.AgentPoolSpec{
+					AgentDeployment:     &appv1alpha2.AgentDeployment{},
+					ExternalAutoscaling: &appv1alpha2.AgentExternalAutoscaling{},
+				},
+				Status: appv1alpha2.AgentPoolStatus{
+					ExternalAutoscaling: &appv1alpha2.AgentExternalAutoscalingStatus{PendingRuns: 3},
+				},
+			},
+			&appv1alpha2.AgentPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "idle", Namespace: "default"},
+				Spec: appv1alpha2.AgentPoolSpec{
+					AgentDeployment:     &appv1alpha2.AgentDeployment{},
+					ExternalAutoscaling: &appv1alpha2.AgentExternalAutoscaling{},
+				},
+			},
+			&appv1alpha2.AgentPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "internal", Namespace: "default"},
+				Spec: appv1alpha2.AgentPoolSpec{
+					AgentDeployment: &appv1alpha2.AgentDeployment{},
+				},
+			},
+		).Build(),
+	}
+}
+
+func TestServerGetMetrics(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer(t)
+
+	successCases := map[string]struct {
+		ref      *ScaledObjectRef
+		expected int64
+	}{
+		"ScaledObjectName": {
+			ref:      &ScaledObjectRef{Name: "this", Namespace: "default"},
+			expected: 3,
+		},
+		"MetadataAgentPool": {
+			ref:      &ScaledObjectRef{Name: "agents", Namespace: "default", ScalerMetadata: map[string]string{metadataAgentPool: "this"}},
+			expected: 3,
+		},
+		"NoStatus": {
+			ref:      &ScaledObjectRef{Name: "idle", Namespace: "default"},
+			expected: 0,
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			r, err := s.GetMetrics(context.Background(), &GetMetricsRequest{ScaledObjectRef: c.ref, MetricName: MetricName})
+			assert.NoError(t, err)
+			assert.Len(t, r.GetMetricValues(), 1)
+			assert.Equal(t, MetricName, r.GetMetricValues()[0].GetMetricName())
+			assert.Equal(t, c.expected, r.GetMetricValues()[0].GetMetricValue())
+
+			a, err := s.IsActive(context.Background(), c.ref)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected > 0, a.GetResult())
+		})
+	}
+
+	errorCases := map[string]struct {
+		ref  *ScaledObjectRef
+		code codes.Code
+	}{
+		"NotFound": {
+			ref:  &ScaledObjectRef{Name: "this", Namespace: "other"},
+			code: codes.NotFound,
+		},
+		"NoExternalAutoscaling": {
+			ref:  &ScaledObjectRef{Name: "internal", Namespace: "default"},
+			code: codes.FailedPrecondition,
+		},
+		"NoRef": {
+			ref:  nil,
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			_, err := s.GetMetrics(context.Background(), &GetMetricsRequest{ScaledObjectRef: c.ref, MetricName: MetricName})
+			assert.Equal(t, c.code, status.Code(err))
+		})
+	}
+}
+
+func TestServerGetMetricSpec(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer(t)
+
+	successCases := map[string]struct {
+		metadata map[string]string
+		expected int64
+	}{
+		"Default": {
+			metadata: nil,
+			expected: defaultTargetPendingRuns,
+		},
+		"TargetPendingRuns": {
+			metadata: map[string]string{metadataTargetPendingRuns: "2"},
+			expected: 2,
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			r, err := s.GetMetricSpec(context.Background(), &ScaledObjectRef{Name: "this", Namespace: "default", ScalerMetadata: c.metadata})
+			assert.NoError(t, err)
+			assert.Len(t, r.GetMetricSpecs(), 1)
+			assert.Equal(t, MetricName, r.GetMetricSpecs()[0].GetMetricName())
+			assert.Equal(t, c.expected, r.GetMetricSpecs()[0].GetTargetSize())
+		})
+	}
+
+	errorCases := map[string]map[string]string{
+		"NotNumber": {metadataTargetPendingRuns: "two"},
+		"Zero":      {metadataTargetPendingRuns: "0"},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			_, err := s.GetMetricSpec(context.Background(), &ScaledObjectRef{Name: "this", Namespace: "default", ScalerMetadata: c})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
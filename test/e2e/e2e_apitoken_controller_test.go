@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+var _ = Describe("API Token controller", Ordered, func() {
+	var (
+		instance       *appv1alpha2.APIToken
+		namespacedName types.NamespacedName
+		team           *tfc.Team
+	)
+
+	BeforeAll(func() {
+		// Set default Eventually timers
+		SetDefaultEventuallyTimeout(syncPeriod * 4)
+		SetDefaultEventuallyPollingInterval(2 * time.Second)
+	})
+
+	BeforeEach(func() {
+		namespacedName = newNamespacedName()
+		team = createTeam(fmt.Sprintf("kubernetes-operator-team-%v", randomNumber()))
+		// Create a new API token object for each test
+		instance = &appv1alpha2.APIToken{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "app.terraform.io/v1alpha2",
+				Kind:       "APIToken",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              namespacedName.Name,
+				Namespace:         namespacedName.Namespace,
+				DeletionTimestamp: nil,
+				Finalizers:        []string{},
+			},
+			Spec: appv1alpha2.APITokenSpec{
+				Organization: organization,
+				Token: appv1alpha2.Token{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretNamespacedName.Name,
+						},
+						Key: secretKey,
+					},
+				},
+				Team: &appv1alpha2.Team{
+					Name: team.Name,
+				},
+				SecretName:     namespacedName.Name,
+				DeletionPolicy: appv1alpha2.APITokenDeletionPolicyDestroy,
+			},
+			Status: appv1alpha2.APITokenStatus{},
+		}
+	})
+
+	AfterEach(func() {
+		// Delete the Kubernetes API token object
+		Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, namespacedName, instance)
+			// The Kubernetes client will return error 'NotFound' on the Get operation once the object is deleted
+			return kerrors.IsNotFound(err)
+		}).Should(BeTrue())
+		Expect(tfClient.Teams.Delete(ctx, team.ID)).Should(Succeed())
+	})
+
+	Context("API Token controller", func() {
+		It("can issue a team API token", func() {
+			// Create a new Kubernetes API token object and wait until the controller finishes the reconciliation
+			createAPITokenResource(instance)
+
+			Expect(instance.Status.TeamID).Should(Equal(team.ID))
+			tt, err := tfClient.TeamTokens.ReadByID(ctx, instance.Status.ID)
+			Expect(err).Should(Succeed())
+			Expect(tt).ShouldNot(BeNil())
+			validateAPITokenSecret(instance)
+		})
+		It("can rotate a team API token", func() {
+			// Make the API token fall into the renewal window right away
+			instance.Spec.Rotation = appv1alpha2.APITokenRotation{
+				ExpirationPeriodSeconds: pointer.PointerOf(int32(3600)),
+				RenewBeforeSeconds:      pointer.PointerOf(int32(3599)),
+				OverlapSeconds:          pointer.PointerOf(int32(0)),
+			}
+			// Create a new Kubernetes API token object and wait until the controller finishes the reconciliation
+			createAPITokenResource(instance)
+			firstID := instance.Status.ID
+
+			// Wait until the controller rotates the API token and revokes the previous one
+			Eventually(func() bool {
+				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+				return instance.Status.ID != firstID && instance.Status.RotatedAt != nil
+			}).Should(BeTrue())
+			Eventually(func() bool {
+				_, err := tfClient.TeamTokens.ReadByID(ctx, firstID)
+				return err == tfc.ErrResourceNotFound
+			}).Should(BeTrue())
+			validateAPITokenSecret(instance)
+		})
+		It("can restore a team API token", func() {
+			// Create a new Kubernetes API token object and wait until the controller finishes the reconciliation
+			createAPITokenResource(instance)
+			firstID := instance.Status.ID
+
+			// Revoke the API token outside of the controller
+			Expect(tfClient.TeamTokens.DeleteByID(ctx, firstID)).Should(Succeed())
+
+			// Wait until the controller issues a new API token
+			Eventually(func() bool {
+				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+				return instance.Status.ID != "" && instance.Status.ID != firstID
+			}).Should(BeTrue())
+			validateAPITokenSecret(instance)
+		})
+	})
+})
+
+func createAPITokenResource(instance *appv1alpha2.APIToken) {
+	namespacedName := getNamespacedName(instance)
+
+	// Create a new Kubernetes API token object
+	Expect(k8sClient.Create(ctx, instance)).Should(Succeed())
+	// Wait until the controller finishes the reconciliation
+	Eventually(func() bool {
+		Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+		return instance.Status.ObservedGeneration == instance.Generation
+	}).Should(BeTrue())
+
+	// The Kubernetes API token object should have Status.ID with the valid token ID
+	Expect(instance.Status.ID).Should(HavePrefix("at-"))
+}
+
+func validateAPITokenSecret(instance *appv1alpha2.APIToken) {
+	s := &corev1.Secret{}
+	Eventually(func() bool {
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.SecretName}, s)).Should(Succeed())
+		_, ok := s.Data[instance.Spec.SecretKey]
+		return ok && s.Annotations["app.terraform.io/token-id"] == instance.Status.ID
+	}).Should(BeTrue())
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+var _ = Describe("No-Code Workspace controller", Ordered, func() {
+	var (
+		instance       *appv1alpha2.NoCodeWorkspace
+		namespacedName types.NamespacedName
+		registryModule *appv1alpha2.RegistryModule
+		moduleCode     *corev1.ConfigMap
+		moduleName     string
+		workspace      string
+	)
+
+	BeforeAll(func() {
+		// Set default Eventually timers
+		SetDefaultEventuallyTimeout(syncPeriod * 4)
+		SetDefaultEventuallyPollingInterval(2 * time.Second)
+	})
+
+	BeforeEach(func() {
+		namespacedName = newNamespacedName()
+		moduleName = fmt.Sprintf("kubernetes-operator-%v", randomNumber())
+		workspace = fmt.Sprintf("kubernetes-operator-%v", randomNumber())
+		// Create a new no-code registry module for each test
+		moduleCode = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-code", namespacedName.Name),
+				Namespace: namespacedName.Namespace,
+			},
+			Data: map[string]string{
+				"main.tf":      "resource \"random_pet\" \"this\" {\n  prefix = var.prefix\n}\n",
+				"variables.tf": "variable \"prefix\" {\n  type = string\n}\n",
+				"outputs.tf":   "output \"name\" {\n  value = random_pet.this.id\n}\n",
+			},
+		}
+		Expect(k8sClient.Create(ctx, moduleCode)).Should(Succeed())
+		registryModule = &appv1alpha2.RegistryModule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-module", namespacedName.Name),
+				Namespace: namespacedName.Namespace,
+			},
+			Spec: appv1alpha2.RegistryModuleSpec{
+				Organization: organization,
+				Token: appv1alpha2.Token{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretNamespacedName.Name,
+						},
+						Key: secretKey,
+					},
+				},
+				Name:     moduleName,
+				Provider: "random",
+				NoCode:   true,
+				Versions: []appv1alpha2.RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+						Source: appv1alpha2.RegistryModuleVersionSource{
+							ConfigMapRef: &corev1.LocalObjectReference{
+								Name: moduleCode.Name,
+							},
+						},
+					},
+				},
+				DeletionPolicy: appv1alpha2.RegistryModuleDeletionPolicyDestroy,
+			},
+		}
+		createRegistryModuleResource(registryModule)
+		Eventually(func() bool {
+			Expect(k8sClient.Get(ctx, getNamespacedName(registryModule), registryModule)).Should(Succeed())
+			for _, v := range registryModule.Status.Versions {
+				if v.Version == "1.0.0" && v.Status == string(tfc.RegistryModuleVersionStatusOk) {
+					return true
+				}
+			}
+			return false
+		}).Should(BeTrue())
+		// Create a new no-code workspace object for each test
+		instance = &appv1alpha2.NoCodeWorkspace{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "app.terraform.io/v1alpha2",
+				Kind:       "NoCodeWorkspace",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              namespacedName.Name,
+				Namespace:         namespacedName.Namespace,
+				DeletionTimestamp: nil,
+				Finalizers:        []string{},
+			},
+			Spec: appv1alpha2.NoCodeWorkspaceSpec{
+				Organization: organization,
+				Token: appv1alpha2.Token{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretNamespacedName.Name,
+						},
+						Key: secretKey,
+					},
+				},
+				Module: appv1alpha2.NoCodeModuleRef{
+					RegistryModule: &appv1alpha2.NoCodeRegistryModule{
+						Name:     moduleName,
+						Provider: "random",
+					},
+				},
+				Version:     "1.0.0",
+				Name:        workspace,
+				ApplyMethod: "auto",
+				TerraformVariables: []appv1alpha2.Variable{
+					{
+						Name:  "prefix",
+						Value: "kubernetes-operator",
+					},
+				},
+				Outputs:        true,
+				DeletionPolicy: appv1alpha2.NoCodeWorkspaceDeletionPolicyForce,
+			},
+			Status: appv1alpha2.NoCodeWorkspaceStatus{},
+		}
+	})
+
+	AfterEach(func() {
+		// Delete the Kubernetes no-code workspace object
+		Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, namespacedName, instance)
+			// The Kubernetes client will return error 'NotFound' on the Get operation once the object is deleted
+			return kerrors.IsNotFound(err)
+		}).Should(BeTrue())
+
+		// Make sure that the HCP Terraform workspace is deleted
+		Eventually(func() bool {
+			_, err := tfClient.Workspaces.Read(ctx, organization, workspace)
+			// The HCP Terraform client will return the error 'ResourceNotFound' once the workspace does not exist
+			return err == tfc.ErrResourceNotFound
+		}).Should(BeTrue())
+
+		Expect(k8sClient.Delete(ctx, registryModule)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, getNamespacedName(registryModule), registryModule)
+			return kerrors.IsNotFound(err)
+		}).Should(BeTrue())
+		Expect(k8sClient.Delete(ctx, moduleCode)).Should(Succeed())
+	})
+
+	Context("No-Code Workspace controller", func() {
+		It("can provision a workspace and expose outputs", func() {
+			// Create a new Kubernetes no-code workspace object and wait until the controller finishes the reconciliation
+			createNoCodeWorkspaceResource(instance)
+
+			Expect(instance.Status.NoCodeModuleID).Should(HavePrefix("nocode-"))
+			Expect(instance.Status.Version).Should(Equal("1.0.0"))
+
+			// Wait until the run is applied
+			Eventually(func() bool {
+				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+				return instance.Status.Run != nil && instance.Status.Run.RunApplied()
+			}).Should(BeTrue())
+
+			// Wait until the controller stores the outputs
+			Eventually(func() bool {
+				cm := &corev1.ConfigMap{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: namespacedName.Namespace, Name: fmt.Sprintf("%s-nocode-outputs", namespacedName.Name)}, cm)
+				if err != nil {
+					return false
+				}
+				_, ok := cm.Data["name"]
+				return ok
+			}).Should(BeTrue())
+		})
+	})
+})
+
+func createNoCodeWorkspaceResource(instance *appv1alpha2.NoCodeWorkspace) {
+	namespacedName := getNamespacedName(instance)
+
+	// Create a new Kubernetes no-code workspace object
+	Expect(k8sClient.Create(ctx, instance)).Should(Succeed())
+	// Wait until the controller finishes the reconciliation
+	Eventually(func() bool {
+		Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+		return instance.Status.ObservedGeneration == instance.Generation
+	}).Should(BeTrue())
+
+	// The Kubernetes no-code workspace object should have Status.WorkspaceID with the valid workspace ID
+	Expect(instance.Status.WorkspaceID).Should(HavePrefix("ws-"))
+}
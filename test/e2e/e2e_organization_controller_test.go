@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+var _ = Describe("Organization controller", Ordered, func() {
+	var (
+		instance       *appv1alpha2.Organization
+		namespacedName types.NamespacedName
+		org            *tfc.Organization
+	)
+
+	BeforeAll(func() {
+		// Set default Eventually timers
+		SetDefaultEventuallyTimeout(syncPeriod * 4)
+		SetDefaultEventuallyPollingInterval(2 * time.Second)
+	})
+
+	BeforeEach(func() {
+		namespacedName = newNamespacedName()
+		// Keep the current organization settings to restore them after each test
+		var err error
+		org, err = tfClient.Organizations.Read(ctx, organization)
+		Expect(err).Should(Succeed())
+		Expect(org).ShouldNot(BeNil())
+		// Create a new organization object for each test
+		instance = &appv1alpha2.Organization{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "app.terraform.io/v1alpha2",
+				Kind:       "Organization",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              namespacedName.Name,
+				Namespace:         namespacedName.Namespace,
+				DeletionTimestamp: nil,
+				Finalizers:        []string{},
+			},
+			Spec: appv1alpha2.OrganizationSpec{
+				Name: organization,
+				Token: appv1alpha2.Token{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretNamespacedName.Name,
+						},
+						Key: secretKey,
+					},
+				},
+				CostEstimationEnabled: tfc.Bool(org.CostEstimationEnabled),
+			},
+			Status: appv1alpha2.OrganizationStatus{},
+		}
+	})
+
+	AfterEach(func() {
+		// Delete the Kubernetes organization object
+		Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, namespacedName, instance)
+			// The Kubernetes client will return error 'NotFound' on the Get operation once the object is deleted
+			return kerrors.IsNotFound(err)
+		}).Should(BeTrue())
+
+		// Restore the organization settings
+		_, err := tfClient.Organizations.Update(ctx, organization, tfc.OrganizationUpdateOptions{
+			CostEstimationEnabled: tfc.Bool(org.CostEstimationEnabled),
+		})
+		Expect(err).Should(Succeed())
+	})
+
+	Context("Organization controller", func() {
+		It("can report the default project ID", func() {
+			// Create a new Kubernetes organization object and wait until the controller finishes the reconciliation
+			createOrganizationResource(instance)
+
+			o, err := tfClient.Organizations.ReadWithOptions(ctx, organization, tfc.OrganizationReadOptions{
+				Include: []tfc.OrganizationIncludeOpt{tfc.OrganizationDefaultProject},
+			})
+			Expect(err).Should(Succeed())
+			Expect(o.DefaultProject).ShouldNot(BeNil())
+			Expect(instance.Status.DefaultProjectID).Should(Equal(o.DefaultProject.ID))
+		})
+		It("can revert external changes", func() {
+			// Create a new Kubernetes organization object and wait until the controller finishes the reconciliation
+			createOrganizationResource(instance)
+
+			// Change the HCP Terraform organization cost estimation setting
+			_, err := tfClient.Organizations.Update(ctx, organization, tfc.OrganizationUpdateOptions{
+				CostEstimationEnabled: tfc.Bool(!org.CostEstimationEnabled),
+			})
+			Expect(err).Should(Succeed())
+
+			// Wait until the controller reverts the change and reports the drift
+			Eventually(func() bool {
+				o, err := tfClient.Organizations.Read(ctx, organization)
+				Expect(err).Should(Succeed())
+				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+				return o.CostEstimationEnabled == org.CostEstimationEnabled && instance.Status.DriftDetectedAt != nil
+			}).Should(BeTrue())
+		})
+	})
+})
+
+func createOrganizationResource(instance *appv1alpha2.Organization) {
+	namespacedName := getNamespacedName(instance)
+
+	// Create a new Kubernetes organization object
+	Expect(k8sClient.Create(ctx, instance)).Should(Succeed())
+	// Wait until the controller finishes the reconciliation
+	Eventually(func() bool {
+		Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+		return instance.Status.ObservedGeneration == instance.Generation
+	}).Should(BeTrue())
+
+	// The Kubernetes organization object should have Status.DefaultProjectID with the valid project ID
+	Expect(instance.Status.DefaultProjectID).Should(HavePrefix("prj-"))
+}
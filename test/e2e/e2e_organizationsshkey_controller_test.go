@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+var _ = Describe("SSH Key controller", Ordered, func() {
+	var (
+		instance       *appv1alpha2.OrganizationSSHKey
+		namespacedName types.NamespacedName
+		sshKeyName     string
+		keySecret      *corev1.Secret
+	)
+
+	BeforeAll(func() {
+		// Set default Eventually timers
+		SetDefaultEventuallyTimeout(syncPeriod * 4)
+		SetDefaultEventuallyPollingInterval(2 * time.Second)
+	})
+
+	BeforeEach(func() {
+		namespacedName = newNamespacedName()
+		sshKeyName = fmt.Sprintf("kubernetes-operator-sshkey-%v", randomNumber())
+		// Create a new secret with an SSH private key for each test
+		keySecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-key", namespacedName.Name),
+				Namespace: namespacedName.Namespace,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				"id_rsa": generateSSHPrivateKey(),
+			},
+		}
+		Expect(k8sClient.Create(ctx, keySecret)).Should(Succeed())
+		// Create a new SSH key object for each test
+		instance = &appv1alpha2.OrganizationSSHKey{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "app.terraform.io/v1alpha2",
+				Kind:       "OrganizationSSHKey",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              namespacedName.Name,
+				Namespace:         namespacedName.Namespace,
+				DeletionTimestamp: nil,
+				Finalizers:        []string{},
+			},
+			Spec: appv1alpha2.OrganizationSSHKeySpec{
+				Organization: organization,
+				Token: appv1alpha2.Token{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretNamespacedName.Name,
+						},
+						Key: secretKey,
+					},
+				},
+				Name: sshKeyName,
+				PrivateKey: appv1alpha2.OrganizationSSHKeyPrivateKey{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: keySecret.Name,
+						},
+						Key: "id_rsa",
+					},
+				},
+				DeletionPolicy: appv1alpha2.OrganizationSSHKeyDeletionPolicyDestroy,
+			},
+			Status: appv1alpha2.OrganizationSSHKeyStatus{},
+		}
+	})
+
+	AfterEach(func() {
+		// Delete the Kubernetes SSH key object and wait until the controller finishes the reconciliation after deletion of the object
+		Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, namespacedName, instance)
+			// The Kubernetes client will return error 'NotFound' on the Get operation once the object is deleted
+			return kerrors.IsNotFound(err)
+		}).Should(BeTrue())
+
+		// Make sure that the HCP Terraform SSH key is deleted
+		Eventually(func() bool {
+			err := tfClient.SSHKeys.Delete(ctx, instance.Status.ID)
+			// The HCP Terraform client will return the error 'ResourceNotFound' once the SSH key does not exist
+			return err == tfc.ErrResourceNotFound || err == nil
+		}).Should(BeTrue())
+
+		Expect(k8sClient.Delete(ctx, keySecret)).Should(Succeed())
+	})
+
+	Context("SSH Key controller", func() {
+		It("can create and delete an SSH key", func() {
+			// Create a new Kubernetes SSH key object and wait until the controller finishes the reconciliation
+			createSSHKeyResource(instance)
+		})
+		It("can restore an SSH key", func() {
+			// Create a new Kubernetes SSH key object and wait until the controller finishes the reconciliation
+			createSSHKeyResource(instance)
+
+			initSSHKeyID := instance.Status.ID
+
+			// Delete the HCP Terraform SSH key
+			Expect(tfClient.SSHKeys.Delete(ctx, instance.Status.ID)).Should(Succeed())
+
+			// Wait until the controller re-creates the SSH key and updates Status.ID with a new valid SSH key ID
+			Eventually(func() bool {
+				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+				return instance.Status.ID != initSSHKeyID
+			}).Should(BeTrue())
+
+			// The Kubernetes SSH key object should have Status.ID with the valid SSH key ID
+			Expect(instance.Status.ID).Should(HavePrefix("sshkey-"))
+		})
+		It("can revert external changes", func() {
+			// Create a new Kubernetes SSH key object and wait until the controller finishes the reconciliation
+			createSSHKeyResource(instance)
+
+			// Change the HCP Terraform SSH key name
+			sk, err := tfClient.SSHKeys.Update(ctx, instance.Status.ID, tfc.SSHKeyUpdateOptions{
+				Name: tfc.String(fmt.Sprintf("%v-new", instance.Spec.Name)),
+			})
+			Expect(sk).ShouldNot(BeNil())
+			Expect(err).Should(Succeed())
+
+			// Wait until the controller updates HCP Terraform SSH key
+			Eventually(func() bool {
+				sk, err := tfClient.SSHKeys.Read(ctx, instance.Status.ID)
+				Expect(sk).ShouldNot(BeNil())
+				Expect(err).Should(Succeed())
+				return sk.Name == instance.Spec.Name
+			}).Should(BeTrue())
+		})
+		It("can rotate an SSH key", func() {
+			// Create a new Kubernetes SSH key object and wait until the controller finishes the reconciliation
+			createSSHKeyResource(instance)
+
+			initSSHKeyID := instance.Status.ID
+
+			// Update the private key in the Kubernetes secret
+			Expect(k8sClient.Get(ctx, getNamespacedName(keySecret), keySecret)).Should(Succeed())
+			keySecret.Data["id_rsa"] = generateSSHPrivateKey()
+			Expect(k8sClient.Update(ctx, keySecret)).Should(Succeed())
+
+			// Wait until the controller rotates the SSH key
+			Eventually(func() bool {
+				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+				return instance.Status.ID != initSSHKeyID && instance.Status.RotatedAt != nil
+			}).Should(BeTrue())
+
+			// The previous SSH key should be removed
+			_, err := tfClient.SSHKeys.Read(ctx, initSSHKeyID)
+			Expect(err).Should(Equal(tfc.ErrResourceNotFound))
+		})
+	})
+})
+
+func createSSHKeyResource(instance *appv1alpha2.OrganizationSSHKey) {
+	namespacedName := getNamespacedName(instance)
+
+	// Create a new Kubernetes SSH key object
+	Expect(k8sClient.Create(ctx, instance)).Should(Succeed())
+	// Wait until the controller finishes the reconciliation
+	Eventually(func() bool {
+		Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+		return instance.Status.ObservedGeneration == instance.Generation
+	}).Should(BeTrue())
+
+	// The Kubernetes SSH key object should have Status.ID with the valid SSH key ID
+	Expect(instance.Status.ID).Should(HavePrefix("sshkey-"))
+}
@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+var _ = Describe("Registry Module controller", Ordered, func() {
+	var (
+		instance       *appv1alpha2.RegistryModule
+		namespacedName types.NamespacedName
+		moduleName     string
+		moduleCode     *corev1.ConfigMap
+	)
+
+	BeforeAll(func() {
+		// Set default Eventually timers
+		SetDefaultEventuallyTimeout(syncPeriod * 4)
+		SetDefaultEventuallyPollingInterval(2 * time.Second)
+	})
+
+	BeforeEach(func() {
+		namespacedName = newNamespacedName()
+		moduleName = fmt.Sprintf("kubernetes-operator-%v", randomNumber())
+		// Create a new ConfigMap with the module code for each test
+		moduleCode = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-code", namespacedName.Name),
+				Namespace: namespacedName.Namespace,
+			},
+			Data: map[string]string{
+				"main.tf":    "resource \"random_pet\" \"this\" {}\n",
+				"outputs.tf": "output \"name\" {\n  value = random_pet.this.id\n}\n",
+			},
+		}
+		Expect(k8sClient.Create(ctx, moduleCode)).Should(Succeed())
+		// Create a new registry module object for each test
+		instance = &appv1alpha2.RegistryModule{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "app.terraform.io/v1alpha2",
+				Kind:       "RegistryModule",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              namespacedName.Name,
+				Namespace:         namespacedName.Namespace,
+				DeletionTimestamp: nil,
+				Finalizers:        []string{},
+			},
+			Spec: appv1alpha2.RegistryModuleSpec{
+				Organization: organization,
+				Token: appv1alpha2.Token{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretNamespacedName.Name,
+						},
+						Key: secretKey,
+					},
+				},
+				Name:     moduleName,
+				Provider: "random",
+				Versions: []appv1alpha2.RegistryModuleVersion{
+					{
+						Version: "1.0.0",
+						Source: appv1alpha2.RegistryModuleVersionSource{
+							ConfigMapRef: &corev1.LocalObjectReference{
+								Name: moduleCode.Name,
+							},
+						},
+					},
+				},
+				DeletionPolicy: appv1alpha2.RegistryModuleDeletionPolicyDestroy,
+			},
+			Status: appv1alpha2.RegistryModuleStatus{},
+		}
+	})
+
+	AfterEach(func() {
+		// Delete the Kubernetes registry module object and wait until the controller finishes the reconciliation after deletion of the object
+		Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, namespacedName, instance)
+			// The Kubernetes client will return error 'NotFound' on the Get operation once the object is deleted
+			return kerrors.IsNotFound(err)
+		}).Should(BeTrue())
+
+		// Make sure that the HCP Terraform registry module is deleted
+		Eventually(func() bool {
+			err := tfClient.RegistryModules.DeleteProvider(ctx, tfc.RegistryModuleID{
+				Organization: organization,
+				Namespace:    organization,
+				Name:         moduleName,
+				Provider:     "random",
+				RegistryName: tfc.PrivateRegistry,
+			})
+			// The HCP Terraform client will return the error 'ResourceNotFound' once the registry module does not exist
+			return err == tfc.ErrResourceNotFound || err == nil
+		}).Should(BeTrue())
+
+		Expect(k8sClient.Delete(ctx, moduleCode)).Should(Succeed())
+	})
+
+	Context("Registry Module controller", func() {
+		It("can create and delete a registry module", func() {
+			// Create a new Kubernetes registry module object and wait until the controller finishes the reconciliation
+			createRegistryModuleResource(instance)
+
+			// The Kubernetes registry module object should have a valid module source address
+			Expect(instance.Status.Source).Should(Equal(fmt.Sprintf("%s/%s/%s/random", cloudEndpoint, organization, moduleName)))
+
+			// Wait until the version is published
+			Eventually(func() bool {
+				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+				for _, v := range instance.Status.Versions {
+					if v.Version == "1.0.0" && v.Status == string(tfc.RegistryModuleVersionStatusOk) {
+						return true
+					}
+				}
+				return false
+			}).Should(BeTrue())
+		})
+		It("can publish a new version", func() {
+			// Create a new Kubernetes registry module object and wait until the controller finishes the reconciliation
+			createRegistryModuleResource(instance)
+
+			// Add a new version
+			Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+			instance.Spec.Versions = append(instance.Spec.Versions, appv1alpha2.RegistryModuleVersion{
+				Version: "1.1.0",
+				Source: appv1alpha2.RegistryModuleVersionSource{
+					ConfigMapRef: &corev1.LocalObjectReference{
+						Name: moduleCode.Name,
+					},
+				},
+			})
+			Expect(k8sClient.Update(ctx, instance)).Should(Succeed())
+
+			// Wait until the controller publishes the new version
+			Eventually(func() bool {
+				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+				for _, v := range instance.Status.Versions {
+					if v.Version == "1.1.0" {
+						return true
+					}
+				}
+				return false
+			}).Should(BeTrue())
+		})
+		It("can revert external changes", func() {
+			// Create a new Kubernetes registry module object and wait until the controller finishes the reconciliation
+			createRegistryModuleResource(instance)
+
+			// Enable no-code provisioning for the HCP Terraform registry module
+			rm, err := tfClient.RegistryModules.Update(ctx, tfc.RegistryModuleID{
+				Organization: organization,
+				Namespace:    organization,
+				Name:         moduleName,
+				Provider:     "random",
+				RegistryName: tfc.PrivateRegistry,
+			}, tfc.RegistryModuleUpdateOptions{
+				NoCode: tfc.Bool(true),
+			})
+			Expect(rm).ShouldNot(BeNil())
+			Expect(err).Should(Succeed())
+
+			// Wait until the controller updates HCP Terraform registry module
+			Eventually(func() bool {
+				rm, err := tfClient.RegistryModules.Read(ctx, tfc.RegistryModuleID{ID: instance.Status.ID})
+				Expect(rm).ShouldNot(BeNil())
+				Expect(err).Should(Succeed())
+				return rm.NoCode == instance.Spec.NoCode
+			}).Should(BeTrue())
+		})
+	})
+})
+
+func createRegistryModuleResource(instance *appv1alpha2.RegistryModule) {
+	namespacedName := getNamespacedName(instance)
+
+	// Create a new Kubernetes registry module object
+	Expect(k8sClient.Create(ctx, instance)).Should(Succeed())
+	// Wait until the controller finishes the reconciliation
+	Eventually(func() bool {
+		Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
+		return instance.Status.ObservedGeneration == instance.Generation
+	}).Should(BeTrue())
+
+	// The Kubernetes registry module object should have Status.ID with the valid registry module ID
+	Expect(instance.Status.ID).Should(HavePrefix("mod-"))
+}
@@ -162,12 +162,17 @@ var _ = BeforeSuite(func() {
 			},
 			Controller: config.Controller{
 				GroupKindConcurrency: map[string]int{
-					"AgentPool.app.terraform.io":     5,
-					"AgentToken.app.terraform.io":    5,
-					"Module.app.terraform.io":        5,
-					"Project.app.terraform.io":       5,
-					"RunsCollector.app.terraform.io": 5,
-					"Workspace.app.terraform.io":     5,
+					"AgentPool.app.terraform.io":          5,
+					"AgentToken.app.terraform.io":         5,
+					"APIToken.app.terraform.io":           5,
+					"Module.app.terraform.io":             5,
+					"NoCodeWorkspace.app.terraform.io":    5,
+					"Organization.app.terraform.io":       5,
+					"Project.app.terraform.io":            5,
+					"RegistryModule.app.terraform.io":     5,
+					"RunsCollector.app.terraform.io":      5,
+					"OrganizationSSHKey.app.terraform.io": 5,
+					"Workspace.app.terraform.io":          5,
 				},
 			},
 			Metrics: server.Options{
@@ -190,6 +195,13 @@ var _ = BeforeSuite(func() {
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
+		err = (&controller.APITokenReconciler{
+			Client:   k8sManager.GetClient(),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("APITokenController"),
+		}).SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
 		err = (&controller.ModuleReconciler{
 			Client:   k8sManager.GetClient(),
 			Scheme:   k8sManager.GetScheme(),
@@ -197,6 +209,20 @@ var _ = BeforeSuite(func() {
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
+		err = (&controller.NoCodeWorkspaceReconciler{
+			Client:   k8sManager.GetClient(),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("NoCodeWorkspaceController"),
+		}).SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = (&controller.OrganizationReconciler{
+			Client:   k8sManager.GetClient(),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("OrganizationController"),
+		}).SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
 		err = (&controller.ProjectReconciler{
 			Client:   k8sManager.GetClient(),
 			Scheme:   k8sManager.GetScheme(),
@@ -204,6 +230,13 @@ var _ = BeforeSuite(func() {
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
+		err = (&controller.RegistryModuleReconciler{
+			Client:   k8sManager.GetClient(),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("RegistryModuleController"),
+		}).SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
 		err = (&controller.RunsCollectorReconciler{
 			Client:   k8sManager.GetClient(),
 			Scheme:   k8sManager.GetScheme(),
@@ -211,6 +244,13 @@ var _ = BeforeSuite(func() {
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
+		err = (&controller.OrganizationSSHKeyReconciler{
+			Client:   k8sManager.GetClient(),
+			Scheme:   k8sManager.GetScheme(),
+			Recorder: k8sManager.GetEventRecorderFor("OrganizationSSHKeyController"),
+		}).SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
 		err = (&controller.WorkspaceReconciler{
 			Client:   k8sManager.GetClient(),
 			Scheme:   k8sManager.GetScheme(),
@@ -98,6 +98,52 @@ var _ = Describe("Workspace controller", Ordered, func() {
 			createWorkspaceResource(instance)
 			isReconciledSSHKey(instance)
 		})
+		It("can be created by object reference", func() {
+			sshKey := &appv1alpha2.OrganizationSSHKey{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      namespacedName.Name,
+					Namespace: namespacedName.Namespace,
+				},
+				Spec: appv1alpha2.OrganizationSSHKeySpec{
+					Organization:   organization,
+					Token:          instance.Spec.Token,
+					Name:           fmt.Sprintf("%v-object", sshKeyName),
+					DeletionPolicy: appv1alpha2.OrganizationSSHKeyDeletionPolicyDestroy,
+				},
+			}
+			keySecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s-key", namespacedName.Name),
+					Namespace: namespacedName.Namespace,
+				},
+				Data: map[string][]byte{
+					"id_rsa": generateSSHPrivateKey(),
+				},
+			}
+			Expect(k8sClient.Create(ctx, keySecret)).Should(Succeed())
+			sshKey.Spec.PrivateKey = appv1alpha2.OrganizationSSHKeyPrivateKey{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: keySecret.Name,
+					},
+					Key: "id_rsa",
+				},
+			}
+			createSSHKeyResource(sshKey)
+
+			instance.Spec.SSHKey = &appv1alpha2.SSHKey{
+				ObjectRef: &corev1.LocalObjectReference{
+					Name: sshKey.Name,
+				},
+			}
+			// Create a new Kubernetes workspace object and wait until the controller finishes the reconciliation
+			createWorkspaceResource(instance)
+			isReconciledSSHKey(instance)
+			Expect(instance.Status.SSHKeyID).Should(Equal(sshKey.Status.ID))
+
+			Expect(k8sClient.Delete(ctx, sshKey)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, keySecret)).Should(Succeed())
+		})
 
 		It("can be restored by name", func() {
 			instance.Spec.SSHKey = &appv1alpha2.SSHKey{
@@ -219,7 +265,7 @@ func isSSHKeyEmpty(instance *appv1alpha2.Workspace) {
 	}).Should(BeTrue())
 }
 
-func createSSHKey(sshKeyName string) string {
+func generateSSHPrivateKey() []byte {
 	sk, err := rsa.GenerateKey(rand.Reader, 2048)
 	Expect(err).Should(Succeed())
 	Expect(sk).ShouldNot(BeNil())
@@ -230,6 +276,12 @@ func createSSHKey(sshKeyName string) string {
 	}
 	privateSSHKey := pem.EncodeToMemory(privateKeyBlock)
 	Expect(privateSSHKey).ShouldNot(BeNil())
+
+	return privateSSHKey
+}
+
+func createSSHKey(sshKeyName string) string {
+	privateSSHKey := generateSSHPrivateKey()
 	sshKey, err := tfClient.SSHKeys.Create(ctx, organization, tfc.SSHKeyCreateOptions{
 		Name:  tfc.String(sshKeyName),
 		Value: tfc.String(string(privateSSHKey)),